@@ -8,26 +8,72 @@ import (
 	"meye-core/internal/application/campaign/createpj"
 	"meye-core/internal/application/campaign/getcampaign"
 	"meye-core/internal/application/campaign/getcampaigns"
+	"meye-core/internal/application/campaign/gethouserules"
 	"meye-core/internal/application/campaign/getinvitations"
 	"meye-core/internal/application/campaign/getpj"
 	"meye-core/internal/application/campaign/getpjs"
+	"meye-core/internal/application/campaign/getrulesets"
 	"meye-core/internal/application/campaign/inviteuser"
+	"meye-core/internal/application/campaign/learnskill"
+	"meye-core/internal/application/campaign/unlocktransformation"
+	"meye-core/internal/application/campaign/updatehouserules"
 	"meye-core/internal/application/campaign/updatepjstats"
+	"meye-core/internal/application/session/amendsession"
 	"meye-core/internal/application/session/createsession"
+	"meye-core/internal/application/session/finalizesession"
+	"meye-core/internal/application/session/getcalendarfeed"
+	"meye-core/internal/application/session/getsession"
+	"meye-core/internal/application/session/getsessionrecap"
+	"meye-core/internal/application/session/listsessions"
+	"meye-core/internal/application/session/reschedulesession"
+	"meye-core/internal/application/session/rsvpsession"
+	"meye-core/internal/application/session/searchsessions"
+	"meye-core/internal/application/user/changepassword"
+	"meye-core/internal/application/user/changeuserroles"
+	"meye-core/internal/application/user/cleartwofactor"
+	"meye-core/internal/application/user/confirmtwofactor"
+	"meye-core/internal/application/user/createapikey"
 	"meye-core/internal/application/user/createuser"
+	"meye-core/internal/application/user/deleteuser"
+	"meye-core/internal/application/user/disabletwofactor"
+	"meye-core/internal/application/user/disableuser"
+	"meye-core/internal/application/user/enableuser"
+	"meye-core/internal/application/user/enrolltwofactor"
 	"meye-core/internal/application/user/getplayers"
+	"meye-core/internal/application/user/gettwofactorpolicy"
 	"meye-core/internal/application/user/getuser"
+	"meye-core/internal/application/user/issuepasswordreset"
+	"meye-core/internal/application/user/listapikeys"
+	"meye-core/internal/application/user/listusers"
 	"meye-core/internal/application/user/login"
+	"meye-core/internal/application/user/logout"
+	"meye-core/internal/application/user/logouteverywhere"
+	"meye-core/internal/application/user/refreshtoken"
+	"meye-core/internal/application/user/resetpassword"
+	"meye-core/internal/application/user/revokeapikey"
+	"meye-core/internal/application/user/revokecalendartoken"
+	"meye-core/internal/application/user/rotatecalendartoken"
+	"meye-core/internal/application/user/updatetwofactorpolicy"
+	"meye-core/internal/application/user/verifylogin"
 	"meye-core/internal/config"
+	domaincampaign "meye-core/internal/domain/campaign"
+	domainuser "meye-core/internal/domain/user"
 	"meye-core/internal/infrastructure/api"
 	"meye-core/internal/infrastructure/api/handler"
+	"meye-core/internal/infrastructure/cache"
+	"meye-core/internal/infrastructure/encryption"
 	"meye-core/internal/infrastructure/hash"
 	"meye-core/internal/infrastructure/identification"
 	"meye-core/internal/infrastructure/jwt"
 	"meye-core/internal/infrastructure/messaging/rabbitmq"
+	"meye-core/internal/infrastructure/recap"
 	postgresCampaignRepo "meye-core/internal/infrastructure/repository/campaign/postgres"
+	yamlRulesetRepo "meye-core/internal/infrastructure/repository/ruleset/yaml"
 	postgresSessionRepo "meye-core/internal/infrastructure/repository/session/postgres"
+	memoryUserRepo "meye-core/internal/infrastructure/repository/user/memory"
 	postgresUserRepo "meye-core/internal/infrastructure/repository/user/postgres"
+	"meye-core/internal/infrastructure/token"
+	"meye-core/internal/infrastructure/totp"
 
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
@@ -40,6 +86,35 @@ type UserUseCases struct {
 	Login      *login.UseCase
 	GetPlayers *getplayers.UseCase
 	GetUser    *getuser.UseCase
+
+	RefreshToken     *refreshtoken.UseCase
+	Logout           *logout.UseCase
+	LogoutEverywhere *logouteverywhere.UseCase
+
+	ChangePassword     *changepassword.UseCase
+	IssuePasswordReset *issuepasswordreset.UseCase
+	ResetPassword      *resetpassword.UseCase
+
+	RotateCalendarToken *rotatecalendartoken.UseCase
+	RevokeCalendarToken *revokecalendartoken.UseCase
+
+	ListUsers       *listusers.UseCase
+	DisableUser     *disableuser.UseCase
+	EnableUser      *enableuser.UseCase
+	ChangeUserRoles *changeuserroles.UseCase
+	DeleteUser      *deleteuser.UseCase
+
+	VerifyLogin           *verifylogin.UseCase
+	EnrollTwoFactor       *enrolltwofactor.UseCase
+	ConfirmTwoFactor      *confirmtwofactor.UseCase
+	DisableTwoFactor      *disabletwofactor.UseCase
+	ClearTwoFactor        *cleartwofactor.UseCase
+	GetTwoFactorPolicy    *gettwofactorpolicy.UseCase
+	UpdateTwoFactorPolicy *updatetwofactorpolicy.UseCase
+
+	CreateAPIKey *createapikey.UseCase
+	ListAPIKeys  *listapikeys.UseCase
+	RevokeAPIKey *revokeapikey.UseCase
 }
 
 type CampaignUseCases struct {
@@ -52,10 +127,24 @@ type CampaignUseCases struct {
 	GetCampaigns          *getcampaigns.UseCase
 	GetPjs                *getpjs.UseCase
 	GetInvitationsUseCase *getinvitations.UseCase
+	LearnSkill            *learnskill.UseCase
+	UnlockTransformation  *unlocktransformation.UseCase
+	UpdateHouseRules      *updatehouserules.UseCase
+	GetHouseRules         *gethouserules.UseCase
+	GetRulesets           *getrulesets.UseCase
 }
 
 type SessionUseCases struct {
-	CreateSession *createsession.UseCase
+	CreateSession     *createsession.UseCase
+	ListSessions      *listsessions.UseCase
+	GetSession        *getsession.UseCase
+	AmendSession      *amendsession.UseCase
+	FinalizeSession   *finalizesession.UseCase
+	RescheduleSession *reschedulesession.UseCase
+	RSVPSession       *rsvpsession.UseCase
+	GetCalendarFeed   *getcalendarfeed.UseCase
+	GetSessionRecap   *getsessionrecap.UseCase
+	SearchSessions    *searchsessions.UseCase
 }
 
 type UseCases struct {
@@ -65,26 +154,42 @@ type UseCases struct {
 }
 
 type Repositories struct {
-	User                 *postgresUserRepo.Repository
-	Campaign             *postgresCampaignRepo.Repository
-	Session              *postgresSessionRepo.Repository
-	PJ                   *postgresCampaignRepo.PjRepository
-	CampaignQueryService *postgresCampaignRepo.CampaignQueryService
-	PjQueryService       *postgresCampaignRepo.PjQueryService
-	InvitationRepository *postgresCampaignRepo.InvitationRepository
+	User                   *postgresUserRepo.Repository
+	RefreshToken           *postgresUserRepo.RefreshTokenRepository
+	LoginAttemptStore      domainuser.LoginAttemptStore
+	LoginChallenge         *postgresUserRepo.LoginChallengeRepository
+	TwoFactorPolicy        *postgresUserRepo.TwoFactorPolicyRepository
+	APIKey                 *postgresUserRepo.APIKeyRepository
+	AuthQueryService       *cache.AuthQueryService
+	Campaign               *postgresCampaignRepo.Repository
+	Session                *postgresSessionRepo.Repository
+	SessionQueryService    *postgresSessionRepo.QueryService
+	PJ                     *postgresCampaignRepo.PjRepository
+	CampaignQueryService   *postgresCampaignRepo.CampaignQueryService
+	PjQueryService         *postgresCampaignRepo.PjQueryService
+	InvitationRepository   *postgresCampaignRepo.InvitationRepository
+	MembershipQueryService *cache.MembershipQueryService
+	Ruleset                *yamlRulesetRepo.Repository
 }
 
 type Services struct {
 	Hash           *hash.Service
 	Identification *identification.Service
 	JWT            *jwt.Service
+	Token          *token.Service
+	TOTP           *totp.Service
+	Encryption     *encryption.Service
 	EventPublisher *rabbitmq.Publisher
+	RecapRenderer  *recap.Renderer
 }
 
 type Handlers struct {
 	User     *handler.UserHandler
 	Auth     *handler.AuthHandler
 	Campaign *handler.CampaignHandler
+	Session  *handler.SessionHandler
+	JWKS     *handler.JWKSHandler
+	APIKey   *handler.APIKeyHandler
 }
 
 type DependencyContainer struct {
@@ -157,79 +262,314 @@ func NewDependencyContainer() (*DependencyContainer, error) {
 		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
 
-	container.initializeServices()
+	if err := container.initializeServices(); err != nil {
+		return nil, fmt.Errorf("failed to initialize services: %w", err)
+	}
 
 	if err := container.connectRabbitMQ(); err != nil {
 		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
 	}
 
-	container.initializeRepositories()
+	if err := container.initializeRepositories(); err != nil {
+		return nil, fmt.Errorf("failed to initialize repositories: %w", err)
+	}
+
 	container.initializeUseCases()
 	container.initializeHandlers()
-	container.initializeRouter()
+
+	if err := container.initializeRouter(); err != nil {
+		return nil, fmt.Errorf("failed to initialize router: %w", err)
+	}
 
 	return container, nil
 }
 
-func (c *DependencyContainer) initializeServices() {
+func (c *DependencyContainer) initializeServices() error {
+	recapRenderer, err := recap.New(c.Config.Recaps.TemplatesDir)
+	if err != nil {
+		return fmt.Errorf("failed to load recap templates: %w", err)
+	}
+
+	jwtService, err := c.newJWTService()
+	if err != nil {
+		return fmt.Errorf("failed to load JWT keys: %w", err)
+	}
+
+	encryptionService, err := encryption.New(c.Config.TwoFactor.SecretKey)
+	if err != nil {
+		return fmt.Errorf("failed to create encryption service: %w", err)
+	}
+
 	c.Services = &Services{
-		Hash:           hash.New(),
+		Hash:           c.newHashService(),
 		Identification: identification.New(),
-		JWT:            jwt.New(c.Config.JWT.Secret, c.Config.JWT.Issuer, c.Config.JWT.ExpirationTime),
+		JWT:            jwtService,
+		Token:          token.New(),
+		TOTP:           totp.New(c.Config.TwoFactor.Issuer),
+		Encryption:     encryptionService,
+		RecapRenderer:  recapRenderer,
 	}
+
+	return nil
 }
 
-func (c *DependencyContainer) initializeRepositories() {
+func (c *DependencyContainer) newJWTService() (*jwt.Service, error) {
+	cfg := c.Config.JWT
+
+	if cfg.SigningMethod == jwt.MethodHS256 {
+		return jwt.New(cfg.Secret, cfg.Issuer, cfg.ExpirationTime), nil
+	}
+
+	keyring, err := jwt.NewKeyring(cfg.KeysDir, cfg.SigningMethod, cfg.ExpirationTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if !keyring.HasKeys() {
+		logrus.Warnf("No signing key in %s yet, the worker writes the first one", cfg.KeysDir)
+	}
+
+	return jwt.NewWithKeyring(keyring, cfg.Issuer, cfg.ExpirationTime), nil
+}
+
+func (c *DependencyContainer) newHashService() *hash.Service {
+	cfg := c.Config.PasswordHashing
+
+	if cfg.Algorithm == hash.AlgorithmBcrypt {
+		return hash.NewBcrypt(cfg.BcryptCost)
+	}
+
+	return hash.NewArgon2id(hash.Argon2idParams{
+		Memory:      cfg.Argon2Memory,
+		Iterations:  cfg.Argon2Iterations,
+		Parallelism: cfg.Argon2Parallelism,
+	})
+}
+
+// newLoginAttemptStore counts failed logins in memory, or in the database to share the counters between instances.
+func (c *DependencyContainer) newLoginAttemptStore() domainuser.LoginAttemptStore {
+	if c.Config.LoginThrottle.Store == "memory" {
+		return memoryUserRepo.NewLoginAttemptStore()
+	}
+
+	return postgresUserRepo.NewLoginAttemptStore(c.Database)
+}
+
+func (c *DependencyContainer) initializeRepositories() error {
+	rulesetRepo, err := yamlRulesetRepo.New(c.Config.Rulesets.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to load rulesets: %w", err)
+	}
+
 	c.Repositories = &Repositories{
-		User:                 postgresUserRepo.New(c.Database),
+		User:              postgresUserRepo.New(c.Database, c.Services.Encryption),
+		RefreshToken:      postgresUserRepo.NewRefreshTokenRepository(c.Database),
+		LoginAttemptStore: c.newLoginAttemptStore(),
+		LoginChallenge:    postgresUserRepo.NewLoginChallengeRepository(c.Database),
+		TwoFactorPolicy:   postgresUserRepo.NewTwoFactorPolicyRepository(c.Database),
+		APIKey:            postgresUserRepo.NewAPIKeyRepository(c.Database),
+		AuthQueryService: cache.NewAuthQueryService(
+			postgresUserRepo.NewAuthQueryService(c.Database),
+			c.Config.Auth.CacheTTL,
+		),
 		Campaign:             postgresCampaignRepo.New(c.Database),
 		Session:              postgresSessionRepo.New(c.Database),
+		SessionQueryService:  postgresSessionRepo.NewQueryService(c.Database),
 		PJ:                   postgresCampaignRepo.NewPjRepository(c.Database),
 		CampaignQueryService: postgresCampaignRepo.NewQueryService(c.Database),
 		PjQueryService:       postgresCampaignRepo.NewPjQueryService(c.Database),
 		InvitationRepository: postgresCampaignRepo.NewInvitationRepository(c.Database),
+		MembershipQueryService: cache.NewMembershipQueryService(
+			postgresCampaignRepo.NewMembershipQueryService(c.Database),
+			c.Config.Auth.CacheTTL,
+		),
+		Ruleset: rulesetRepo,
 	}
+
+	return nil
 }
 
 func (c *DependencyContainer) initializeUseCases() {
+	// Every use case publishes through it, so user changes invalidate the cached auth states
+	eventPublisher := cache.NewInvalidatingPublisher(c.Services.EventPublisher, c.Repositories.AuthQueryService)
+
+	passwordPolicy := domainuser.NewPasswordPolicy(
+		c.Config.Passwords.MinLength,
+		c.Config.Passwords.RequireMixedCase,
+		c.Config.Passwords.RequireDigit,
+		c.Config.Passwords.RequireSymbol,
+	)
+
+	loginThrottle := c.Config.LoginThrottle
+	usernameThrottle := domainuser.NewLoginThrottle(
+		loginThrottle.MaxFailuresPerUsername,
+		loginThrottle.BaseDelay,
+		loginThrottle.MaxDelay,
+		loginThrottle.LockoutDuration,
+		loginThrottle.FailureWindow,
+	)
+	ipAddressThrottle := domainuser.NewLoginThrottle(
+		loginThrottle.MaxFailuresPerIP,
+		loginThrottle.BaseDelay,
+		loginThrottle.MaxDelay,
+		loginThrottle.LockoutDuration,
+		loginThrottle.FailureWindow,
+	)
+	loginGuard := domainuser.NewLoginGuard(c.Repositories.LoginAttemptStore, usernameThrottle, ipAddressThrottle)
+
+	skillRules := domaincampaign.NewSkillRules(
+		c.Config.Supernatural.SkillUnlockXPCost,
+		c.Config.Supernatural.TransformationUnlockXPCost,
+		c.Config.Supernatural.MaxSkillsPerPJ,
+	)
+
 	c.UseCases = &UseCases{
 		User: &UserUseCases{
 			CreateUser: createuser.New(
 				c.Repositories.User,
 				c.Services.Identification,
 				c.Services.Hash,
-				c.Services.EventPublisher,
+				eventPublisher,
 			),
 			Login: login.New(
 				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				c.Repositories.LoginChallenge,
+				c.Repositories.TwoFactorPolicy,
 				c.Services.Hash,
 				c.Services.JWT,
+				c.Services.Identification,
+				c.Services.Token,
+				c.Services.TOTP,
+				c.Config.JWT.RefreshExpirationTime,
+				c.Config.TwoFactor.ChallengeTTL,
+				loginGuard,
+				eventPublisher,
+			),
+			RefreshToken: refreshtoken.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				c.Repositories.TwoFactorPolicy,
+				c.Services.JWT,
+				c.Services.Identification,
+				c.Services.Token,
+				c.Config.JWT.RefreshExpirationTime,
+			),
+			Logout: logout.New(
+				c.Repositories.RefreshToken,
+				c.Services.Token,
+			),
+			LogoutEverywhere: logouteverywhere.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				eventPublisher,
+			),
+			ChangePassword: changepassword.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				c.Services.Hash,
+				passwordPolicy,
+				eventPublisher,
+			),
+			IssuePasswordReset: issuepasswordreset.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				c.Services.Token,
+				eventPublisher,
+				c.Config.Passwords.ResetTokenTTL,
+			),
+			ResetPassword: resetpassword.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				c.Services.Hash,
+				c.Services.Token,
+				passwordPolicy,
+				eventPublisher,
 			),
 			GetPlayers: getplayers.New(
 				c.Repositories.User,
 			),
 			GetUser: getuser.New(c.Repositories.User),
+			RotateCalendarToken: rotatecalendartoken.New(
+				c.Repositories.User,
+				c.Services.Token,
+			),
+			RevokeCalendarToken: revokecalendartoken.New(c.Repositories.User),
+			ListUsers:           listusers.New(c.Repositories.User),
+			DisableUser: disableuser.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				eventPublisher,
+			),
+			EnableUser:      enableuser.New(c.Repositories.User, eventPublisher),
+			ChangeUserRoles: changeuserroles.New(c.Repositories.User, eventPublisher),
+			DeleteUser: deleteuser.New(
+				c.Repositories.User,
+				c.Repositories.CampaignQueryService,
+				c.Repositories.PjQueryService,
+				eventPublisher,
+			),
+			VerifyLogin: verifylogin.New(
+				c.Repositories.User,
+				c.Repositories.RefreshToken,
+				c.Repositories.LoginChallenge,
+				c.Services.JWT,
+				c.Services.Identification,
+				c.Services.Token,
+				c.Services.TOTP,
+				c.Config.JWT.RefreshExpirationTime,
+				loginGuard,
+				eventPublisher,
+			),
+			EnrollTwoFactor: enrolltwofactor.New(c.Repositories.User, c.Services.TOTP),
+			ConfirmTwoFactor: confirmtwofactor.New(
+				c.Repositories.User,
+				c.Services.TOTP,
+				c.Services.Token,
+				eventPublisher,
+			),
+			DisableTwoFactor: disabletwofactor.New(
+				c.Repositories.User,
+				c.Repositories.TwoFactorPolicy,
+				c.Services.TOTP,
+				c.Services.Token,
+				eventPublisher,
+			),
+			ClearTwoFactor:        cleartwofactor.New(c.Repositories.User, eventPublisher),
+			GetTwoFactorPolicy:    gettwofactorpolicy.New(c.Repositories.TwoFactorPolicy),
+			UpdateTwoFactorPolicy: updatetwofactorpolicy.New(c.Repositories.TwoFactorPolicy),
+			CreateAPIKey: createapikey.New(
+				c.Repositories.User,
+				c.Repositories.APIKey,
+				c.Services.Identification,
+				c.Services.Token,
+				eventPublisher,
+			),
+			ListAPIKeys:  listapikeys.New(c.Repositories.APIKey),
+			RevokeAPIKey: revokeapikey.New(c.Repositories.APIKey, eventPublisher),
 		},
 		Campaign: &CampaignUseCases{
 			CreateCampaign: createcampaign.New(
 				c.Repositories.Campaign,
+				c.Repositories.Ruleset,
 				c.Services.Identification,
-				c.Services.EventPublisher,
+				eventPublisher,
 			),
 			InviteUser: inviteuser.New(
 				c.Repositories.Campaign,
 				c.Repositories.User,
 				c.Services.Identification,
-				c.Services.EventPublisher,
+				eventPublisher,
 			),
 			CreatePJ: createpj.New(
 				c.Repositories.Campaign,
 				c.Repositories.User,
 				c.Services.Identification,
-				c.Services.EventPublisher,
+				eventPublisher,
 			),
 			UpdatePjStats: updatepjstats.New(
 				c.Repositories.PJ,
+				c.Repositories.Campaign,
 			),
 			GetCampaign: getcampaign.New(
 				c.Repositories.Campaign,
@@ -246,13 +586,75 @@ func (c *DependencyContainer) initializeUseCases() {
 			GetInvitationsUseCase: getinvitations.New(
 				c.Repositories.InvitationRepository,
 			),
+			LearnSkill: learnskill.New(
+				c.Repositories.PJ,
+				c.Services.Identification,
+				skillRules,
+			),
+			UnlockTransformation: unlocktransformation.New(
+				c.Repositories.PJ,
+				c.Services.Identification,
+				skillRules,
+			),
+			UpdateHouseRules: updatehouserules.New(
+				c.Repositories.Campaign,
+				eventPublisher,
+			),
+			GetHouseRules: gethouserules.New(
+				c.Repositories.Campaign,
+			),
+			GetRulesets: getrulesets.New(
+				c.Repositories.Ruleset,
+			),
 		},
 		Session: &SessionUseCases{
 			CreateSession: createsession.New(
 				c.Repositories.Session,
 				c.Repositories.Campaign,
 				c.Services.Identification,
-				c.Services.EventPublisher,
+				eventPublisher,
+			),
+			ListSessions: listsessions.New(
+				c.Repositories.SessionQueryService,
+				c.Repositories.MembershipQueryService,
+			),
+			GetSession: getsession.New(
+				c.Repositories.Session,
+				c.Repositories.MembershipQueryService,
+			),
+			AmendSession: amendsession.New(
+				c.Repositories.Session,
+				c.Repositories.Campaign,
+				eventPublisher,
+			),
+			FinalizeSession: finalizesession.New(
+				c.Repositories.Session,
+				c.Repositories.Campaign,
+				eventPublisher,
+			),
+			RescheduleSession: reschedulesession.New(
+				c.Repositories.Session,
+				eventPublisher,
+			),
+			RSVPSession: rsvpsession.New(
+				c.Repositories.Session,
+				c.Repositories.MembershipQueryService,
+				eventPublisher,
+			),
+			GetCalendarFeed: getcalendarfeed.New(
+				c.Repositories.User,
+				c.Services.Token,
+				c.Repositories.SessionQueryService,
+			),
+			GetSessionRecap: getsessionrecap.New(
+				c.Repositories.Session,
+				c.Repositories.CampaignQueryService,
+				c.Repositories.MembershipQueryService,
+				c.Repositories.PjQueryService,
+			),
+			SearchSessions: searchsessions.New(
+				c.Repositories.SessionQueryService,
+				c.Repositories.MembershipQueryService,
 			),
 		},
 	}
@@ -263,15 +665,35 @@ func (c *DependencyContainer) initializeHandlers() {
 		User: handler.NewUserHandler(
 			c.UseCases.User.CreateUser,
 			c.UseCases.User.Login,
+			c.UseCases.User.RefreshToken,
+			c.UseCases.User.Logout,
+			c.UseCases.User.LogoutEverywhere,
+			c.UseCases.User.ChangePassword,
+			c.UseCases.User.IssuePasswordReset,
+			c.UseCases.User.ResetPassword,
 			c.UseCases.User.GetPlayers,
 			c.UseCases.User.GetUser,
+			c.UseCases.User.RotateCalendarToken,
+			c.UseCases.User.RevokeCalendarToken,
+			c.UseCases.User.ListUsers,
+			c.UseCases.User.DisableUser,
+			c.UseCases.User.EnableUser,
+			c.UseCases.User.ChangeUserRoles,
+			c.UseCases.User.DeleteUser,
+			c.UseCases.User.VerifyLogin,
+			c.UseCases.User.EnrollTwoFactor,
+			c.UseCases.User.ConfirmTwoFactor,
+			c.UseCases.User.DisableTwoFactor,
+			c.UseCases.User.ClearTwoFactor,
+			c.UseCases.User.GetTwoFactorPolicy,
+			c.UseCases.User.UpdateTwoFactorPolicy,
 		),
 		Auth: handler.NewAuthHandler(
-			c.Config.Api.ApiKey,
 			c.Services.JWT,
-			c.Repositories.User,
-			c.Repositories.Campaign,
-			c.Repositories.PJ,
+			c.Repositories.AuthQueryService,
+			c.Repositories.MembershipQueryService,
+			c.Repositories.APIKey,
+			c.Services.Token,
 		),
 		Campaign: handler.NewCampaignHandler(
 			c.UseCases.Campaign.CreateCampaign,
@@ -284,17 +706,50 @@ func (c *DependencyContainer) initializeHandlers() {
 			c.UseCases.Campaign.GetCampaigns,
 			c.UseCases.Campaign.GetPjs,
 			c.UseCases.Campaign.GetInvitationsUseCase,
+			c.UseCases.Campaign.LearnSkill,
+			c.UseCases.Campaign.UnlockTransformation,
+			c.UseCases.Campaign.UpdateHouseRules,
+			c.UseCases.Campaign.GetHouseRules,
+			c.UseCases.Campaign.GetRulesets,
+		),
+		Session: handler.NewSessionHandler(
+			c.UseCases.Session.ListSessions,
+			c.UseCases.Session.GetSession,
+			c.UseCases.Session.AmendSession,
+			c.UseCases.Session.FinalizeSession,
+			c.UseCases.Session.RescheduleSession,
+			c.UseCases.Session.RSVPSession,
+			c.UseCases.Session.GetCalendarFeed,
+			c.UseCases.Session.GetSessionRecap,
+			c.UseCases.Session.SearchSessions,
+			c.Services.RecapRenderer,
+		),
+		JWKS: handler.NewJWKSHandler(c.Services.JWT),
+		APIKey: handler.NewAPIKeyHandler(
+			c.UseCases.User.CreateAPIKey,
+			c.UseCases.User.ListAPIKeys,
+			c.UseCases.User.RevokeAPIKey,
 		),
 	}
 }
 
-func (c *DependencyContainer) initializeRouter() {
-	c.APIRouter = api.NewRouter(&api.Handlers{
+func (c *DependencyContainer) initializeRouter() error {
+	router, err := api.NewRouter(&api.Handlers{
 		UserHandler:     c.Handlers.User,
 		AuthHandler:     c.Handlers.Auth,
 		CampaignHandler: c.Handlers.Campaign,
-	}, c.Config.Api.AllowedOrigins)
+		SessionHandler:  c.Handlers.Session,
+		JWKSHandler:     c.Handlers.JWKS,
+		APIKeyHandler:   c.Handlers.APIKey,
+	}, c.Config.Api.AllowedOrigins, c.Config.Api.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
+	c.APIRouter = router
 	logrus.Debug("Router initialized")
+
+	return nil
 }
 
 // Close gracefully closes all resources
@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"os"
 
+	"meye-core/internal/application/campaign/amendxp"
 	"meye-core/internal/application/campaign/consumexp"
+	"meye-core/internal/application/session/startduesessions"
 	"meye-core/internal/config"
+	"meye-core/internal/infrastructure/jwt"
 	"meye-core/internal/infrastructure/messaging/rabbitmq"
 	postgresCampaignRepo "meye-core/internal/infrastructure/repository/campaign/postgres"
+	postgresSessionRepo "meye-core/internal/infrastructure/repository/session/postgres"
 	"meye-core/internal/infrastructure/worker"
 
 	"github.com/joho/godotenv"
@@ -17,11 +21,14 @@ import (
 )
 
 type UseCases struct {
-	ConsumeXp *consumexp.UseCase
+	ConsumeXp        *consumexp.UseCase
+	AmendXp          *amendxp.UseCase
+	StartDueSessions *startduesessions.UseCase
 }
 
 type Repositories struct {
-	PJ *postgresCampaignRepo.PjRepository
+	PJ      *postgresCampaignRepo.PjRepository
+	Session *postgresSessionRepo.Repository
 }
 
 type Services struct {
@@ -36,6 +43,8 @@ type DependencyContainer struct {
 	UseCases     *UseCases
 	EventHandler *worker.EventHandler
 	Consumer     *rabbitmq.Consumer
+	Scheduler    *worker.SessionScheduler
+	KeyRotator   *worker.KeyRotator
 }
 
 func (c *DependencyContainer) loadEnvironment() error {
@@ -124,6 +133,11 @@ func NewDependencyContainer() (*DependencyContainer, error) {
 	container.initializeRepositories()
 	container.initializeUseCases()
 	container.initializeEventHandler()
+	container.initializeScheduler()
+
+	if err := container.initializeKeyRotator(); err != nil {
+		return nil, fmt.Errorf("failed to initialize key rotator: %w", err)
+	}
 
 	if err := container.initializeConsumer(); err != nil {
 		return nil, fmt.Errorf("failed to initialize consumer: %w", err)
@@ -134,7 +148,8 @@ func NewDependencyContainer() (*DependencyContainer, error) {
 
 func (c *DependencyContainer) initializeRepositories() {
 	c.Repositories = &Repositories{
-		PJ: postgresCampaignRepo.NewPjRepository(c.Database),
+		PJ:      postgresCampaignRepo.NewPjRepository(c.Database),
+		Session: postgresSessionRepo.New(c.Database),
 	}
 }
 
@@ -144,11 +159,41 @@ func (c *DependencyContainer) initializeUseCases() {
 			c.Repositories.PJ,
 			c.Services.EventPublisher,
 		),
+		AmendXp: amendxp.New(
+			c.Repositories.PJ,
+			c.Services.EventPublisher,
+		),
+		StartDueSessions: startduesessions.New(
+			c.Repositories.Session,
+			c.Services.EventPublisher,
+		),
 	}
 }
 
 func (c *DependencyContainer) initializeEventHandler() {
-	c.EventHandler = worker.NewEventHandler(c.UseCases.ConsumeXp)
+	c.EventHandler = worker.NewEventHandler(c.UseCases.ConsumeXp, c.UseCases.AmendXp)
+}
+
+func (c *DependencyContainer) initializeScheduler() {
+	c.Scheduler = worker.NewSessionScheduler(c.UseCases.StartDueSessions, c.Config.Scheduler.Interval)
+}
+
+// initializeKeyRotator writes the JWT signing keys shared with the API, for the methods using keys
+func (c *DependencyContainer) initializeKeyRotator() error {
+	cfg := c.Config.JWT
+
+	if cfg.SigningMethod == jwt.MethodHS256 {
+		return nil
+	}
+
+	keyring, err := jwt.NewKeyring(cfg.KeysDir, cfg.SigningMethod, cfg.ExpirationTime)
+	if err != nil {
+		return err
+	}
+
+	c.KeyRotator = worker.NewKeyRotator(keyring, cfg.KeyRotationInterval)
+
+	return nil
 }
 
 // Close gracefully closes all resources
@@ -31,6 +31,14 @@ func main() {
 		}
 	}()
 
+	// Start the session scheduler in a goroutine
+	go container.Scheduler.Start(ctx)
+
+	// Start the JWT key rotator in a goroutine
+	if container.KeyRotator != nil {
+		go container.KeyRotator.Start(ctx)
+	}
+
 	// Wait for interrupt signal or error
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
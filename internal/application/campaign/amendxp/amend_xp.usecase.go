@@ -0,0 +1,48 @@
+package amendxp
+
+import (
+	"context"
+	"errors"
+	applicationcampaign "meye-core/internal/application/campaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationcampaign.AmendXpUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	pjRepository    domaincampaign.PjRepository
+	eventsPublisher event.Publisher
+}
+
+func New(pjRepo domaincampaign.PjRepository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		pjRepository:    pjRepo,
+		eventsPublisher: eventPub,
+	}
+}
+
+// Execute applies the XP delta of an amended session. The amendment was checked when the session
+// was amended, but the PJ may have spent the XP since: the check is made again with the PJ locked,
+// and a delta that can't be applied any more is recorded as rejected instead of retried forever.
+func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.AmendXpInput) error {
+	pj, err := uc.pjRepository.Update(ctx, input.PjID, func(pj *domaincampaign.PJ) error {
+		err := pj.AmendXp(input.Delta.Basic, input.Delta.Special, input.Delta.Supernatural)
+		if errors.Is(err, domaincampaign.ErrXPAlreadySpent) {
+			pj.RejectXpAmendment(input.Delta.Basic, input.Delta.Special, input.Delta.Supernatural, err)
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if pj == nil {
+		return domaincampaign.ErrPjNotFound
+	}
+
+	return uc.eventsPublisher.Publish(ctx, pj.UncommittedEvents())
+}
@@ -0,0 +1,118 @@
+package amendxp_test
+
+import (
+	"context"
+	"errors"
+	applicationcampaign "meye-core/internal/application/campaign"
+	"meye-core/internal/application/campaign/amendxp"
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const pjID = "pj-id"
+
+func newPJ(xp domaincampaign.XP) *domaincampaign.PJ {
+	return domaincampaign.CreatePJWithoutValidation(
+		pjID, data.CampaignID, data.UserID, "pj", 0, 0, 0, 0, 0, 0, 0,
+		domaincampaign.PJTypeHuman,
+		domaincampaign.BasicStats{},
+		domaincampaign.SpecialStats{},
+		nil,
+		xp,
+		domaincampaign.DefaultRuleset(),
+	)
+}
+
+func TestAmendXpUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	tests := []struct {
+		name          string
+		pj            *domaincampaign.PJ
+		updateErr     error
+		delta         applicationcampaign.XpDelta
+		wantXP        domaincampaign.XP
+		wantEventType event.EventType
+		wantErr       error
+	}{
+		{
+			name:          "applies the delta",
+			pj:            newPJ(domaincampaign.CreateXPWithoutValidation(30, 10, 0)),
+			delta:         applicationcampaign.XpDelta{Basic: -10, Special: 5},
+			wantXP:        domaincampaign.CreateXPWithoutValidation(20, 15, 0),
+			wantEventType: event.EventTypeXpAmended,
+		},
+		{
+			name:          "records the rejection when the XP was spent since the session was amended",
+			pj:            newPJ(domaincampaign.CreateXPWithoutValidation(5, 10, 0)),
+			delta:         applicationcampaign.XpDelta{Basic: -10},
+			wantXP:        domaincampaign.CreateXPWithoutValidation(5, 10, 0),
+			wantEventType: event.EventTypeXpAmendmentRejected,
+		},
+		{
+			name:    "pj not found",
+			delta:   applicationcampaign.XpDelta{Basic: -10},
+			wantErr: domaincampaign.ErrPjNotFound,
+		},
+		{
+			name:      "error on update",
+			pj:        newPJ(domaincampaign.CreateXPWithoutValidation(30, 10, 0)),
+			updateErr: errTest,
+			delta:     applicationcampaign.XpDelta{Basic: -10},
+			wantErr:   errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pjRepoMock := mocks.NewMockPjRepository(ctrl)
+			publisherMock := mocks.NewMockPublisher(ctrl)
+
+			pjRepoMock.EXPECT().Update(ctx, pjID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, update func(*domaincampaign.PJ) error) (*domaincampaign.PJ, error) {
+					if tt.pj == nil {
+						return nil, nil
+					}
+
+					if tt.updateErr != nil {
+						return nil, tt.updateErr
+					}
+
+					if err := update(tt.pj); err != nil {
+						return nil, err
+					}
+
+					return tt.pj, nil
+				},
+			)
+
+			if tt.wantErr == nil {
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, tt.wantEventType, events[0].Type())
+					return nil
+				})
+			}
+
+			uc := amendxp.New(pjRepoMock, publisherMock)
+
+			err := uc.Execute(ctx, applicationcampaign.AmendXpInput{PjID: pjID, Delta: tt.delta})
+
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantXP, tt.pj.XP())
+			}
+		})
+	}
+}
@@ -20,7 +20,10 @@ func New(pjRepo domaincampaign.PjRepository, eventPub event.Publisher) *UseCase
 }
 
 func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.ConsumeXpInput) error {
-	pj, err := uc.pjRepository.FindByID(ctx, input.PjID)
+	pj, err := uc.pjRepository.Update(ctx, input.PjID, func(pj *domaincampaign.PJ) error {
+		pj.ConsumeXp(input.Xp.Basic, input.Xp.Special, input.Xp.Supernatural)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -29,11 +32,5 @@ func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.Consum
 		return domaincampaign.ErrPjNotFound
 	}
 
-	pj.ConsumeXp(input.Xp.Basic, input.Xp.Special, input.Xp.Supernatural)
-
-	if err = uc.pjRepository.Save(ctx, pj); err != nil {
-		return err
-	}
-
 	return uc.eventsPublisher.Publish(ctx, pj.UncommittedEvents())
 }
@@ -13,20 +13,47 @@ var _ applicationcampaign.CreateCampaignUseCase = (*UseCase)(nil)
 
 type UseCase struct {
 	campaignRepository    domaincampaign.Repository
+	rulesetRepository     domaincampaign.RulesetRepository
 	identificationService shared.IdentificationService
 	eventPublisher        event.Publisher
 }
 
-func New(campaignRepository domaincampaign.Repository, identificationService shared.IdentificationService, evtPub event.Publisher) *UseCase {
+func New(
+	campaignRepository domaincampaign.Repository,
+	rulesetRepository domaincampaign.RulesetRepository,
+	identificationService shared.IdentificationService,
+	evtPub event.Publisher,
+) *UseCase {
 	return &UseCase{
 		campaignRepository:    campaignRepository,
+		rulesetRepository:     rulesetRepository,
 		identificationService: identificationService,
 		eventPublisher:        evtPub,
 	}
 }
 
 func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.CreateCampaignInput) (applicationcampaign.CampaignOutput, error) {
-	campaign := domaincampaign.NewCampaign(input.MasterID, input.Name, uc.identificationService)
+	rulesetName := input.Ruleset
+	if rulesetName == "" {
+		rulesetName = domaincampaign.DefaultRulesetName
+	}
+
+	ruleset, err := uc.rulesetRepository.FindByName(ctx, rulesetName)
+	if err != nil {
+		return applicationcampaign.CampaignOutput{}, err
+	}
+
+	if ruleset == nil {
+		return applicationcampaign.CampaignOutput{}, domaincampaign.ErrRulesetNotFound
+	}
+
+	campaign := domaincampaign.NewCampaign(
+		input.MasterID,
+		input.Name,
+		applicationcampaign.MapToXP(input.StartingXP),
+		*ruleset,
+		uc.identificationService,
+	)
 
 	if err := uc.campaignRepository.Save(ctx, campaign); err != nil {
 		return applicationcampaign.CampaignOutput{}, err
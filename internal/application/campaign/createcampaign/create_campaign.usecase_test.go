@@ -0,0 +1,121 @@
+package createcampaign_test
+
+import (
+	"context"
+	"errors"
+	applicationcampaign "meye-core/internal/application/campaign"
+	"meye-core/internal/application/campaign/createcampaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCampaignUseCase_Execute(t *testing.T) {
+	var campaignRepoMock *mocks.MockCampaignRepository
+	var rulesetRepoMock *mocks.MockRulesetRepository
+	var idServiceMock *mocks.MockIdentificationService
+	var publisherMock *mocks.MockPublisher
+
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	heroic := domaincampaign.CreateRulesetWithoutValidation(domaincampaign.RulesetParameters{
+		Name:                       "heroic",
+		BasicLevelStep:             10,
+		BasicFirstLevelCost:        2,
+		SpecialLevelStep:           100,
+		SupernaturalLevelStep:      100,
+		SupernaturalFirstLevelCost: 1,
+	})
+
+	tests := []struct {
+		name        string
+		input       applicationcampaign.CreateCampaignInput
+		wantRuleset string
+		wantErr     error
+		setupMocks  func()
+	}{
+		{
+			name: "uses the default ruleset when none is given",
+			input: applicationcampaign.CreateCampaignInput{
+				Name:     data.CampaignName,
+				MasterID: data.CampaignMasterID,
+			},
+			wantRuleset: domaincampaign.DefaultRulesetName,
+			setupMocks: func() {
+				defaultRuleset := domaincampaign.DefaultRuleset()
+				rulesetRepoMock.EXPECT().FindByName(ctx, domaincampaign.DefaultRulesetName).Return(&defaultRuleset, nil)
+				idServiceMock.EXPECT().GenerateID().Return(data.CampaignID)
+				campaignRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "uses the requested ruleset",
+			input: applicationcampaign.CreateCampaignInput{
+				Name:     data.CampaignName,
+				MasterID: data.CampaignMasterID,
+				Ruleset:  "heroic",
+			},
+			wantRuleset: "heroic",
+			setupMocks: func() {
+				rulesetRepoMock.EXPECT().FindByName(ctx, "heroic").Return(&heroic, nil)
+				idServiceMock.EXPECT().GenerateID().Return(data.CampaignID)
+				campaignRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "unknown ruleset",
+			input: applicationcampaign.CreateCampaignInput{
+				Name:     data.CampaignName,
+				MasterID: data.CampaignMasterID,
+				Ruleset:  "unknown",
+			},
+			wantErr: domaincampaign.ErrRulesetNotFound,
+			setupMocks: func() {
+				rulesetRepoMock.EXPECT().FindByName(ctx, "unknown").Return(nil, nil)
+				campaignRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "error on ruleset lookup",
+			input: applicationcampaign.CreateCampaignInput{
+				Name:     data.CampaignName,
+				MasterID: data.CampaignMasterID,
+			},
+			wantErr: errTest,
+			setupMocks: func() {
+				rulesetRepoMock.EXPECT().FindByName(ctx, domaincampaign.DefaultRulesetName).Return(nil, errTest)
+				campaignRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			campaignRepoMock = mocks.NewMockCampaignRepository(ctrl)
+			rulesetRepoMock = mocks.NewMockRulesetRepository(ctrl)
+			idServiceMock = mocks.NewMockIdentificationService(ctrl)
+			publisherMock = mocks.NewMockPublisher(ctrl)
+
+			tt.setupMocks()
+
+			s := createcampaign.New(campaignRepoMock, rulesetRepoMock, idServiceMock, publisherMock)
+
+			output, err := s.Execute(ctx, tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantRuleset, output.Ruleset.Name)
+		})
+	}
+}
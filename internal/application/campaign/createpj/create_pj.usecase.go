@@ -72,6 +72,11 @@ func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.Create
 		IsEnergyTalented:         input.PJInfo.IsEnergyTalented,
 	}
 
+	if input.PJInfo.InitialStats != nil {
+		initialStats := applicationcampaign.MapToPjInitialStatsParameters(*input.PJInfo.InitialStats)
+		params.InitialStats = &initialStats
+	}
+
 	pj, err := camp.AddPJ(input.IDs.UserID, params, uc.identificationService)
 	if err != nil {
 		return applicationcampaign.PJOutput{}, err
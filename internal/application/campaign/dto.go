@@ -6,8 +6,10 @@ import (
 )
 
 type CreateCampaignInput struct {
-	Name     string
-	MasterID string
+	Name       string
+	MasterID   string
+	StartingXP XP
+	Ruleset    string // ruleset name, the default ruleset when empty
 }
 
 func MapCampaignOutput(c *campaign.Campaign) CampaignOutput {
@@ -30,6 +32,9 @@ func MapCampaignOutput(c *campaign.Campaign) CampaignOutput {
 		ID:          c.ID(),
 		Name:        c.Name(),
 		MasterID:    c.MasterID(),
+		StartingXP:  MapXPOutput(c.StartingXP()),
+		HouseRules:  MapHouseRulesOutput(c.HouseRules()),
+		Ruleset:     MapRulesetOutput(c.Ruleset()),
 		Invitations: invitations,
 		PJs:         pjs,
 		Sessions:    sessions,
@@ -40,11 +45,99 @@ type CampaignOutput struct {
 	ID          string
 	Name        string
 	MasterID    string
+	StartingXP  XP
+	HouseRules  HouseRules
+	Ruleset     Ruleset
 	Invitations []InvitationOutput
 	PJs         []PJOutput
 	Sessions    []session.SessionOutput
 }
 
+type HouseRules struct {
+	MaxTalents       uint
+	ExclusiveTalents [][]string
+	StatCaps         map[string]uint
+	AttendanceXP     XP
+	SessionXPCap     XP
+}
+
+func MapHouseRulesOutput(hr campaign.HouseRules) HouseRules {
+	exclusiveTalents := make([][]string, len(hr.ExclusiveTalents()))
+	for i, group := range hr.ExclusiveTalents() {
+		exclusiveTalents[i] = make([]string, len(group))
+		for j, talent := range group {
+			exclusiveTalents[i][j] = string(talent)
+		}
+	}
+
+	statCaps := make(map[string]uint, len(hr.StatCaps()))
+	for stat, limit := range hr.StatCaps() {
+		statCaps[string(stat)] = limit
+	}
+
+	return HouseRules{
+		MaxTalents:       hr.MaxTalents(),
+		ExclusiveTalents: exclusiveTalents,
+		StatCaps:         statCaps,
+		AttendanceXP:     MapXPOutput(hr.AttendanceXP()),
+		SessionXPCap:     MapXPOutput(hr.SessionXPCap()),
+	}
+}
+
+func MapToHouseRules(hr HouseRules) (campaign.HouseRules, error) {
+	exclusiveTalents := make([][]campaign.Talent, len(hr.ExclusiveTalents))
+	for i, group := range hr.ExclusiveTalents {
+		exclusiveTalents[i] = make([]campaign.Talent, len(group))
+		for j, talent := range group {
+			exclusiveTalents[i][j] = campaign.Talent(talent)
+		}
+	}
+
+	statCaps := make(map[campaign.Stat]uint, len(hr.StatCaps))
+	for stat, limit := range hr.StatCaps {
+		statCaps[campaign.Stat(stat)] = limit
+	}
+
+	return campaign.NewHouseRules(hr.MaxTalents, exclusiveTalents, statCaps, MapToXP(hr.AttendanceXP), MapToXP(hr.SessionXPCap))
+}
+
+type UpdateHouseRulesInput struct {
+	CampaignID string
+	HouseRules HouseRules
+}
+
+type Ruleset struct {
+	Name                          string
+	BasicLevelStep                uint
+	BasicFirstLevelCost           uint
+	BasicTalentedFirstLevelCost   uint
+	LifeCost                      uint
+	SpecialLevelStep              uint
+	SpecialFirstLevelCost         uint
+	SpecialTalentedFirstLevelCost uint
+	EnergyTankCost                uint
+	EnergyTankTalentedCost        uint
+	SupernaturalLevelStep         uint
+	SupernaturalFirstLevelCost    uint
+}
+
+func MapRulesetOutput(r campaign.Ruleset) Ruleset {
+	return Ruleset{
+		Name:                          r.Name(),
+		BasicLevelStep:                r.BasicLevelStep(),
+		BasicFirstLevelCost:           r.BasicFirstLevelCost(),
+		BasicTalentedFirstLevelCost:   r.BasicTalentedFirstLevelCost(),
+		LifeCost:                      r.LifeCost(),
+		SpecialLevelStep:              r.SpecialLevelStep(),
+		SpecialFirstLevelCost:         r.SpecialFirstLevelCost(),
+		SpecialTalentedFirstLevelCost: r.SpecialTalentedFirstLevelCost(),
+		EnergyTankCost:                r.EnergyTankCost(),
+		EnergyTankTalentedCost:        r.EnergyTankTalentedCost(),
+		SupernaturalLevelStep:         r.SupernaturalLevelStep(),
+		SupernaturalFirstLevelCost:    r.SupernaturalFirstLevelCost(),
+	}
+}
+
 type InviteUserInput struct {
 	CampaignID string
 	UserID     string
@@ -88,6 +181,40 @@ type CreatePJInfo struct {
 	IsMentalSkillsTalented   bool
 	IsEnergySkillsTalented   bool
 	IsEnergyTalented         bool
+	InitialStats             *InitialStats
+}
+
+type InitialSkill struct {
+	Name                      string
+	Description               string
+	TransformationName        string
+	TransformationDescription string
+	TransformationLevel       uint
+}
+
+type InitialStats struct {
+	Basic   BasicStats
+	Special SpecialStats
+	Skill   *InitialSkill
+}
+
+func MapToPjInitialStatsParameters(is InitialStats) campaign.PjInitialStatsParameters {
+	params := campaign.PjInitialStatsParameters{
+		BasicStats:   MapToBasicStatsParameters(is.Basic),
+		SpecialStats: MapToSpecialStatsParameters(is.Special),
+	}
+
+	if is.Skill != nil {
+		params.Skill = &campaign.InitialSkillParameters{
+			Name:                      is.Skill.Name,
+			Description:               is.Skill.Description,
+			TransformationName:        is.Skill.TransformationName,
+			TransformationDescription: is.Skill.TransformationDescription,
+			TransformationLevel:       is.Skill.TransformationLevel,
+		}
+	}
+
+	return params
 }
 
 type CreatePJInput struct {
@@ -151,8 +278,21 @@ type SpecialStats struct {
 	EnergyTank       uint
 	IsEnergyTalented bool
 }
+
+type Transformation struct {
+	ID          string
+	Name        string
+	Description string
+	Level       uint
+	UnlockCost  uint
+}
+
 type Skill struct {
-	Transformations []uint
+	ID              string
+	Name            string
+	Description     string
+	UnlockCost      uint
+	Transformations []Transformation
 }
 
 type SupernaturalStats struct {
@@ -165,6 +305,18 @@ type XP struct {
 	Supernatural uint
 }
 
+func MapXPOutput(xp campaign.XP) XP {
+	return XP{
+		Basic:        xp.Basic(),
+		Special:      xp.Special(),
+		Supernatural: xp.Supernatural(),
+	}
+}
+
+func MapToXP(xp XP) campaign.XP {
+	return campaign.CreateXPWithoutValidation(xp.Basic, xp.Special, xp.Supernatural)
+}
+
 type PJOutput struct {
 	ID                string
 	CampaignID        string
@@ -242,24 +394,14 @@ func MapPJOutput(pj *campaign.PJ) PJOutput {
 			EnergyTank:       pj.SpecialStats().EnergyTank(),
 			IsEnergyTalented: pj.SpecialStats().IsEnergyTalented(),
 		},
-		XP: XP{
-			Basic:        pj.XP().Basic(),
-			Special:      pj.XP().Special(),
-			Supernatural: pj.XP().Supernatural(),
-		},
-		SpentXP: XP{
-			Basic:        pj.SpentXP().Basic(),
-			Special:      pj.SpentXP().Special(),
-			Supernatural: pj.SpentXP().Supernatural(),
-		},
+		XP:      MapXPOutput(pj.XP()),
+		SpentXP: MapXPOutput(pj.SpentXP()),
 	}
 
 	if pj.SupernaturalStats() != nil {
 		skills := make([]Skill, len(pj.SupernaturalStats().Skills()))
 		for i, skill := range pj.SupernaturalStats().Skills() {
-			skills[i] = Skill{
-				Transformations: skill.Transformations(),
-			}
+			skills[i] = MapSkillOutput(skill)
 		}
 		output.SupernaturalStats = &SupernaturalStats{
 			Skills: skills,
@@ -269,6 +411,27 @@ func MapPJOutput(pj *campaign.PJ) PJOutput {
 	return output
 }
 
+func MapSkillOutput(s campaign.Skill) Skill {
+	transformations := make([]Transformation, len(s.Transformations()))
+	for i, t := range s.Transformations() {
+		transformations[i] = Transformation{
+			ID:          t.ID(),
+			Name:        t.Name(),
+			Description: t.Description(),
+			Level:       t.Level(),
+			UnlockCost:  t.UnlockCost(),
+		}
+	}
+
+	return Skill{
+		ID:              s.ID(),
+		Name:            s.Name(),
+		Description:     s.Description(),
+		UnlockCost:      s.UnlockCost(),
+		Transformations: transformations,
+	}
+}
+
 type XpAmounts struct {
 	Basic        uint
 	Special      uint
@@ -280,6 +443,18 @@ type ConsumeXpInput struct {
 	Xp   XpAmounts
 }
 
+// XpDelta can be negative, when an amended session takes back XP.
+type XpDelta struct {
+	Basic        int
+	Special      int
+	Supernatural int
+}
+
+type AmendXpInput struct {
+	PjID  string
+	Delta XpDelta
+}
+
 type UpdatePjStatsInput struct {
 	PjID         string
 	Basic        BasicStats
@@ -287,6 +462,38 @@ type UpdatePjStatsInput struct {
 	Supernatural *SupernaturalStats
 }
 
+type LearnSkillInput struct {
+	PjID                      string
+	Name                      string
+	Description               string
+	TransformationName        string
+	TransformationDescription string
+}
+
+func MapToLearnSkillParameters(input LearnSkillInput) campaign.LearnSkillParameters {
+	return campaign.LearnSkillParameters{
+		Name:                      input.Name,
+		Description:               input.Description,
+		TransformationName:        input.TransformationName,
+		TransformationDescription: input.TransformationDescription,
+	}
+}
+
+type UnlockTransformationInput struct {
+	PjID        string
+	SkillID     string
+	Name        string
+	Description string
+}
+
+func MapToUnlockTransformationParameters(input UnlockTransformationInput) campaign.UnlockTransformationParameters {
+	return campaign.UnlockTransformationParameters{
+		SkillID:     input.SkillID,
+		Name:        input.Name,
+		Description: input.Description,
+	}
+}
+
 func MapToPhysicalParameters(p Physical) campaign.PhysicalParameters {
 	return campaign.PhysicalParameters{
 		Strength:   p.Strength,
@@ -353,10 +560,24 @@ func MapToSpecialStatsParameters(ss SpecialStats) campaign.SpecialStatsParameter
 	}
 }
 
+func MapToTransformationParameters(t Transformation) campaign.TransformationParameters {
+	return campaign.TransformationParameters{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Level:       t.Level,
+	}
+}
+
 func MapToSkillParameters(s Skill) campaign.SkillParameters {
-	transformations := make([]uint, len(s.Transformations))
-	copy(transformations, s.Transformations)
+	transformations := make([]campaign.TransformationParameters, len(s.Transformations))
+	for i, t := range s.Transformations {
+		transformations[i] = MapToTransformationParameters(t)
+	}
 	return campaign.SkillParameters{
+		ID:              s.ID,
+		Name:            s.Name,
+		Description:     s.Description,
 		Transformations: transformations,
 	}
 }
@@ -0,0 +1,32 @@
+package gethouserules
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+)
+
+var _ applicationcampaign.GetHouseRulesUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	campaignRepository domaincampaign.Repository
+}
+
+func New(campRepo domaincampaign.Repository) *UseCase {
+	return &UseCase{
+		campaignRepository: campRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, campaignID string) (applicationcampaign.HouseRules, error) {
+	houseRules, err := uc.campaignRepository.FindHouseRules(ctx, campaignID)
+	if err != nil {
+		return applicationcampaign.HouseRules{}, err
+	}
+
+	if houseRules == nil {
+		return applicationcampaign.HouseRules{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	return applicationcampaign.MapHouseRulesOutput(*houseRules), nil
+}
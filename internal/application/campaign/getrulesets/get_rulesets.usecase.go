@@ -0,0 +1,33 @@
+package getrulesets
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+)
+
+var _ applicationcampaign.GetRulesetsUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	rulesetRepository domaincampaign.RulesetRepository
+}
+
+func New(rulesetRepo domaincampaign.RulesetRepository) *UseCase {
+	return &UseCase{
+		rulesetRepository: rulesetRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context) ([]applicationcampaign.Ruleset, error) {
+	rulesets, err := uc.rulesetRepository.FindAll(ctx)
+	if err != nil {
+		return []applicationcampaign.Ruleset{}, err
+	}
+
+	output := make([]applicationcampaign.Ruleset, 0, len(rulesets))
+	for _, ruleset := range rulesets {
+		output = append(output, applicationcampaign.MapRulesetOutput(ruleset))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,46 @@
+package learnskill
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/shared"
+)
+
+var _ applicationcampaign.LearnSkillUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	pjRepository          domaincampaign.PjRepository
+	identificationService shared.IdentificationService
+	skillRules            domaincampaign.SkillRules
+}
+
+func New(
+	pjRepository domaincampaign.PjRepository,
+	identificationService shared.IdentificationService,
+	skillRules domaincampaign.SkillRules,
+) *UseCase {
+	return &UseCase{
+		pjRepository:          pjRepository,
+		identificationService: identificationService,
+		skillRules:            skillRules,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.LearnSkillInput) (applicationcampaign.PJOutput, error) {
+	params := applicationcampaign.MapToLearnSkillParameters(input)
+
+	pj, err := uc.pjRepository.Update(ctx, input.PjID, func(pj *domaincampaign.PJ) error {
+		_, err := pj.LearnSkill(params, uc.skillRules, uc.identificationService)
+		return err
+	})
+	if err != nil {
+		return applicationcampaign.PJOutput{}, err
+	}
+
+	if pj == nil {
+		return applicationcampaign.PJOutput{}, domaincampaign.ErrPjNotFound
+	}
+
+	return applicationcampaign.MapPJOutput(pj), nil
+}
@@ -20,10 +20,34 @@ type ConsumeXpUseCase interface {
 	Execute(ctx context.Context, input ConsumeXpInput) error
 }
 
+type AmendXpUseCase interface {
+	Execute(ctx context.Context, input AmendXpInput) error
+}
+
 type UpdateStatsUseCase interface {
 	Execute(ctx context.Context, input UpdatePjStatsInput) (PJOutput, error)
 }
 
+type LearnSkillUseCase interface {
+	Execute(ctx context.Context, input LearnSkillInput) (PJOutput, error)
+}
+
+type UnlockTransformationUseCase interface {
+	Execute(ctx context.Context, input UnlockTransformationInput) (PJOutput, error)
+}
+
+type UpdateHouseRulesUseCase interface {
+	Execute(ctx context.Context, input UpdateHouseRulesInput) (HouseRules, error)
+}
+
+type GetHouseRulesUseCase interface {
+	Execute(ctx context.Context, campaignID string) (HouseRules, error)
+}
+
+type GetRulesetsUseCase interface {
+	Execute(ctx context.Context) ([]Ruleset, error)
+}
+
 type GetCampaignUseCase interface {
 	Execute(ctx context.Context, campID string) (CampaignOutput, error)
 }
@@ -0,0 +1,46 @@
+package unlocktransformation
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/shared"
+)
+
+var _ applicationcampaign.UnlockTransformationUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	pjRepository          domaincampaign.PjRepository
+	identificationService shared.IdentificationService
+	skillRules            domaincampaign.SkillRules
+}
+
+func New(
+	pjRepository domaincampaign.PjRepository,
+	identificationService shared.IdentificationService,
+	skillRules domaincampaign.SkillRules,
+) *UseCase {
+	return &UseCase{
+		pjRepository:          pjRepository,
+		identificationService: identificationService,
+		skillRules:            skillRules,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.UnlockTransformationInput) (applicationcampaign.PJOutput, error) {
+	params := applicationcampaign.MapToUnlockTransformationParameters(input)
+
+	pj, err := uc.pjRepository.Update(ctx, input.PjID, func(pj *domaincampaign.PJ) error {
+		_, err := pj.UnlockTransformation(params, uc.skillRules, uc.identificationService)
+		return err
+	})
+	if err != nil {
+		return applicationcampaign.PJOutput{}, err
+	}
+
+	if pj == nil {
+		return applicationcampaign.PJOutput{}, domaincampaign.ErrPjNotFound
+	}
+
+	return applicationcampaign.MapPJOutput(pj), nil
+}
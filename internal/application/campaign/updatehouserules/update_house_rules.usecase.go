@@ -0,0 +1,50 @@
+package updatehouserules
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+)
+
+var _ applicationcampaign.UpdateHouseRulesUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	campaignRepository domaincampaign.Repository
+	eventPublisher     event.Publisher
+}
+
+func New(campRepo domaincampaign.Repository, evtPub event.Publisher) *UseCase {
+	return &UseCase{
+		campaignRepository: campRepo,
+		eventPublisher:     evtPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.UpdateHouseRulesInput) (applicationcampaign.HouseRules, error) {
+	houseRules, err := applicationcampaign.MapToHouseRules(input.HouseRules)
+	if err != nil {
+		return applicationcampaign.HouseRules{}, err
+	}
+
+	camp, err := uc.campaignRepository.FindByID(ctx, input.CampaignID)
+	if err != nil {
+		return applicationcampaign.HouseRules{}, err
+	}
+
+	if camp == nil {
+		return applicationcampaign.HouseRules{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	camp.UpdateHouseRules(houseRules)
+
+	if err = uc.campaignRepository.Save(ctx, camp); err != nil {
+		return applicationcampaign.HouseRules{}, err
+	}
+
+	if err = uc.eventPublisher.Publish(ctx, camp.UncommittedEvents()); err != nil {
+		return applicationcampaign.HouseRules{}, err
+	}
+
+	return applicationcampaign.MapHouseRulesOutput(camp.HouseRules()), nil
+}
@@ -9,35 +9,38 @@ import (
 var _ applicationcampaign.UpdateStatsUseCase = (*UseCase)(nil)
 
 type UseCase struct {
-	pjRepository domaincampaign.PjRepository
+	pjRepository       domaincampaign.PjRepository
+	campaignRepository domaincampaign.Repository
 }
 
-func New(pjRepository domaincampaign.PjRepository) *UseCase {
+func New(pjRepository domaincampaign.PjRepository, campaignRepository domaincampaign.Repository) *UseCase {
 	return &UseCase{
-		pjRepository: pjRepository,
+		pjRepository:       pjRepository,
+		campaignRepository: campaignRepository,
 	}
 }
 
 func (uc *UseCase) Execute(ctx context.Context, input applicationcampaign.UpdatePjStatsInput) (applicationcampaign.PJOutput, error) {
-	pj, err := uc.pjRepository.FindByID(ctx, input.PjID)
-	if err != nil {
-		return applicationcampaign.PJOutput{}, err
-	}
+	updateParams := applicationcampaign.MapToUpdatePjStatsParameters(input)
 
-	if pj == nil {
-		return applicationcampaign.PJOutput{}, domaincampaign.ErrPjNotFound
-	}
+	pj, err := uc.pjRepository.Update(ctx, input.PjID, func(pj *domaincampaign.PJ) error {
+		houseRules, err := uc.campaignRepository.FindHouseRules(ctx, pj.CampaignID())
+		if err != nil {
+			return err
+		}
 
-	updateParams := applicationcampaign.MapToUpdatePjStatsParameters(input)
+		if houseRules == nil {
+			return applicationcampaign.ErrCampaignNotFound
+		}
 
-	err = pj.UpdateStats(updateParams)
+		return pj.UpdateStats(updateParams, *houseRules)
+	})
 	if err != nil {
 		return applicationcampaign.PJOutput{}, err
 	}
 
-	err = uc.pjRepository.Save(ctx, pj)
-	if err != nil {
-		return applicationcampaign.PJOutput{}, err
+	if pj == nil {
+		return applicationcampaign.PJOutput{}, domaincampaign.ErrPjNotFound
 	}
 
 	output := applicationcampaign.MapPJOutput(pj)
@@ -0,0 +1,93 @@
+package amendsession
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	domainsession "meye-core/internal/domain/session"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.AmendSessionUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository  domainsession.Repository
+	campaignRepository campaign.Repository
+	eventPublisher     event.Publisher
+}
+
+func New(sessRepo domainsession.Repository, campRepo campaign.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		sessionRepository:  sessRepo,
+		campaignRepository: campRepo,
+		eventPublisher:     eventPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.AmendSessionInput) (applicationsession.SessionOutput, error) {
+	session, err := uc.sessionRepository.FindByID(ctx, input.SessionID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if session == nil || session.CampaignID() != input.CampaignID {
+		return applicationsession.SessionOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	camp, err := uc.campaignRepository.FindByID(ctx, input.CampaignID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if camp == nil {
+		return applicationsession.SessionOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	if err = camp.MustContainPjs(input.Attendees); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	// Attendance lines are regenerated from the attendees with the base XP of the session
+	bonuses := applicationsession.MapXPBonusesInput(input.XPAssignations)
+
+	xpAssignations, err := session.MergeXPAssignations(input.Attendees, bonuses)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = camp.ValidateSessionXP(xpAssignations); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	// Planned and draft sessions haven't handed out any XP yet, so they are edited without compensations
+	if !session.IsFinalized() {
+		err = session.UpdateDraft(input.Summary, input.Attendees, bonuses)
+	} else {
+		err = amend(camp, session, input.Summary, input.Attendees, bonuses)
+	}
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.sessionRepository.Save(ctx, session); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.eventPublisher.Publish(ctx, session.UncommittedEvents()); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	return applicationsession.MapSessionOutput(session), nil
+}
+
+func amend(camp *campaign.Campaign, session *domainsession.Session, summary string, attendees []string, bonuses []domainsession.XPAssignation) error {
+	if err := camp.CanAmendSessionXP(session.ComputeXPDeltas(attendees, bonuses)); err != nil {
+		return err
+	}
+
+	_, err := session.Amend(summary, attendees, bonuses)
+
+	return err
+}
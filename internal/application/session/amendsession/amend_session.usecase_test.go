@@ -0,0 +1,153 @@
+package amendsession_test
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/amendsession"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionID = "session-id"
+	pjID      = "pj-id"
+)
+
+func newCampaign(pjXP campaign.XP) *campaign.Campaign {
+	pj := campaign.CreatePJWithoutValidation(
+		pjID, data.CampaignID, data.UserID, "pj", 0, 0, 0, 0, 0, 0, 0,
+		campaign.PJTypeHuman,
+		campaign.BasicStats{},
+		campaign.SpecialStats{},
+		nil,
+		pjXP,
+		campaign.DefaultRuleset(),
+	)
+
+	return campaign.CreateCampaignWithoutValidation(
+		data.CampaignID, data.CampaignMasterID, data.CampaignName,
+		campaign.XP{}, campaign.HouseRules{}, campaign.DefaultRuleset(),
+		[]*campaign.Invitation{},
+		[]*campaign.PJ{pj},
+		[]*session.Session{},
+	)
+}
+
+func newSession(status session.Status) *session.Session {
+	return session.CreateSessionWithoutValidation(sessionID, data.CampaignID, "summary", status, time.Now(), nil, session.XPAmounts{}, []session.XPAssignation{
+		session.NewXPAssignation(pjID, 50, 10, 0, "typo"),
+	}, nil, nil)
+}
+
+func amendInput() applicationsession.AmendSessionInput {
+	return applicationsession.AmendSessionInput{
+		CampaignID: data.CampaignID,
+		SessionID:  sessionID,
+		Summary:    "fixed summary",
+		XPAssignations: []applicationsession.XPAssignation{
+			{PjID: pjID, Amounts: applicationsession.XPAmounts{Basic: 5, Special: 10}, Reason: "fixed"},
+		},
+	}
+}
+
+func TestAmendSessionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("emits the XP delta of the amended PJs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := mocks.NewMockSessionRepository(ctrl)
+		campaignRepo := mocks.NewMockCampaignRepository(ctrl)
+		publisher := mocks.NewMockPublisher(ctrl)
+
+		sessionRepo.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusFinalized), nil)
+		campaignRepo.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.CreateXPWithoutValidation(45, 0, 0)), nil)
+		sessionRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		publisher.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+			require.Len(t, events, 1)
+			amended, ok := events[0].(session.XPAssignationAmendedEvent)
+			require.True(t, ok)
+			assert.Equal(t, pjID, amended.AggregateID())
+			assert.Equal(t, -45, amended.Delta().Basic())
+			assert.Equal(t, 0, amended.Delta().Special())
+			return nil
+		})
+
+		uc := amendsession.New(sessionRepo, campaignRepo, publisher)
+		output, err := uc.Execute(ctx, amendInput())
+
+		require.NoError(t, err)
+		assert.Equal(t, "fixed summary", output.Summary)
+		assert.Equal(t, uint(5), output.XPAssignations[0].Amounts.Basic)
+	})
+
+	t.Run("refuses to take back XP already spent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := mocks.NewMockSessionRepository(ctrl)
+		campaignRepo := mocks.NewMockCampaignRepository(ctrl)
+		publisher := mocks.NewMockPublisher(ctrl)
+
+		sessionRepo.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusFinalized), nil)
+		campaignRepo.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.CreateXPWithoutValidation(30, 0, 0)), nil)
+
+		uc := amendsession.New(sessionRepo, campaignRepo, publisher)
+		_, err := uc.Execute(ctx, amendInput())
+
+		assert.ErrorIs(t, err, campaign.ErrXPAlreadySpent)
+	})
+
+	t.Run("drafts are edited without handing out XP", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := mocks.NewMockSessionRepository(ctrl)
+		campaignRepo := mocks.NewMockCampaignRepository(ctrl)
+		publisher := mocks.NewMockPublisher(ctrl)
+
+		sessionRepo.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+		campaignRepo.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.XP{}), nil)
+		sessionRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		publisher.EXPECT().Publish(ctx, gomock.Len(0)).Return(nil)
+
+		input := amendInput()
+		input.Attendees = []string{pjID}
+
+		uc := amendsession.New(sessionRepo, campaignRepo, publisher)
+		output, err := uc.Execute(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, string(session.StatusDraft), output.Status)
+		assert.Equal(t, []string{pjID}, output.Attendees)
+	})
+
+	t.Run("session of another campaign", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := mocks.NewMockSessionRepository(ctrl)
+		campaignRepo := mocks.NewMockCampaignRepository(ctrl)
+		publisher := mocks.NewMockPublisher(ctrl)
+
+		sessionRepo.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusFinalized), nil)
+
+		input := amendInput()
+		input.CampaignID = "another-campaign-id"
+
+		uc := amendsession.New(sessionRepo, campaignRepo, publisher)
+		_, err := uc.Execute(ctx, input)
+
+		assert.ErrorIs(t, err, applicationsession.ErrSessionNotFound)
+	})
+}
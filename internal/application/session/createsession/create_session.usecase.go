@@ -36,27 +36,37 @@ func (uc *UseCase) Execute(ctx context.Context, input applicationsession.CreateS
 		return applicationsession.SessionOutput{}, err
 	}
 
-	xpALength := len(input.XPAssignations)
-
-	pjIDs := make([]string, 0, xpALength)
-	for _, xpA := range input.XPAssignations {
-		pjIDs = append(pjIDs, xpA.PjID)
-	}
-
-	if err = camp.MustContainPjs(pjIDs); err != nil {
+	if err = camp.MustContainPjs(input.Attendees); err != nil {
 		return applicationsession.SessionOutput{}, err
 	}
 
-	xpAssignations := make([]domainsession.XPAssignation, 0, xpALength)
-	for _, xpInput := range input.XPAssignations {
-		xpAssignations = append(xpAssignations, domainsession.NewXPAssignation(xpInput.PjID, xpInput.Amounts.Basic, xpInput.Amounts.Special, xpInput.Amounts.SuperNatural, xpInput.Reason))
-	}
+	bonuses := applicationsession.MapXPBonusesInput(input.XPAssignations)
+
+	// Every attendee earns the campaign base XP, the explicit lines are bonuses on top
+	attendanceXP := camp.SessionAttendanceXP()
 
-	session, err := domainsession.NewSession(camp.MasterID(), input.CampaignID, input.Summary, xpAssignations, uc.identificationService)
+	var session *domainsession.Session
+	switch {
+	case input.Schedule != nil:
+		schedule, err := applicationsession.MapScheduleInput(*input.Schedule)
+		if err != nil {
+			return applicationsession.SessionOutput{}, err
+		}
+
+		session, err = domainsession.NewPlannedSession(input.CampaignID, input.Summary, input.Attendees, attendanceXP, bonuses, schedule, uc.identificationService)
+	case input.Draft:
+		session, err = domainsession.NewDraftSession(input.CampaignID, input.Summary, input.Attendees, attendanceXP, bonuses, uc.identificationService)
+	default:
+		session, err = domainsession.NewSession(camp.MasterID(), input.CampaignID, input.Summary, input.Attendees, attendanceXP, bonuses, uc.identificationService)
+	}
 	if err != nil {
 		return applicationsession.SessionOutput{}, err
 	}
 
+	if err = camp.ValidateSessionXP(session.XPAssignations()); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
 	err = uc.sessionRepository.Save(ctx, session)
 	if err != nil {
 		return applicationsession.SessionOutput{}, err
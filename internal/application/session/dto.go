@@ -1,7 +1,10 @@
 package session
 
 import (
+	"context"
+	"meye-core/internal/domain/campaign"
 	"meye-core/internal/domain/session"
+	"slices"
 	"time"
 )
 
@@ -13,31 +16,107 @@ type XPAmounts struct {
 
 type XPAssignation struct {
 	PjID    string
+	Kind    string // attendance or bonus
 	Amounts XPAmounts
 	Reason  string
 }
 
+// MapXPBonusesInput maps the XP lines given by the master. Attendance lines are left out, the
+// session generates them from its attendees.
+func MapXPBonusesInput(xpAssignations []XPAssignation) []session.XPAssignation {
+	bonuses := make([]session.XPAssignation, 0, len(xpAssignations))
+	for _, xpA := range xpAssignations {
+		if session.XPKind(xpA.Kind) == session.XPKindAttendance {
+			continue
+		}
+
+		bonuses = append(bonuses, session.NewXPAssignation(xpA.PjID, xpA.Amounts.Basic, xpA.Amounts.Special, xpA.Amounts.SuperNatural, xpA.Reason))
+	}
+
+	return bonuses
+}
+
+type Schedule struct {
+	StartsAt        time.Time
+	DurationMinutes uint
+	Location        string
+}
+
+func MapScheduleInput(schedule Schedule) (session.Schedule, error) {
+	return session.NewSchedule(schedule.StartsAt, time.Duration(schedule.DurationMinutes)*time.Minute, schedule.Location)
+}
+
+type RSVP struct {
+	UserID   string
+	Response string
+}
+
 type CreateSessionInput struct {
 	CampaignID     string
 	Summary        string
+	Draft          bool      // drafts don't hand out XP until finalized
+	Schedule       *Schedule // planned sessions become drafts once they start
+	Attendees      []string
 	XPAssignations []XPAssignation
 }
 
+type AmendSessionInput struct {
+	CampaignID     string
+	SessionID      string
+	Summary        string
+	Attendees      []string
+	XPAssignations []XPAssignation
+}
+
+type FinalizeSessionInput struct {
+	CampaignID string
+	SessionID  string
+}
+
+type RescheduleSessionInput struct {
+	CampaignID string
+	SessionID  string
+	Schedule   Schedule
+}
+
+type RSVPSessionInput struct {
+	SessionID string
+	UserID    string
+	Response  string
+}
+
+type StartDueSessionsInput struct {
+	Now time.Time
+}
+
 type SessionOutput struct {
 	ID             string
 	CampaignID     string
 	Summary        string
+	Status         string
+	Attendees      []string
 	XPAssignations []XPAssignation
+	Schedule       *Schedule
+	RSVPs          []RSVP
 	CreatedAt      time.Time
 }
 
 func MapSessionOutput(s *session.Session) SessionOutput {
+	return mapSessionOutput(s, func(session.XPAssignation) bool { return true })
+}
+
+func mapSessionOutput(s *session.Session, isVisible func(session.XPAssignation) bool) SessionOutput {
 	sessionXpAssignations := s.XPAssignations()
 	xpAssignations := make([]XPAssignation, 0, len(sessionXpAssignations))
 
 	for _, xpA := range sessionXpAssignations {
+		if !isVisible(xpA) {
+			continue
+		}
+
 		xpAssignations = append(xpAssignations, XPAssignation{
 			PjID: xpA.PjID(),
+			Kind: string(xpA.Kind()),
 			Amounts: XPAmounts{
 				Basic:        xpA.Basic(),
 				Special:      xpA.Special(),
@@ -47,11 +126,207 @@ func MapSessionOutput(s *session.Session) SessionOutput {
 		})
 	}
 
+	var schedule *Schedule
+	if sc := s.Schedule(); sc != nil {
+		schedule = &Schedule{
+			StartsAt:        sc.StartsAt(),
+			DurationMinutes: uint(sc.Duration().Minutes()),
+			Location:        sc.Location(),
+		}
+	}
+
+	sessionRSVPs := s.RSVPs()
+	rsvps := make([]RSVP, 0, len(sessionRSVPs))
+	for _, rsvp := range sessionRSVPs {
+		rsvps = append(rsvps, RSVP{
+			UserID:   rsvp.UserID(),
+			Response: string(rsvp.Response()),
+		})
+	}
+
 	return SessionOutput{
 		ID:             s.ID(),
 		CampaignID:     s.CampaignID(),
 		Summary:        s.Summary(),
+		Status:         string(s.Status()),
+		Attendees:      s.Attendees(),
 		XPAssignations: xpAssignations,
+		Schedule:       schedule,
+		RSVPs:          rsvps,
 		CreatedAt:      s.CreatedAt(),
 	}
 }
+
+// SessionViewer decides which campaign sessions and XP lines a user can see. The campaign master
+// sees every session and line, players neither drafts nor the lines of PJs they don't own.
+type SessionViewer struct {
+	isMaster bool
+	pjIDs    []string
+}
+
+func NewSessionViewer(masterID, userID string, userPjIDs []string) (SessionViewer, error) {
+	if masterID == userID {
+		return SessionViewer{isMaster: true}, nil
+	}
+
+	if len(userPjIDs) == 0 {
+		return SessionViewer{}, ErrNotCampaignMember
+	}
+
+	return SessionViewer{pjIDs: userPjIDs}, nil
+}
+
+// FindSessionViewer looks up the PJs the user owns in the campaign, unless they are its master.
+func FindSessionViewer(
+	ctx context.Context,
+	membershipQueryService campaign.MembershipQueryService,
+	campaignID, masterID, userID string,
+) (SessionViewer, error) {
+	if masterID == userID {
+		return NewSessionViewer(masterID, userID, nil)
+	}
+
+	userPjIDs, err := membershipQueryService.FindUserPjIDs(ctx, campaignID, userID)
+	if err != nil {
+		return SessionViewer{}, err
+	}
+
+	return NewSessionViewer(masterID, userID, userPjIDs)
+}
+
+// CanSeeDrafts reports whether the user can see the sessions being written.
+func (v SessionViewer) CanSeeDrafts() bool {
+	return v.isMaster
+}
+
+func (v SessionViewer) CanSee(s *session.Session) bool {
+	return !s.IsDraft() || v.CanSeeDrafts()
+}
+
+// CanSeeEveryPj reports whether the user can see the XP of every PJ of the campaign.
+func (v SessionViewer) CanSeeEveryPj() bool {
+	return v.isMaster
+}
+
+// CanSeePj reports whether the user can see the XP and progression of the PJ.
+func (v SessionViewer) CanSeePj(pjID string) bool {
+	if v.CanSeeEveryPj() {
+		return true
+	}
+
+	return slices.Contains(v.pjIDs, pjID)
+}
+
+// PjIDs returns the PJs the user owns, none for the master who sees them all.
+func (v SessionViewer) PjIDs() []string {
+	return v.pjIDs
+}
+
+func (v SessionViewer) MapSessionOutput(s *session.Session) SessionOutput {
+	return mapSessionOutput(s, func(xpA session.XPAssignation) bool {
+		return v.CanSeePj(xpA.PjID())
+	})
+}
+
+type ListSessionsInput struct {
+	CampaignID string
+	UserID     string
+	From       *time.Time // inclusive
+	To         *time.Time // exclusive
+	Cursor     string
+	Limit      int
+}
+
+type SessionsPageOutput struct {
+	Sessions   []SessionOutput
+	NextCursor string
+}
+
+type GetSessionInput struct {
+	SessionID string
+	UserID    string
+}
+
+type GetCalendarFeedInput struct {
+	Token string
+}
+
+type CalendarEventOutput struct {
+	SessionID    string
+	CampaignName string
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Location     string
+	Sequence     uint
+}
+
+type CalendarFeedOutput struct {
+	Username string
+	Events   []CalendarEventOutput
+}
+
+type GetSessionRecapInput struct {
+	SessionID string
+	UserID    string
+}
+
+type RecapPJ struct {
+	ID   string
+	Name string
+}
+
+type RecapXPAssignation struct {
+	PJ      RecapPJ
+	Kind    string
+	Amounts XPAmounts
+	Reason  string
+}
+
+type StatChange struct {
+	Stat     string
+	Previous uint
+	Current  uint
+}
+
+type RecapStatsUpgrade struct {
+	PJ         RecapPJ
+	SpentXP    XPAmounts
+	Changes    []StatChange
+	OccurredAt time.Time
+}
+
+type SessionRecapOutput struct {
+	SessionID      string
+	CampaignName   string
+	Summary        string
+	Status         string
+	PlayedAt       time.Time // start of the schedule, or creation of unplanned sessions
+	Attendees      []RecapPJ
+	XPAssignations []RecapXPAssignation
+	StatsUpgrades  []RecapStatsUpgrade // bought since the previous finalized session
+}
+
+type SearchSessionsInput struct {
+	CampaignID string
+	UserID     string
+	Query      string
+	Limit      int
+}
+
+type ReasonMatchOutput struct {
+	PjID    string
+	Snippet string
+}
+
+type SearchResultOutput struct {
+	SessionID      string
+	Status         string
+	CreatedAt      time.Time
+	Rank           float64
+	SummarySnippet string
+	ReasonMatches  []ReasonMatchOutput
+}
+
+type SearchSessionsOutput struct {
+	Results []SearchResultOutput
+}
@@ -0,0 +1,9 @@
+package session
+
+import "errors"
+
+var (
+	ErrSessionNotFound   = errors.New("ERR_SESSION_NOT_FOUND")
+	ErrNotCampaignMember = errors.New("ERR_NOT_CAMPAIGN_MEMBER")
+	ErrCalendarNotFound  = errors.New("ERR_CALENDAR_NOT_FOUND")
+)
@@ -0,0 +1,66 @@
+package finalizesession
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	domainsession "meye-core/internal/domain/session"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.FinalizeSessionUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository  domainsession.Repository
+	campaignRepository campaign.Repository
+	eventPublisher     event.Publisher
+}
+
+func New(sessRepo domainsession.Repository, campRepo campaign.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		sessionRepository:  sessRepo,
+		campaignRepository: campRepo,
+		eventPublisher:     eventPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.FinalizeSessionInput) (applicationsession.SessionOutput, error) {
+	session, err := uc.sessionRepository.FindByID(ctx, input.SessionID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if session == nil || session.CampaignID() != input.CampaignID {
+		return applicationsession.SessionOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	camp, err := uc.campaignRepository.FindByID(ctx, input.CampaignID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if camp == nil {
+		return applicationsession.SessionOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	// The house rules and the PJ types may have changed since the draft was written
+	if err = camp.ValidateSessionXP(session.XPAssignations()); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = session.Finalize(); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.sessionRepository.Save(ctx, session); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.eventPublisher.Publish(ctx, session.UncommittedEvents()); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	return applicationsession.MapSessionOutput(session), nil
+}
@@ -0,0 +1,187 @@
+package finalizesession_test
+
+import (
+	"context"
+	"errors"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/finalizesession"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionID = "session-id"
+	pjID      = "pj-id"
+)
+
+func newCampaign(sessionXPCap campaign.XP) *campaign.Campaign {
+	pj := campaign.CreatePJWithoutValidation(
+		pjID, data.CampaignID, data.UserID, "pj", 0, 0, 0, 0, 0, 0, 0,
+		campaign.PJTypeHuman,
+		campaign.BasicStats{},
+		campaign.SpecialStats{},
+		nil,
+		campaign.XP{},
+		campaign.DefaultRuleset(),
+	)
+
+	return campaign.CreateCampaignWithoutValidation(
+		data.CampaignID, data.CampaignMasterID, data.CampaignName,
+		campaign.XP{},
+		campaign.CreateHouseRulesWithoutValidation(0, nil, nil, campaign.XP{}, sessionXPCap),
+		campaign.DefaultRuleset(),
+		[]*campaign.Invitation{},
+		[]*campaign.PJ{pj},
+		[]*session.Session{},
+	)
+}
+
+func newSession(status session.Status) *session.Session {
+	return session.CreateSessionWithoutValidation(
+		sessionID, data.CampaignID, "summary", status, time.Now(), nil, session.XPAmounts{},
+		[]session.XPAssignation{session.NewXPAssignation(pjID, 10, 0, 0, "bonus")},
+		nil, nil,
+	)
+}
+
+func TestFinalizeSessionUseCase_Execute(t *testing.T) {
+	var sessionRepoMock *mocks.MockSessionRepository
+	var campaignRepoMock *mocks.MockCampaignRepository
+	var publisherMock *mocks.MockPublisher
+
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	defaultInput := applicationsession.FinalizeSessionInput{
+		CampaignID: data.CampaignID,
+		SessionID:  sessionID,
+	}
+
+	tests := []struct {
+		name       string
+		input      applicationsession.FinalizeSessionInput
+		wantStatus string
+		wantErr    error
+		setupMocks func()
+	}{
+		{
+			name:       "finalizes the draft and hands out its XP",
+			input:      defaultInput,
+			wantStatus: string(session.StatusFinalized),
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				campaignRepoMock.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.XP{}), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 2)
+					assert.Equal(t, event.EventTypeSessionFinalized, events[0].Type())
+					assert.Equal(t, event.EventTypeXPAssigned, events[1].Type())
+					return nil
+				})
+			},
+		},
+		{
+			name:    "session already finalized",
+			input:   defaultInput,
+			wantErr: session.ErrSessionFinalized,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusFinalized), nil)
+				campaignRepoMock.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.XP{}), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "planned sessions are started before being finalized",
+			input:   defaultInput,
+			wantErr: session.ErrSessionPlanned,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				campaignRepoMock.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.XP{}), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "session of another campaign",
+			input: applicationsession.FinalizeSessionInput{
+				CampaignID: "other-campaign-id",
+				SessionID:  sessionID,
+			},
+			wantErr: applicationsession.ErrSessionNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "session not found",
+			input:   defaultInput,
+			wantErr: applicationsession.ErrSessionNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(nil, nil)
+			},
+		},
+		{
+			name:    "the session XP cap was lowered since the draft was written",
+			input:   defaultInput,
+			wantErr: campaign.ErrSessionXPCapExceeded,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				campaignRepoMock.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.CreateXPWithoutValidation(5, 0, 0)), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "campaign not found",
+			input:   defaultInput,
+			wantErr: applicationcampaign.ErrCampaignNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				campaignRepoMock.EXPECT().FindByID(ctx, data.CampaignID).Return(nil, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "error on repository save",
+			input:   defaultInput,
+			wantErr: errTest,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				campaignRepoMock.EXPECT().FindByID(ctx, data.CampaignID).Return(newCampaign(campaign.XP{}), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(errTest)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionRepoMock = mocks.NewMockSessionRepository(ctrl)
+			campaignRepoMock = mocks.NewMockCampaignRepository(ctrl)
+			publisherMock = mocks.NewMockPublisher(ctrl)
+
+			tt.setupMocks()
+
+			uc := finalizesession.New(sessionRepoMock, campaignRepoMock, publisherMock)
+
+			output, err := uc.Execute(ctx, tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantStatus, output.Status)
+		})
+	}
+}
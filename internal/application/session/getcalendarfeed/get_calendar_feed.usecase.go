@@ -0,0 +1,65 @@
+package getcalendarfeed
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	domainsession "meye-core/internal/domain/session"
+	"meye-core/internal/domain/user"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.GetCalendarFeedUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository      user.Repository
+	tokenService        user.TokenService
+	sessionQueryService domainsession.QueryService
+}
+
+func New(userRepo user.Repository, tokenServ user.TokenService, sessionQueryServ domainsession.QueryService) *UseCase {
+	return &UseCase{
+		userRepository:      userRepo,
+		tokenService:        tokenServ,
+		sessionQueryService: sessionQueryServ,
+	}
+}
+
+// Execute resolves the owner of the calendar token and lists the scheduled sessions of their campaigns.
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.GetCalendarFeedInput) (applicationsession.CalendarFeedOutput, error) {
+	if input.Token == "" {
+		return applicationsession.CalendarFeedOutput{}, applicationsession.ErrCalendarNotFound
+	}
+
+	u, err := uc.userRepository.FindByCalendarTokenHash(ctx, uc.tokenService.Hash(input.Token))
+	if err != nil {
+		return applicationsession.CalendarFeedOutput{}, err
+	}
+
+	if u == nil {
+		return applicationsession.CalendarFeedOutput{}, applicationsession.ErrCalendarNotFound
+	}
+
+	scheduledSessions, err := uc.sessionQueryService.ListUserScheduledSessions(ctx, u.ID())
+	if err != nil {
+		return applicationsession.CalendarFeedOutput{}, err
+	}
+
+	events := make([]applicationsession.CalendarEventOutput, 0, len(scheduledSessions))
+	for _, scheduled := range scheduledSessions {
+		schedule := scheduled.Session.Schedule()
+
+		events = append(events, applicationsession.CalendarEventOutput{
+			SessionID:    scheduled.Session.ID(),
+			CampaignName: scheduled.CampaignName,
+			StartsAt:     schedule.StartsAt(),
+			EndsAt:       schedule.EndsAt(),
+			Location:     schedule.Location(),
+			Sequence:     schedule.Sequence(),
+		})
+	}
+
+	return applicationsession.CalendarFeedOutput{
+		Username: u.Username(),
+		Events:   events,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package getcalendarfeed_test
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/getcalendarfeed"
+	"meye-core/internal/domain/session"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	token     = "calendar-token"
+	tokenHash = "calendar-token-hash"
+)
+
+func TestGetCalendarFeedUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	owner := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, []user.UserRole{user.UserRolePlayer}, tokenHash, 0, nil, false, nil)
+
+	startsAt := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	schedule := session.CreateScheduleWithoutValidation(startsAt, 3*time.Hour, "Table 1", 2)
+	planned := session.CreateSessionWithoutValidation("session-id", data.CampaignID, "", session.StatusPlanned, time.Now(), nil, session.XPAmounts{}, nil, &schedule, nil)
+
+	tests := []struct {
+		name       string
+		token      string
+		findUser   *user.User
+		scheduled  []session.ScheduledSession
+		wantOutput applicationsession.CalendarFeedOutput
+		wantErr    error
+	}{
+		{
+			name:      "lists the scheduled sessions of the token owner",
+			token:     token,
+			findUser:  owner,
+			scheduled: []session.ScheduledSession{{Session: planned, CampaignName: data.CampaignName}},
+			wantOutput: applicationsession.CalendarFeedOutput{
+				Username: data.Username,
+				Events: []applicationsession.CalendarEventOutput{
+					{
+						SessionID:    "session-id",
+						CampaignName: data.CampaignName,
+						StartsAt:     startsAt,
+						EndsAt:       startsAt.Add(3 * time.Hour),
+						Location:     "Table 1",
+						Sequence:     2,
+					},
+				},
+			},
+		},
+		{
+			name:    "unknown or revoked token",
+			token:   token,
+			wantErr: applicationsession.ErrCalendarNotFound,
+		},
+		{
+			name:    "empty token",
+			wantErr: applicationsession.ErrCalendarNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepoMock := mocks.NewMockUserRepository(ctrl)
+			tokenServiceMock := mocks.NewMockTokenService(ctrl)
+			queryServiceMock := mocks.NewMockQueryService(ctrl)
+
+			if tt.token != "" {
+				tokenServiceMock.EXPECT().Hash(tt.token).Return(tokenHash)
+				userRepoMock.EXPECT().FindByCalendarTokenHash(ctx, tokenHash).Return(tt.findUser, nil)
+			}
+
+			if tt.findUser != nil {
+				queryServiceMock.EXPECT().ListUserScheduledSessions(ctx, tt.findUser.ID()).Return(tt.scheduled, nil)
+			}
+
+			uc := getcalendarfeed.New(userRepoMock, tokenServiceMock, queryServiceMock)
+
+			output, err := uc.Execute(ctx, applicationsession.GetCalendarFeedInput{Token: tt.token})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantOutput, output)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package getsession
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	domainsession "meye-core/internal/domain/session"
+)
+
+var _ applicationsession.GetSessionUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository      domainsession.Repository
+	membershipQueryService campaign.MembershipQueryService
+}
+
+func New(sessRepo domainsession.Repository, membershipQueryServ campaign.MembershipQueryService) *UseCase {
+	return &UseCase{
+		sessionRepository:      sessRepo,
+		membershipQueryService: membershipQueryServ,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.GetSessionInput) (applicationsession.SessionOutput, error) {
+	s, err := uc.sessionRepository.FindByID(ctx, input.SessionID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if s == nil {
+		return applicationsession.SessionOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	masterID, err := uc.membershipQueryService.FindCampaignMasterID(ctx, s.CampaignID())
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if masterID == "" {
+		return applicationsession.SessionOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	viewer, err := applicationsession.FindSessionViewer(ctx, uc.membershipQueryService, s.CampaignID(), masterID, input.UserID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	// Drafts are hidden as if they didn't exist yet
+	if !viewer.CanSee(s) {
+		return applicationsession.SessionOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	return viewer.MapSessionOutput(s), nil
+}
@@ -0,0 +1,112 @@
+package getsession_test
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/getsession"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionID   = "session-id"
+	ownPjID     = "own-pj-id"
+	otherPjID   = "other-pj-id"
+	otherUserID = "other-user-id"
+)
+
+func TestGetSessionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	userPjIDs := map[string][]string{
+		data.UserID: {ownPjID},
+		otherUserID: {otherPjID},
+	}
+
+	xpAssignations := []session.XPAssignation{
+		session.NewXPAssignation(ownPjID, 10, 0, 0, "own"),
+		session.NewXPAssignation(otherPjID, 20, 0, 0, "other"),
+	}
+	sess := session.CreateSessionWithoutValidation(sessionID, data.CampaignID, "summary", session.StatusFinalized, time.Now(), nil, session.XPAmounts{}, xpAssignations, nil, nil)
+	draft := session.CreateSessionWithoutValidation(sessionID, data.CampaignID, "notes", session.StatusDraft, time.Now(), nil, session.XPAmounts{}, xpAssignations, nil, nil)
+
+	tests := []struct {
+		name        string
+		userID      string
+		wantPjIDs   []string
+		wantErr     error
+		findSession *session.Session
+	}{
+		{
+			name:        "master sees every XP line",
+			userID:      data.CampaignMasterID,
+			wantPjIDs:   []string{ownPjID, otherPjID},
+			findSession: sess,
+		},
+		{
+			name:        "player sees only their own XP lines",
+			userID:      data.UserID,
+			wantPjIDs:   []string{ownPjID},
+			findSession: sess,
+		},
+		{
+			name:        "users without PJs in the campaign can't see it",
+			userID:      "stranger-id",
+			wantErr:     applicationsession.ErrNotCampaignMember,
+			findSession: sess,
+		},
+		{
+			name:        "master sees drafts",
+			userID:      data.CampaignMasterID,
+			wantPjIDs:   []string{ownPjID, otherPjID},
+			findSession: draft,
+		},
+		{
+			name:        "players can't see drafts",
+			userID:      data.UserID,
+			wantErr:     applicationsession.ErrSessionNotFound,
+			findSession: draft,
+		},
+		{
+			name:    "session not found",
+			userID:  data.CampaignMasterID,
+			wantErr: applicationsession.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionRepoMock := mocks.NewMockSessionRepository(ctrl)
+			membershipQueryServiceMock := mocks.NewMockMembershipQueryService(ctrl)
+
+			sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(tt.findSession, nil)
+			if tt.findSession != nil {
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+			}
+			if tt.findSession != nil && tt.userID != data.CampaignMasterID {
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, tt.userID).Return(userPjIDs[tt.userID], nil)
+			}
+
+			uc := getsession.New(sessionRepoMock, membershipQueryServiceMock)
+
+			output, err := uc.Execute(ctx, applicationsession.GetSessionInput{SessionID: sessionID, UserID: tt.userID})
+
+			assert.Equal(t, tt.wantErr, err)
+
+			var pjIDs []string
+			for _, xpA := range output.XPAssignations {
+				pjIDs = append(pjIDs, xpA.PjID)
+			}
+			assert.Equal(t, tt.wantPjIDs, pjIDs)
+		})
+	}
+}
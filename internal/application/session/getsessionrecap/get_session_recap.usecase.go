@@ -0,0 +1,180 @@
+package getsessionrecap
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	domainsession "meye-core/internal/domain/session"
+	"time"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.GetSessionRecapUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository      domainsession.Repository
+	campaignQueryService   campaign.CampaignQueryService
+	membershipQueryService campaign.MembershipQueryService
+	pjQueryService         campaign.PjQueryService
+}
+
+func New(
+	sessRepo domainsession.Repository,
+	campQueryServ campaign.CampaignQueryService,
+	membershipQueryServ campaign.MembershipQueryService,
+	pjQueryServ campaign.PjQueryService,
+) *UseCase {
+	return &UseCase{
+		sessionRepository:      sessRepo,
+		campaignQueryService:   campQueryServ,
+		membershipQueryService: membershipQueryServ,
+		pjQueryService:         pjQueryServ,
+	}
+}
+
+// Execute gathers what the recap of a session tells: its summary, attendees and XP lines, and the
+// stats the PJs bought since the previous finalized session. Players only get their own PJs' XP
+// lines and upgrades, as in the session detail.
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.GetSessionRecapInput) (applicationsession.SessionRecapOutput, error) {
+	s, err := uc.sessionRepository.FindByID(ctx, input.SessionID)
+	if err != nil {
+		return applicationsession.SessionRecapOutput{}, err
+	}
+
+	if s == nil {
+		return applicationsession.SessionRecapOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	camp, err := uc.campaignQueryService.GetCampaignBasicInfo(ctx, s.CampaignID())
+	if err != nil {
+		return applicationsession.SessionRecapOutput{}, err
+	}
+
+	if camp == nil {
+		return applicationsession.SessionRecapOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	viewer, err := applicationsession.FindSessionViewer(ctx, uc.membershipQueryService, camp.ID(), camp.MasterID(), input.UserID)
+	if err != nil {
+		return applicationsession.SessionRecapOutput{}, err
+	}
+
+	if !viewer.CanSee(s) {
+		return applicationsession.SessionRecapOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	campaignSessions, err := uc.sessionRepository.FindByCampaignID(ctx, s.CampaignID())
+	if err != nil {
+		return applicationsession.SessionRecapOutput{}, err
+	}
+
+	playedAt := getPlayedAt(s)
+
+	var since time.Time
+	for _, other := range campaignSessions {
+		otherPlayedAt := getPlayedAt(other)
+		if other.ID() != s.ID() && other.IsFinalized() && otherPlayedAt.Before(playedAt) && otherPlayedAt.After(since) {
+			since = otherPlayedAt
+		}
+	}
+
+	pjs, err := uc.pjQueryService.GetCampaignPjsBasicInfo(ctx, s.CampaignID())
+	if err != nil {
+		return applicationsession.SessionRecapOutput{}, err
+	}
+
+	pjNames := make(map[string]string, len(pjs))
+	var pjIDs []string
+	for _, pj := range pjs {
+		pjNames[pj.ID()] = pj.Name()
+		if viewer.CanSeePj(pj.ID()) {
+			pjIDs = append(pjIDs, pj.ID())
+		}
+	}
+
+	upgrades, err := uc.pjQueryService.ListStatsUpgrades(ctx, pjIDs, since, playedAt)
+	if err != nil {
+		return applicationsession.SessionRecapOutput{}, err
+	}
+
+	return mapSessionRecapOutput(camp.Name(), pjNames, viewer, s, playedAt, upgrades), nil
+}
+
+func getPlayedAt(s *domainsession.Session) time.Time {
+	if schedule := s.Schedule(); schedule != nil {
+		return schedule.StartsAt()
+	}
+
+	return s.CreatedAt()
+}
+
+func mapRecapPJ(pjNames map[string]string, pjID string) applicationsession.RecapPJ {
+	return applicationsession.RecapPJ{ID: pjID, Name: pjNames[pjID]}
+}
+
+func mapSessionRecapOutput(
+	campaignName string,
+	pjNames map[string]string,
+	viewer applicationsession.SessionViewer,
+	s *domainsession.Session,
+	playedAt time.Time,
+	upgrades []*campaign.StatsUpgrade,
+) applicationsession.SessionRecapOutput {
+	attendees := make([]applicationsession.RecapPJ, 0, len(s.Attendees()))
+	for _, pjID := range s.Attendees() {
+		attendees = append(attendees, mapRecapPJ(pjNames, pjID))
+	}
+
+	xpAssignations := make([]applicationsession.RecapXPAssignation, 0, len(s.XPAssignations()))
+	for _, xpA := range s.XPAssignations() {
+		if !viewer.CanSeePj(xpA.PjID()) {
+			continue
+		}
+
+		xpAssignations = append(xpAssignations, applicationsession.RecapXPAssignation{
+			PJ:   mapRecapPJ(pjNames, xpA.PjID()),
+			Kind: string(xpA.Kind()),
+			Amounts: applicationsession.XPAmounts{
+				Basic:        xpA.Basic(),
+				Special:      xpA.Special(),
+				SuperNatural: xpA.SuperNatural(),
+			},
+			Reason: xpA.Reason(),
+		})
+	}
+
+	statsUpgrades := make([]applicationsession.RecapStatsUpgrade, 0, len(upgrades))
+	for _, upgrade := range upgrades {
+		changes := make([]applicationsession.StatChange, 0, len(upgrade.Changes()))
+		for _, change := range upgrade.Changes() {
+			changes = append(changes, applicationsession.StatChange{
+				Stat:     change.Stat(),
+				Previous: change.Previous(),
+				Current:  change.Current(),
+			})
+		}
+
+		statsUpgrades = append(statsUpgrades, applicationsession.RecapStatsUpgrade{
+			PJ: mapRecapPJ(pjNames, upgrade.PjID()),
+			SpentXP: applicationsession.XPAmounts{
+				Basic:        upgrade.SpentXP().Basic(),
+				Special:      upgrade.SpentXP().Special(),
+				SuperNatural: upgrade.SpentXP().Supernatural(),
+			},
+			Changes:    changes,
+			OccurredAt: upgrade.OccurredAt(),
+		})
+	}
+
+	return applicationsession.SessionRecapOutput{
+		SessionID:      s.ID(),
+		CampaignName:   campaignName,
+		Summary:        s.Summary(),
+		Status:         string(s.Status()),
+		PlayedAt:       playedAt,
+		Attendees:      attendees,
+		XPAssignations: xpAssignations,
+		StatsUpgrades:  statsUpgrades,
+	}
+}
@@ -0,0 +1,169 @@
+package getsessionrecap_test
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/getsessionrecap"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionID         = "session-id"
+	previousSessionID = "previous-session-id"
+	ownPjID           = "own-pj-id"
+	otherPjID         = "other-pj-id"
+	otherUserID       = "other-user-id"
+)
+
+func TestGetSessionRecapUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	camp := campaign.CreateCampaignBasicInfo(data.CampaignID, data.CampaignName, data.CampaignMasterID)
+	pjs := []*campaign.PjBasicInfo{
+		campaign.CreatePjBasicInfo(ownPjID, "Aria"),
+		campaign.CreatePjBasicInfo(otherPjID, "Brom"),
+	}
+	userPjIDs := map[string][]string{
+		data.UserID: {ownPjID},
+		otherUserID: {otherPjID},
+	}
+
+	previousPlayedAt := time.Date(2026, 3, 7, 18, 0, 0, 0, time.UTC)
+	playedAt := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+
+	xpAssignations := []session.XPAssignation{
+		session.NewXPAssignation(ownPjID, 10, 0, 0, "own"),
+		session.NewXPAssignation(otherPjID, 20, 0, 0, "other"),
+	}
+	sess := session.CreateSessionWithoutValidation(sessionID, data.CampaignID, "summary", session.StatusFinalized, playedAt, []string{ownPjID, otherPjID}, session.XPAmounts{}, xpAssignations, nil, nil)
+	previous := session.CreateSessionWithoutValidation(previousSessionID, data.CampaignID, "", session.StatusFinalized, previousPlayedAt, nil, session.XPAmounts{}, nil, nil, nil)
+	draft := session.CreateSessionWithoutValidation(sessionID, data.CampaignID, "notes", session.StatusDraft, playedAt, nil, session.XPAmounts{}, nil, nil, nil)
+
+	upgradedAt := previousPlayedAt.Add(24 * time.Hour)
+	ownUpgrade := campaign.CreateStatsUpgrade(
+		ownPjID,
+		campaign.CreateXPWithoutValidation(30, 0, 0),
+		[]campaign.StatChange{campaign.CreateStatChange("physical.strength", 1, 2)},
+		upgradedAt,
+	)
+
+	tests := []struct {
+		name        string
+		userID      string
+		findSession *session.Session
+		wantPjIDs   []string
+		upgrades    []*campaign.StatsUpgrade
+		wantOutput  applicationsession.SessionRecapOutput
+		wantErr     error
+	}{
+		{
+			name:        "master gets every XP line and upgrade since the previous session",
+			userID:      data.CampaignMasterID,
+			findSession: sess,
+			wantPjIDs:   []string{ownPjID, otherPjID},
+			upgrades:    []*campaign.StatsUpgrade{ownUpgrade},
+			wantOutput: applicationsession.SessionRecapOutput{
+				SessionID:    sessionID,
+				CampaignName: data.CampaignName,
+				Summary:      "summary",
+				Status:       string(session.StatusFinalized),
+				PlayedAt:     playedAt,
+				Attendees: []applicationsession.RecapPJ{
+					{ID: ownPjID, Name: "Aria"},
+					{ID: otherPjID, Name: "Brom"},
+				},
+				XPAssignations: []applicationsession.RecapXPAssignation{
+					{PJ: applicationsession.RecapPJ{ID: ownPjID, Name: "Aria"}, Kind: string(session.XPKindBonus), Amounts: applicationsession.XPAmounts{Basic: 10}, Reason: "own"},
+					{PJ: applicationsession.RecapPJ{ID: otherPjID, Name: "Brom"}, Kind: string(session.XPKindBonus), Amounts: applicationsession.XPAmounts{Basic: 20}, Reason: "other"},
+				},
+				StatsUpgrades: []applicationsession.RecapStatsUpgrade{
+					{
+						PJ:         applicationsession.RecapPJ{ID: ownPjID, Name: "Aria"},
+						SpentXP:    applicationsession.XPAmounts{Basic: 30},
+						Changes:    []applicationsession.StatChange{{Stat: "physical.strength", Previous: 1, Current: 2}},
+						OccurredAt: upgradedAt,
+					},
+				},
+			},
+		},
+		{
+			name:        "player gets only their own XP lines and upgrades",
+			userID:      data.UserID,
+			findSession: sess,
+			wantPjIDs:   []string{ownPjID},
+			upgrades:    []*campaign.StatsUpgrade{},
+			wantOutput: applicationsession.SessionRecapOutput{
+				SessionID:    sessionID,
+				CampaignName: data.CampaignName,
+				Summary:      "summary",
+				Status:       string(session.StatusFinalized),
+				PlayedAt:     playedAt,
+				Attendees: []applicationsession.RecapPJ{
+					{ID: ownPjID, Name: "Aria"},
+					{ID: otherPjID, Name: "Brom"},
+				},
+				XPAssignations: []applicationsession.RecapXPAssignation{
+					{PJ: applicationsession.RecapPJ{ID: ownPjID, Name: "Aria"}, Kind: string(session.XPKindBonus), Amounts: applicationsession.XPAmounts{Basic: 10}, Reason: "own"},
+				},
+				StatsUpgrades: []applicationsession.RecapStatsUpgrade{},
+			},
+		},
+		{
+			name:        "players can't get the recap of drafts",
+			userID:      data.UserID,
+			findSession: draft,
+			wantErr:     applicationsession.ErrSessionNotFound,
+		},
+		{
+			name:        "users without PJs in the campaign can't get it",
+			userID:      "stranger-id",
+			findSession: sess,
+			wantErr:     applicationsession.ErrNotCampaignMember,
+		},
+		{
+			name:    "session not found",
+			userID:  data.CampaignMasterID,
+			wantErr: applicationsession.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionRepoMock := mocks.NewMockSessionRepository(ctrl)
+			campaignQueryServiceMock := mocks.NewMockCampaignQueryService(ctrl)
+			membershipQueryServiceMock := mocks.NewMockMembershipQueryService(ctrl)
+			pjQueryServiceMock := mocks.NewMockPjQueryService(ctrl)
+
+			sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(tt.findSession, nil)
+			if tt.findSession != nil {
+				campaignQueryServiceMock.EXPECT().GetCampaignBasicInfo(ctx, data.CampaignID).Return(camp, nil)
+			}
+			if tt.findSession != nil && tt.userID != data.CampaignMasterID {
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, tt.userID).Return(userPjIDs[tt.userID], nil)
+			}
+			if tt.wantErr == nil {
+				sessionRepoMock.EXPECT().FindByCampaignID(ctx, data.CampaignID).Return([]*session.Session{previous, sess}, nil)
+				pjQueryServiceMock.EXPECT().GetCampaignPjsBasicInfo(ctx, data.CampaignID).Return(pjs, nil)
+				pjQueryServiceMock.EXPECT().ListStatsUpgrades(ctx, tt.wantPjIDs, previousPlayedAt, playedAt).Return(tt.upgrades, nil)
+			}
+
+			uc := getsessionrecap.New(sessionRepoMock, campaignQueryServiceMock, membershipQueryServiceMock, pjQueryServiceMock)
+
+			output, err := uc.Execute(ctx, applicationsession.GetSessionRecapInput{SessionID: sessionID, UserID: tt.userID})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantOutput, output)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package listsessions
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	domainsession "meye-core/internal/domain/session"
+)
+
+var _ applicationsession.ListSessionsUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionQueryService    domainsession.QueryService
+	membershipQueryService campaign.MembershipQueryService
+}
+
+func New(sessQueryServ domainsession.QueryService, membershipQueryServ campaign.MembershipQueryService) *UseCase {
+	return &UseCase{
+		sessionQueryService:    sessQueryServ,
+		membershipQueryService: membershipQueryServ,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.ListSessionsInput) (applicationsession.SessionsPageOutput, error) {
+	masterID, err := uc.membershipQueryService.FindCampaignMasterID(ctx, input.CampaignID)
+	if err != nil {
+		return applicationsession.SessionsPageOutput{}, err
+	}
+
+	if masterID == "" {
+		return applicationsession.SessionsPageOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	viewer, err := applicationsession.FindSessionViewer(ctx, uc.membershipQueryService, input.CampaignID, masterID, input.UserID)
+	if err != nil {
+		return applicationsession.SessionsPageOutput{}, err
+	}
+
+	page, err := uc.sessionQueryService.ListCampaignSessions(ctx, domainsession.ListQuery{
+		CampaignID: input.CampaignID,
+		From:       input.From,
+		To:         input.To,
+		Cursor:     input.Cursor,
+		Limit:      input.Limit,
+
+		IncludeDrafts: viewer.CanSeeDrafts(),
+	})
+	if err != nil {
+		return applicationsession.SessionsPageOutput{}, err
+	}
+
+	sessions := make([]applicationsession.SessionOutput, 0, len(page.Sessions))
+	for _, s := range page.Sessions {
+		sessions = append(sessions, viewer.MapSessionOutput(s))
+	}
+
+	return applicationsession.SessionsPageOutput{
+		Sessions:   sessions,
+		NextCursor: page.NextCursor,
+	}, nil
+}
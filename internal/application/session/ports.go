@@ -5,3 +5,43 @@ import "context"
 type CreateSessionUseCase interface {
 	Execute(ctx context.Context, input CreateSessionInput) (SessionOutput, error)
 }
+
+type ListSessionsUseCase interface {
+	Execute(ctx context.Context, input ListSessionsInput) (SessionsPageOutput, error)
+}
+
+type GetSessionUseCase interface {
+	Execute(ctx context.Context, input GetSessionInput) (SessionOutput, error)
+}
+
+type AmendSessionUseCase interface {
+	Execute(ctx context.Context, input AmendSessionInput) (SessionOutput, error)
+}
+
+type FinalizeSessionUseCase interface {
+	Execute(ctx context.Context, input FinalizeSessionInput) (SessionOutput, error)
+}
+
+type RescheduleSessionUseCase interface {
+	Execute(ctx context.Context, input RescheduleSessionInput) (SessionOutput, error)
+}
+
+type RSVPSessionUseCase interface {
+	Execute(ctx context.Context, input RSVPSessionInput) (SessionOutput, error)
+}
+
+type StartDueSessionsUseCase interface {
+	Execute(ctx context.Context, input StartDueSessionsInput) error
+}
+
+type GetCalendarFeedUseCase interface {
+	Execute(ctx context.Context, input GetCalendarFeedInput) (CalendarFeedOutput, error)
+}
+
+type GetSessionRecapUseCase interface {
+	Execute(ctx context.Context, input GetSessionRecapInput) (SessionRecapOutput, error)
+}
+
+type SearchSessionsUseCase interface {
+	Execute(ctx context.Context, input SearchSessionsInput) (SearchSessionsOutput, error)
+}
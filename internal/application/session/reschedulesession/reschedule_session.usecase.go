@@ -0,0 +1,53 @@
+package reschedulesession
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/event"
+	domainsession "meye-core/internal/domain/session"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.RescheduleSessionUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository domainsession.Repository
+	eventPublisher    event.Publisher
+}
+
+func New(sessRepo domainsession.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		sessionRepository: sessRepo,
+		eventPublisher:    eventPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.RescheduleSessionInput) (applicationsession.SessionOutput, error) {
+	session, err := uc.sessionRepository.FindByID(ctx, input.SessionID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if session == nil || session.CampaignID() != input.CampaignID {
+		return applicationsession.SessionOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	schedule, err := applicationsession.MapScheduleInput(input.Schedule)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = session.Reschedule(schedule); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.sessionRepository.Save(ctx, session); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.eventPublisher.Publish(ctx, session.UncommittedEvents()); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	return applicationsession.MapSessionOutput(session), nil
+}
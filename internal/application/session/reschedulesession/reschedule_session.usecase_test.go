@@ -0,0 +1,146 @@
+package reschedulesession_test
+
+import (
+	"context"
+	"errors"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/reschedulesession"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const sessionID = "session-id"
+
+var startsAt = time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+
+func newSession(status session.Status) *session.Session {
+	schedule := session.CreateScheduleWithoutValidation(startsAt, 3*time.Hour, "tavern", 0)
+
+	return session.CreateSessionWithoutValidation(
+		sessionID, data.CampaignID, "", status, time.Now(), nil, session.XPAmounts{}, nil, &schedule, nil,
+	)
+}
+
+func TestRescheduleSessionUseCase_Execute(t *testing.T) {
+	var sessionRepoMock *mocks.MockSessionRepository
+	var publisherMock *mocks.MockPublisher
+
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	newSchedule := applicationsession.Schedule{
+		StartsAt:        startsAt.Add(24 * time.Hour),
+		DurationMinutes: 240,
+		Location:        "castle",
+	}
+
+	defaultInput := applicationsession.RescheduleSessionInput{
+		CampaignID: data.CampaignID,
+		SessionID:  sessionID,
+		Schedule:   newSchedule,
+	}
+
+	tests := []struct {
+		name         string
+		input        applicationsession.RescheduleSessionInput
+		wantSchedule *applicationsession.Schedule
+		wantErr      error
+		setupMocks   func()
+	}{
+		{
+			name:         "moves the planned session",
+			input:        defaultInput,
+			wantSchedule: &newSchedule,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, event.EventTypeSessionRescheduled, events[0].Type())
+					return nil
+				})
+			},
+		},
+		{
+			name:    "started sessions can't be moved",
+			input:   defaultInput,
+			wantErr: session.ErrSessionNotPlanned,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "invalid schedule",
+			input: applicationsession.RescheduleSessionInput{
+				CampaignID: data.CampaignID,
+				SessionID:  sessionID,
+				Schedule:   applicationsession.Schedule{StartsAt: newSchedule.StartsAt},
+			},
+			wantErr: session.ErrInvalidSchedule,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "session of another campaign",
+			input: applicationsession.RescheduleSessionInput{
+				CampaignID: "other-campaign-id",
+				SessionID:  sessionID,
+				Schedule:   newSchedule,
+			},
+			wantErr: applicationsession.ErrSessionNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "session not found",
+			input:   defaultInput,
+			wantErr: applicationsession.ErrSessionNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(nil, nil)
+			},
+		},
+		{
+			name:    "error on repository save",
+			input:   defaultInput,
+			wantErr: errTest,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(errTest)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionRepoMock = mocks.NewMockSessionRepository(ctrl)
+			publisherMock = mocks.NewMockPublisher(ctrl)
+
+			tt.setupMocks()
+
+			uc := reschedulesession.New(sessionRepoMock, publisherMock)
+
+			output, err := uc.Execute(ctx, tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantSchedule, output.Schedule)
+		})
+	}
+}
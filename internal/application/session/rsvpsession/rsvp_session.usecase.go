@@ -0,0 +1,76 @@
+package rsvpsession
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	domainsession "meye-core/internal/domain/session"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.RSVPSessionUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository      domainsession.Repository
+	membershipQueryService campaign.MembershipQueryService
+	eventPublisher         event.Publisher
+}
+
+func New(sessRepo domainsession.Repository, membershipQueryServ campaign.MembershipQueryService, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		sessionRepository:      sessRepo,
+		membershipQueryService: membershipQueryServ,
+		eventPublisher:         eventPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.RSVPSessionInput) (applicationsession.SessionOutput, error) {
+	session, err := uc.sessionRepository.FindByID(ctx, input.SessionID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if session == nil {
+		return applicationsession.SessionOutput{}, applicationsession.ErrSessionNotFound
+	}
+
+	masterID, err := uc.membershipQueryService.FindCampaignMasterID(ctx, session.CampaignID())
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if masterID == "" {
+		return applicationsession.SessionOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	userPjIDs, err := uc.membershipQueryService.FindUserPjIDs(ctx, session.CampaignID(), input.UserID)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	// Only the players of the campaign answer, the master runs the session anyway
+	if len(userPjIDs) == 0 {
+		return applicationsession.SessionOutput{}, applicationsession.ErrNotCampaignMember
+	}
+
+	viewer, err := applicationsession.NewSessionViewer(masterID, input.UserID, userPjIDs)
+	if err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = session.RSVP(input.UserID, domainsession.RSVPResponse(input.Response)); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.sessionRepository.Save(ctx, session); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	if err = uc.eventPublisher.Publish(ctx, session.UncommittedEvents()); err != nil {
+		return applicationsession.SessionOutput{}, err
+	}
+
+	return viewer.MapSessionOutput(session), nil
+}
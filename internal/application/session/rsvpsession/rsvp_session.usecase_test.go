@@ -0,0 +1,162 @@
+package rsvpsession_test
+
+import (
+	"context"
+	"errors"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/rsvpsession"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionID = "session-id"
+	ownPjID   = "own-pj-id"
+)
+
+func newSession(status session.Status) *session.Session {
+	schedule := session.CreateScheduleWithoutValidation(time.Now().Add(24*time.Hour), 3*time.Hour, "tavern", 0)
+
+	return session.CreateSessionWithoutValidation(
+		sessionID, data.CampaignID, "", status, time.Now(), nil, session.XPAmounts{}, nil, &schedule, nil,
+	)
+}
+
+func TestRSVPSessionUseCase_Execute(t *testing.T) {
+	var sessionRepoMock *mocks.MockSessionRepository
+	var membershipQueryServiceMock *mocks.MockMembershipQueryService
+	var publisherMock *mocks.MockPublisher
+
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	defaultInput := applicationsession.RSVPSessionInput{
+		SessionID: sessionID,
+		UserID:    data.UserID,
+		Response:  string(session.RSVPYes),
+	}
+
+	tests := []struct {
+		name       string
+		input      applicationsession.RSVPSessionInput
+		wantRSVPs  []applicationsession.RSVP
+		wantErr    error
+		setupMocks func()
+	}{
+		{
+			name:      "records the answer of the player",
+			input:     defaultInput,
+			wantRSVPs: []applicationsession.RSVP{{UserID: data.UserID, Response: string(session.RSVPYes)}},
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, data.UserID).Return([]string{ownPjID}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, event.EventTypeRSVPChanged, events[0].Type())
+					return nil
+				})
+			},
+		},
+		{
+			name: "users without PJs in the campaign can't answer",
+			input: applicationsession.RSVPSessionInput{
+				SessionID: sessionID,
+				UserID:    data.CampaignMasterID,
+				Response:  string(session.RSVPYes),
+			},
+			wantErr: applicationsession.ErrNotCampaignMember,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, data.CampaignMasterID).Return([]string{}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "invalid answer",
+			input: applicationsession.RSVPSessionInput{
+				SessionID: sessionID,
+				UserID:    data.UserID,
+				Response:  "perhaps",
+			},
+			wantErr: session.ErrInvalidRSVP,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, data.UserID).Return([]string{ownPjID}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "started sessions can't be answered",
+			input:   defaultInput,
+			wantErr: session.ErrSessionNotPlanned,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusDraft), nil)
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, data.UserID).Return([]string{ownPjID}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "campaign not found",
+			input:   defaultInput,
+			wantErr: applicationcampaign.ErrCampaignNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return("", nil)
+			},
+		},
+		{
+			name:    "session not found",
+			input:   defaultInput,
+			wantErr: applicationsession.ErrSessionNotFound,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(nil, nil)
+			},
+		},
+		{
+			name:    "error on repository save",
+			input:   defaultInput,
+			wantErr: errTest,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindByID(ctx, sessionID).Return(newSession(session.StatusPlanned), nil)
+				membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, data.UserID).Return([]string{ownPjID}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(errTest)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionRepoMock = mocks.NewMockSessionRepository(ctrl)
+			membershipQueryServiceMock = mocks.NewMockMembershipQueryService(ctrl)
+			publisherMock = mocks.NewMockPublisher(ctrl)
+
+			tt.setupMocks()
+
+			uc := rsvpsession.New(sessionRepoMock, membershipQueryServiceMock, publisherMock)
+
+			output, err := uc.Execute(ctx, tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantRSVPs, output.RSVPs)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package searchsessions
+
+import (
+	"context"
+	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/campaign"
+	domainsession "meye-core/internal/domain/session"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.SearchSessionsUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionQueryService    domainsession.QueryService
+	membershipQueryService campaign.MembershipQueryService
+}
+
+func New(sessQueryServ domainsession.QueryService, membershipQueryServ campaign.MembershipQueryService) *UseCase {
+	return &UseCase{
+		sessionQueryService:    sessQueryServ,
+		membershipQueryService: membershipQueryServ,
+	}
+}
+
+// Execute searches what the user can read: players neither find drafts nor the XP reasons of
+// PJs they don't own.
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.SearchSessionsInput) (applicationsession.SearchSessionsOutput, error) {
+	masterID, err := uc.membershipQueryService.FindCampaignMasterID(ctx, input.CampaignID)
+	if err != nil {
+		return applicationsession.SearchSessionsOutput{}, err
+	}
+
+	if masterID == "" {
+		return applicationsession.SearchSessionsOutput{}, applicationcampaign.ErrCampaignNotFound
+	}
+
+	viewer, err := applicationsession.FindSessionViewer(ctx, uc.membershipQueryService, input.CampaignID, masterID, input.UserID)
+	if err != nil {
+		return applicationsession.SearchSessionsOutput{}, err
+	}
+
+	query := domainsession.SearchQuery{
+		CampaignID: input.CampaignID,
+		Text:       input.Query,
+		Limit:      input.Limit,
+
+		IncludeDrafts: viewer.CanSeeDrafts(),
+	}
+
+	if !viewer.CanSeeEveryPj() {
+		query.PjIDs = viewer.PjIDs()
+	}
+
+	results, err := uc.sessionQueryService.Search(ctx, query)
+	if err != nil {
+		return applicationsession.SearchSessionsOutput{}, err
+	}
+
+	output := make([]applicationsession.SearchResultOutput, 0, len(results))
+	for _, result := range results {
+		matches := make([]applicationsession.ReasonMatchOutput, 0, len(result.ReasonMatches))
+		for _, m := range result.ReasonMatches {
+			matches = append(matches, applicationsession.ReasonMatchOutput{
+				PjID:    m.PjID,
+				Snippet: m.Snippet,
+			})
+		}
+
+		output = append(output, applicationsession.SearchResultOutput{
+			SessionID:      result.SessionID,
+			Status:         string(result.Status),
+			CreatedAt:      result.CreatedAt,
+			Rank:           result.Rank,
+			SummarySnippet: result.SummarySnippet,
+			ReasonMatches:  matches,
+		})
+	}
+
+	return applicationsession.SearchSessionsOutput{Results: output}, nil
+}
@@ -0,0 +1,129 @@
+package searchsessions_test
+
+import (
+	"context"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/searchsessions"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	ownPjID     = "own-pj-id"
+	otherPjID   = "other-pj-id"
+	otherUserID = "other-user-id"
+	searchText  = "smuggler"
+)
+
+func TestSearchSessionsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	userPjIDs := map[string][]string{
+		data.UserID: {ownPjID},
+		otherUserID: {otherPjID},
+	}
+
+	createdAt := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	result := session.SearchResult{
+		SessionID:      "session-id",
+		Status:         session.StatusFinalized,
+		CreatedAt:      createdAt,
+		Rank:           0.5,
+		SummarySnippet: "we met the <mark>smuggler</mark>",
+		ReasonMatches:  []session.ReasonMatch{{PjID: ownPjID, Snippet: "bribed the <mark>smuggler</mark>"}},
+	}
+
+	tests := []struct {
+		name       string
+		userID     string
+		wantQuery  *session.SearchQuery
+		wantOutput applicationsession.SearchSessionsOutput
+		wantErr    error
+	}{
+		{
+			name:   "master searches drafts and every XP reason",
+			userID: data.CampaignMasterID,
+			wantQuery: &session.SearchQuery{
+				CampaignID:    data.CampaignID,
+				Text:          searchText,
+				Limit:         20,
+				IncludeDrafts: true,
+			},
+			wantOutput: applicationsession.SearchSessionsOutput{
+				Results: []applicationsession.SearchResultOutput{
+					{
+						SessionID:      "session-id",
+						Status:         string(session.StatusFinalized),
+						CreatedAt:      createdAt,
+						Rank:           0.5,
+						SummarySnippet: "we met the <mark>smuggler</mark>",
+						ReasonMatches:  []applicationsession.ReasonMatchOutput{{PjID: ownPjID, Snippet: "bribed the <mark>smuggler</mark>"}},
+					},
+				},
+			},
+		},
+		{
+			name:   "player searches only the XP reasons of their PJs",
+			userID: data.UserID,
+			wantQuery: &session.SearchQuery{
+				CampaignID: data.CampaignID,
+				Text:       searchText,
+				Limit:      20,
+				PjIDs:      []string{ownPjID},
+			},
+			wantOutput: applicationsession.SearchSessionsOutput{
+				Results: []applicationsession.SearchResultOutput{
+					{
+						SessionID:      "session-id",
+						Status:         string(session.StatusFinalized),
+						CreatedAt:      createdAt,
+						Rank:           0.5,
+						SummarySnippet: "we met the <mark>smuggler</mark>",
+						ReasonMatches:  []applicationsession.ReasonMatchOutput{{PjID: ownPjID, Snippet: "bribed the <mark>smuggler</mark>"}},
+					},
+				},
+			},
+		},
+		{
+			name:    "users without PJs in the campaign can't search it",
+			userID:  "stranger-id",
+			wantErr: applicationsession.ErrNotCampaignMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionQueryServiceMock := mocks.NewMockQueryService(ctrl)
+			membershipQueryServiceMock := mocks.NewMockMembershipQueryService(ctrl)
+
+			membershipQueryServiceMock.EXPECT().FindCampaignMasterID(ctx, data.CampaignID).Return(data.CampaignMasterID, nil)
+			if tt.userID != data.CampaignMasterID {
+				membershipQueryServiceMock.EXPECT().FindUserPjIDs(ctx, data.CampaignID, tt.userID).Return(userPjIDs[tt.userID], nil)
+			}
+			if tt.wantQuery != nil {
+				sessionQueryServiceMock.EXPECT().Search(ctx, *tt.wantQuery).Return([]session.SearchResult{result}, nil)
+			}
+
+			uc := searchsessions.New(sessionQueryServiceMock, membershipQueryServiceMock)
+
+			output, err := uc.Execute(ctx, applicationsession.SearchSessionsInput{
+				CampaignID: data.CampaignID,
+				UserID:     tt.userID,
+				Query:      searchText,
+				Limit:      20,
+			})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantOutput, output)
+		})
+	}
+}
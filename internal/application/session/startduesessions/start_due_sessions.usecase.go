@@ -0,0 +1,54 @@
+package startduesessions
+
+import (
+	"context"
+	"errors"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/domain/event"
+	domainsession "meye-core/internal/domain/session"
+)
+
+// Compile-time check to ensure UseCase implements the port interface
+var _ applicationsession.StartDueSessionsUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	sessionRepository domainsession.Repository
+	eventPublisher    event.Publisher
+}
+
+func New(sessRepo domainsession.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		sessionRepository: sessRepo,
+		eventPublisher:    eventPub,
+	}
+}
+
+// Execute turns into drafts the planned sessions whose start time has passed. A failing session
+// doesn't stop the others, it is retried on the next run.
+func (uc *UseCase) Execute(ctx context.Context, input applicationsession.StartDueSessionsInput) error {
+	sessions, err := uc.sessionRepository.FindPlannedStartingBefore(ctx, input.Now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, session := range sessions {
+		if err := uc.start(ctx, session, input); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (uc *UseCase) start(ctx context.Context, session *domainsession.Session, input applicationsession.StartDueSessionsInput) error {
+	if err := session.Start(input.Now); err != nil {
+		return err
+	}
+
+	if err := uc.sessionRepository.Save(ctx, session); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, session.UncommittedEvents())
+}
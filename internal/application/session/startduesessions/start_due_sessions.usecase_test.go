@@ -0,0 +1,110 @@
+package startduesessions_test
+
+import (
+	"context"
+	"errors"
+	applicationsession "meye-core/internal/application/session"
+	"meye-core/internal/application/session/startduesessions"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var now = time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+
+func newSession(id string, startsAt time.Time) *session.Session {
+	schedule := session.CreateScheduleWithoutValidation(startsAt, 3*time.Hour, "tavern", 0)
+
+	return session.CreateSessionWithoutValidation(
+		id, data.CampaignID, "", session.StatusPlanned, startsAt.Add(-24*time.Hour), nil, session.XPAmounts{}, nil, &schedule, nil,
+	)
+}
+
+func TestStartDueSessionsUseCase_Execute(t *testing.T) {
+	var sessionRepoMock *mocks.MockSessionRepository
+	var publisherMock *mocks.MockPublisher
+
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	tests := []struct {
+		name       string
+		wantErr    error
+		setupMocks func()
+	}{
+		{
+			name: "starts every due session",
+			setupMocks: func() {
+				first := newSession("first-session-id", now.Add(-time.Hour))
+				second := newSession("second-session-id", now)
+
+				sessionRepoMock.EXPECT().FindPlannedStartingBefore(ctx, now).Return([]*session.Session{first, second}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, first).DoAndReturn(func(_ context.Context, s *session.Session) error {
+					assert.True(t, s.IsDraft())
+					return nil
+				})
+				sessionRepoMock.EXPECT().Save(ctx, second).DoAndReturn(func(_ context.Context, s *session.Session) error {
+					assert.True(t, s.IsDraft())
+					return nil
+				})
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Return(nil).Times(2)
+			},
+		},
+		{
+			name:    "a failing session doesn't stop the others",
+			wantErr: errTest,
+			setupMocks: func() {
+				failing := newSession("failing-session-id", now.Add(-time.Hour))
+				other := newSession("other-session-id", now)
+
+				sessionRepoMock.EXPECT().FindPlannedStartingBefore(ctx, now).Return([]*session.Session{failing, other}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, failing).Return(errTest)
+				sessionRepoMock.EXPECT().Save(ctx, other).Return(nil)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:    "sessions starting later are left planned",
+			wantErr: session.ErrSessionNotStarted,
+			setupMocks: func() {
+				later := newSession("later-session-id", now.Add(time.Hour))
+
+				sessionRepoMock.EXPECT().FindPlannedStartingBefore(ctx, now).Return([]*session.Session{later}, nil)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "error on repository lookup",
+			wantErr: errTest,
+			setupMocks: func() {
+				sessionRepoMock.EXPECT().FindPlannedStartingBefore(ctx, now).Return(nil, errTest)
+				sessionRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionRepoMock = mocks.NewMockSessionRepository(ctrl)
+			publisherMock = mocks.NewMockPublisher(ctrl)
+
+			tt.setupMocks()
+
+			uc := startduesessions.New(sessionRepoMock, publisherMock)
+
+			err := uc.Execute(ctx, applicationsession.StartDueSessionsInput{Now: now})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
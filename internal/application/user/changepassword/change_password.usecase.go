@@ -0,0 +1,62 @@
+package changepassword
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.ChangePasswordUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository         domainuser.Repository
+	refreshTokenRepository domainuser.RefreshTokenRepository
+	hashService            domainuser.HashService
+	passwordPolicy         domainuser.PasswordPolicy
+	eventPublisher         event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	hashServ domainuser.HashService,
+	passwordPolicy domainuser.PasswordPolicy,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		hashService:            hashServ,
+		passwordPolicy:         passwordPolicy,
+		eventPublisher:         eventPub,
+	}
+}
+
+// Execute changes the password of the user and logs it out everywhere,
+// the client has to login again with the new password.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ChangePasswordInput) error {
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	if err := u.ChangePassword(input.CurrentPassword, input.NewPassword, uc.passwordPolicy, uc.hashService); err != nil {
+		return err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return err
+	}
+
+	if err := uc.refreshTokenRepository.RevokeUserTokens(ctx, u.ID(), time.Now()); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
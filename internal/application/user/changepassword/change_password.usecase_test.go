@@ -0,0 +1,154 @@
+package changepassword_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/changepassword"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	newPassword       = "new-password"
+	newHashedPassword = "hashed:new-password"
+)
+
+var policy = user.NewPasswordPolicy(8, false, false, false)
+
+func TestChangePasswordUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	tests := []struct {
+		name            string
+		currentPassword string
+		newPassword     string
+		userFound       bool
+		findErr         error
+		compareErr      error
+		save            bool
+		saveErr         error
+		revokeErr       error
+		wantErr         error
+	}{
+		{
+			name:            "changes the password",
+			currentPassword: data.Password,
+			newPassword:     newPassword,
+			userFound:       true,
+			save:            true,
+		},
+		{
+			name:            "user not found",
+			currentPassword: data.Password,
+			newPassword:     newPassword,
+			wantErr:         applicationuser.ErrUserNotFound,
+		},
+		{
+			name:            "wrong current password",
+			currentPassword: "wrong-password",
+			newPassword:     newPassword,
+			userFound:       true,
+			compareErr:      errTest,
+			wantErr:         user.ErrWrongPassword,
+		},
+		{
+			name:            "unchanged password",
+			currentPassword: data.Password,
+			newPassword:     data.Password,
+			userFound:       true,
+			wantErr:         user.ErrPasswordUnchanged,
+		},
+		{
+			name:            "password breaking the policy",
+			currentPassword: data.Password,
+			newPassword:     "short",
+			userFound:       true,
+			wantErr:         user.ErrPasswordTooShort,
+		},
+		{
+			name:            "error on user lookup",
+			currentPassword: data.Password,
+			newPassword:     newPassword,
+			findErr:         errTest,
+			wantErr:         errTest,
+		},
+		{
+			name:            "error on save",
+			currentPassword: data.Password,
+			newPassword:     newPassword,
+			userFound:       true,
+			save:            true,
+			saveErr:         errTest,
+			wantErr:         errTest,
+		},
+		{
+			name:            "error on token revocation",
+			currentPassword: data.Password,
+			newPassword:     newPassword,
+			userFound:       true,
+			save:            true,
+			revokeErr:       errTest,
+			wantErr:         errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepoMock := mocks.NewMockUserRepository(ctrl)
+			refreshTokenRepoMock := mocks.NewMockRefreshTokenRepository(ctrl)
+			hashServiceMock := mocks.NewMockHashService(ctrl)
+			publisherMock := mocks.NewMockPublisher(ctrl)
+
+			var u *user.User
+			if tt.userFound {
+				u = user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+				hashServiceMock.EXPECT().Compare(tt.currentPassword, data.HashedPassword).Return(tt.compareErr)
+			}
+
+			userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(u, tt.findErr)
+			hashServiceMock.EXPECT().Hash(newPassword).Return(newHashedPassword, nil).AnyTimes()
+
+			if tt.save {
+				userRepoMock.EXPECT().Save(ctx, u).Return(tt.saveErr)
+
+				if tt.saveErr == nil {
+					refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, data.UserID, gomock.Any()).Return(tt.revokeErr)
+				}
+			}
+
+			if tt.wantErr == nil {
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, event.EventTypePasswordChanged, events[0].Type())
+					return nil
+				})
+			}
+
+			uc := changepassword.New(userRepoMock, refreshTokenRepoMock, hashServiceMock, policy, publisherMock)
+
+			err := uc.Execute(ctx, applicationuser.ChangePasswordInput{
+				UserID:          data.UserID,
+				CurrentPassword: tt.currentPassword,
+				NewPassword:     tt.newPassword,
+			})
+
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, newHashedPassword, u.HashedPassword())
+				assert.Equal(t, 1, u.TokenVersion())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package changeuserroles
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.ChangeUserRolesUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+	eventPublisher event.Publisher
+}
+
+func New(userRepo domainuser.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+		eventPublisher: eventPub,
+	}
+}
+
+// Execute replaces the roles of the user, its access tokens carrying the previous roles are rejected from now on.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ChangeUserRolesInput) (applicationuser.UserOutput, error) {
+	if input.ActorID == input.UserID {
+		return applicationuser.UserOutput{}, applicationuser.ErrCannotManageSelf
+	}
+
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return applicationuser.UserOutput{}, err
+	}
+
+	if u == nil {
+		return applicationuser.UserOutput{}, applicationuser.ErrUserNotFound
+	}
+
+	if err := u.ChangeRoles(input.Roles); err != nil {
+		return applicationuser.UserOutput{}, err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return applicationuser.UserOutput{}, err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, u.UncommittedEvents()); err != nil {
+		return applicationuser.UserOutput{}, err
+	}
+
+	return applicationuser.MapUserOutput(u), nil
+}
@@ -0,0 +1,44 @@
+package cleartwofactor
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.ClearTwoFactorUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+	eventPublisher event.Publisher
+}
+
+func New(userRepo domainuser.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+		eventPublisher: eventPub,
+	}
+}
+
+// Execute drops the pending enrollment of the user, whose next login shows a new secret.
+func (uc *UseCase) Execute(ctx context.Context, userID string) error {
+	u, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	if err := u.ClearPendingTwoFactor(); err != nil {
+		return err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
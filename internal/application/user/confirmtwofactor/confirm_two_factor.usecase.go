@@ -0,0 +1,59 @@
+package confirmtwofactor
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.ConfirmTwoFactorUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+	totpService    domainuser.TOTPService
+	tokenService   domainuser.TokenService
+	eventPublisher event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	totpServ domainuser.TOTPService,
+	tokenServ domainuser.TokenService,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+		totpService:    totpServ,
+		tokenService:   tokenServ,
+		eventPublisher: eventPub,
+	}
+}
+
+// Execute enables the pending second factor of the user and returns its recovery codes, only shown once.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.TwoFactorCodeInput) ([]string, error) {
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u == nil {
+		return nil, applicationuser.ErrUserNotFound
+	}
+
+	recoveryCodes, err := u.ConfirmTwoFactor(input.Code, time.Now(), uc.totpService, uc.tokenService)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, u.UncommittedEvents()); err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
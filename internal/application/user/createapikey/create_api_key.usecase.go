@@ -0,0 +1,76 @@
+package createapikey
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/shared"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.CreateAPIKeyUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository        domainuser.Repository
+	apiKeyRepository      domainuser.APIKeyRepository
+	identificationService shared.IdentificationService
+	tokenService          domainuser.TokenService
+	eventPublisher        event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	apiKeyRepo domainuser.APIKeyRepository,
+	identificationServ shared.IdentificationService,
+	tokenServ domainuser.TokenService,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:        userRepo,
+		apiKeyRepository:      apiKeyRepo,
+		identificationService: identificationServ,
+		tokenService:          tokenServ,
+		eventPublisher:        eventPub,
+	}
+}
+
+// Execute issues a key acting as the service account, whose roles still bound what the key can do.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.CreateAPIKeyInput) (applicationuser.CreatedAPIKeyOutput, error) {
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return applicationuser.CreatedAPIKeyOutput{}, err
+	}
+
+	if u == nil {
+		return applicationuser.CreatedAPIKeyOutput{}, applicationuser.ErrUserNotFound
+	}
+
+	if u.IsDisabled() {
+		return applicationuser.CreatedAPIKeyOutput{}, domainuser.ErrUserDisabled
+	}
+
+	k, secret, err := domainuser.NewAPIKey(
+		input.Name,
+		u.ID(),
+		input.Scopes,
+		input.ExpiresAt,
+		uc.identificationService,
+		uc.tokenService,
+	)
+	if err != nil {
+		return applicationuser.CreatedAPIKeyOutput{}, err
+	}
+
+	if err := uc.apiKeyRepository.Save(ctx, k); err != nil {
+		return applicationuser.CreatedAPIKeyOutput{}, err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, k.UncommittedEvents()); err != nil {
+		return applicationuser.CreatedAPIKeyOutput{}, err
+	}
+
+	return applicationuser.CreatedAPIKeyOutput{
+		APIKey: applicationuser.MapAPIKeyOutput(k),
+		Secret: secret,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package createapikey_test
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/createapikey"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createAPIKeyMocks struct {
+	userRepo   *mocks.MockUserRepository
+	apiKeyRepo *mocks.MockAPIKeyRepository
+	publisher  *mocks.MockPublisher
+}
+
+func TestCreateAPIKeyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	input := applicationuser.CreateAPIKeyInput{
+		Name:      "discord-bot",
+		UserID:    data.UserID,
+		Scopes:    []user.APIKeyScope{user.APIKeyScopeSessionsWrite},
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+
+	setup := func(t *testing.T) (*createapikey.UseCase, createAPIKeyMocks) {
+		ctrl := gomock.NewController(t)
+
+		m := createAPIKeyMocks{
+			userRepo:   mocks.NewMockUserRepository(ctrl),
+			apiKeyRepo: mocks.NewMockAPIKeyRepository(ctrl),
+			publisher:  mocks.NewMockPublisher(ctrl),
+		}
+		idService := mocks.NewMockIdentificationService(ctrl)
+		tokenService := mocks.NewMockTokenService(ctrl)
+
+		idService.EXPECT().GenerateID().Return("key-id").AnyTimes()
+		tokenService.EXPECT().Generate().Return("secret-api-key", nil).AnyTimes()
+		tokenService.EXPECT().Hash("secret-api-key").Return("api-key-hash").AnyTimes()
+
+		return createapikey.New(m.userRepo, m.apiKeyRepo, idService, tokenService, m.publisher), m
+	}
+
+	t.Run("key is saved and its secret returned once", func(t *testing.T) {
+		uc, m := setup(t)
+		serviceAccount := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+
+		m.userRepo.EXPECT().FindByID(ctx, data.UserID).Return(serviceAccount, nil)
+		m.apiKeyRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, k *user.APIKey) error {
+			assert.Equal(t, "api-key-hash", k.TokenHash())
+			assert.Equal(t, data.UserID, k.UserID())
+			return nil
+		})
+		m.publisher.EXPECT().Publish(ctx, gomock.Len(1)).Return(nil)
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "secret-api-key", output.Secret)
+		assert.Equal(t, "key-id", output.APIKey.ID)
+		assert.Equal(t, input.Scopes, output.APIKey.Scopes)
+	})
+
+	t.Run("unknown service account", func(t *testing.T) {
+		uc, m := setup(t)
+
+		m.userRepo.EXPECT().FindByID(ctx, data.UserID).Return(nil, nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, applicationuser.ErrUserNotFound, err)
+	})
+
+	t.Run("disabled service account", func(t *testing.T) {
+		uc, m := setup(t)
+		disabled := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, true, nil)
+
+		m.userRepo.EXPECT().FindByID(ctx, data.UserID).Return(disabled, nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, user.ErrUserDisabled, err)
+	})
+}
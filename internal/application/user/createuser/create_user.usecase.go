@@ -43,7 +43,7 @@ func (uc *UseCase) Execute(ctx context.Context, input applicationuser.CreateUser
 		return applicationuser.UserOutput{}, applicationuser.ErrUsernameAlreadyExists
 	}
 
-	newUser, err := user.NewUser(input.Username, input.Password, input.Role, uc.identificationService, uc.hashService)
+	newUser, err := user.NewUser(input.Username, input.Password, input.Roles, uc.identificationService, uc.hashService)
 	if err != nil {
 		return applicationuser.UserOutput{}, err
 	}
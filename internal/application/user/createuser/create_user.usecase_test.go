@@ -34,7 +34,7 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 	defaultInput := applicationuser.CreateUserInput{
 		Username: data.Username,
 		Password: data.Password,
-		Role:     data.Role,
+		Roles:    data.Roles,
 	}
 
 	tests := []struct {
@@ -50,7 +50,7 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 				output: applicationuser.UserOutput{
 					ID:       data.UserID,
 					Username: data.Username,
-					Role:     data.Role,
+					Roles:    data.Roles,
 				},
 				err: nil,
 			},
@@ -156,7 +156,12 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 					"existing-id",
 					data.Username,
 					data.HashedPassword,
-					data.Role,
+					data.Roles,
+					"",
+					0,
+					nil,
+					false,
+					nil,
 				)
 
 				idServiceMock.EXPECT().
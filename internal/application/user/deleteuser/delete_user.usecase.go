@@ -0,0 +1,71 @@
+package deleteuser
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.DeleteUserUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository       domainuser.Repository
+	campaignQueryService campaign.CampaignQueryService
+	pjQueryService       campaign.PjQueryService
+	eventPublisher       event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	campaignQueryServ campaign.CampaignQueryService,
+	pjQueryServ campaign.PjQueryService,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:       userRepo,
+		campaignQueryService: campaignQueryServ,
+		pjQueryService:       pjQueryServ,
+		eventPublisher:       eventPub,
+	}
+}
+
+// Execute deletes the user. Deleting a user cascades to its campaigns and PJs,
+// so users still mastering a campaign or owning a PJ must be disabled instead.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ManageUserInput) error {
+	if input.ActorID == input.UserID {
+		return applicationuser.ErrCannotManageSelf
+	}
+
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	campaigns, err := uc.campaignQueryService.GetCampaignsBasicInfo(ctx, u.ID())
+	if err != nil {
+		return err
+	}
+
+	pjs, err := uc.pjQueryService.GetPjsBasicInfo(ctx, u.ID())
+	if err != nil {
+		return err
+	}
+
+	if len(campaigns) > 0 || len(pjs) > 0 {
+		return applicationuser.ErrUserHasCampaignData
+	}
+
+	u.Delete()
+
+	if err := uc.userRepository.Delete(ctx, u); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
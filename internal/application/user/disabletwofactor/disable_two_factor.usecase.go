@@ -0,0 +1,63 @@
+package disabletwofactor
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.DisableTwoFactorUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository            domainuser.Repository
+	twoFactorPolicyRepository domainuser.TwoFactorPolicyRepository
+	totpService               domainuser.TOTPService
+	tokenService              domainuser.TokenService
+	eventPublisher            event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	twoFactorPolicyRepo domainuser.TwoFactorPolicyRepository,
+	totpServ domainuser.TOTPService,
+	tokenServ domainuser.TokenService,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:            userRepo,
+		twoFactorPolicyRepository: twoFactorPolicyRepo,
+		totpService:               totpServ,
+		tokenService:              tokenServ,
+		eventPublisher:            eventPub,
+	}
+}
+
+// Execute removes the second factor of the user, confirmed by one of its codes,
+// unless a role of the user requires one.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.TwoFactorCodeInput) error {
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	policy, err := uc.twoFactorPolicyRepository.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := u.DisableTwoFactor(input.Code, time.Now(), policy, uc.totpService, uc.tokenService); err != nil {
+		return err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
@@ -0,0 +1,57 @@
+package disableuser
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.DisableUserUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository         domainuser.Repository
+	refreshTokenRepository domainuser.RefreshTokenRepository
+	eventPublisher         event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		eventPublisher:         eventPub,
+	}
+}
+
+// Execute disables the user and revokes its refresh tokens, its access tokens being rejected from now on.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ManageUserInput) error {
+	if input.ActorID == input.UserID {
+		return applicationuser.ErrCannotManageSelf
+	}
+
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	u.Disable()
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return err
+	}
+
+	if err := uc.refreshTokenRepository.RevokeUserTokens(ctx, u.ID(), time.Now()); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
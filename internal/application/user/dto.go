@@ -1,29 +1,134 @@
 package user
 
-import "meye-core/internal/domain/user"
+import (
+	"meye-core/internal/domain/user"
+	"time"
+)
 
 type CreateUserInput struct {
 	Username string
 	Password string
-	Role     user.UserRole
+	Roles    []user.UserRole
 }
 
 type LoginInput struct {
-	Username string
-	Password string
+	Username  string
+	Password  string
+	UserAgent string
+	IPAddress string
+}
+
+// TokensOutput is the pair of credentials handed to a client on login and on every refresh.
+type TokensOutput struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// LoginOutput holds the tokens, or the challenge to answer with a second factor before getting them.
+type LoginOutput struct {
+	Tokens    *TokensOutput
+	Challenge *LoginChallengeOutput
+}
+
+type LoginChallengeOutput struct {
+	Token     string
+	ExpiresAt time.Time
+	// Set when a role of the user requires a second factor it didn't enroll yet,
+	// the code answering the challenge confirms the enrollment.
+	Enrollment *TwoFactorEnrollmentOutput
+}
+
+// TwoFactorEnrollmentOutput holds the TOTP secret to add to an authenticator app, only shown once.
+type TwoFactorEnrollmentOutput struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type VerifyLoginInput struct {
+	ChallengeToken string
+	Code           string
+	UserAgent      string
+	IPAddress      string
+}
+
+// VerifyLoginOutput holds the tokens of a login completed with a second factor.
+// The recovery codes are only set when the login confirmed the enrollment of the second factor.
+type VerifyLoginOutput struct {
+	Tokens        TokensOutput
+	RecoveryCodes []string
+}
+
+type TwoFactorCodeInput struct {
+	UserID string
+	Code   string
+}
+
+type TwoFactorPolicyOutput struct {
+	RequiredRoles []user.UserRole
+}
+
+type RefreshTokenInput struct {
+	RefreshToken string
+	UserAgent    string
+	IPAddress    string
+}
+
+type LogoutInput struct {
+	UserID       string
+	RefreshToken string
+}
+
+type ChangePasswordInput struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+}
+
+// PasswordResetOutput holds the one-time reset token, only shown to the admin who issued it.
+type PasswordResetOutput struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+type ListUsersInput struct {
+	Role           user.UserRole // users holding it, among others
+	UsernamePrefix string
+	Page           int
+	Size           int
+}
+
+// ManageUserInput identifies the user an admin acts on, admins can't act on themselves.
+type ManageUserInput struct {
+	ActorID string
+	UserID  string
+}
+
+type ChangeUserRolesInput struct {
+	ActorID string
+	UserID  string
+	Roles   []user.UserRole
 }
 
 type UserOutput struct {
-	ID       string
-	Username string
-	Role     user.UserRole
+	ID               string
+	Username         string
+	Roles            []user.UserRole
+	Disabled         bool
+	TwoFactorEnabled bool // the logins of the user need a second factor
 }
 
 func MapUserOutput(u *user.User) UserOutput {
 	return UserOutput{
-		ID:       u.ID(),
-		Username: u.Username(),
-		Role:     u.Role(),
+		ID:               u.ID(),
+		Username:         u.Username(),
+		Roles:            u.Roles(),
+		Disabled:         u.IsDisabled(),
+		TwoFactorEnabled: u.HasTwoFactor(),
 	}
 }
 
@@ -31,3 +136,42 @@ type PaginationInput struct {
 	Page int
 	Size int
 }
+
+type CreateAPIKeyInput struct {
+	Name      string
+	UserID    string // the service account the key acts as
+	Scopes    []user.APIKeyScope
+	ExpiresAt time.Time
+}
+
+// CreatedAPIKeyOutput holds the secret of a new key, only shown to the admin who created it.
+type CreatedAPIKeyOutput struct {
+	APIKey APIKeyOutput
+	Secret string
+}
+
+type APIKeyOutput struct {
+	ID         string
+	Name       string
+	UserID     string
+	Prefix     string
+	Scopes     []user.APIKeyScope
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func MapAPIKeyOutput(k *user.APIKey) APIKeyOutput {
+	return APIKeyOutput{
+		ID:         k.ID(),
+		Name:       k.Name(),
+		UserID:     k.UserID(),
+		Prefix:     k.Prefix(),
+		Scopes:     k.Scopes(),
+		CreatedAt:  k.CreatedAt(),
+		ExpiresAt:  k.ExpiresAt(),
+		LastUsedAt: k.LastUsedAt(),
+		RevokedAt:  k.RevokedAt(),
+	}
+}
@@ -0,0 +1,45 @@
+package enableuser
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.EnableUserUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+	eventPublisher event.Publisher
+}
+
+func New(userRepo domainuser.Repository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+		eventPublisher: eventPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ManageUserInput) error {
+	if input.ActorID == input.UserID {
+		return applicationuser.ErrCannotManageSelf
+	}
+
+	u, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	u.Enable()
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
@@ -0,0 +1,47 @@
+package enrolltwofactor
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.EnrollTwoFactorUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+	totpService    domainuser.TOTPService
+}
+
+func New(userRepo domainuser.Repository, totpServ domainuser.TOTPService) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+		totpService:    totpServ,
+	}
+}
+
+// Execute generates a new TOTP secret for the user, which stays pending until a code confirms it.
+func (uc *UseCase) Execute(ctx context.Context, userID string) (applicationuser.TwoFactorEnrollmentOutput, error) {
+	u, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return applicationuser.TwoFactorEnrollmentOutput{}, err
+	}
+
+	if u == nil {
+		return applicationuser.TwoFactorEnrollmentOutput{}, applicationuser.ErrUserNotFound
+	}
+
+	secret, uri, err := u.EnrollTwoFactor(uc.totpService)
+	if err != nil {
+		return applicationuser.TwoFactorEnrollmentOutput{}, err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return applicationuser.TwoFactorEnrollmentOutput{}, err
+	}
+
+	return applicationuser.TwoFactorEnrollmentOutput{
+		Secret:          secret,
+		ProvisioningURI: uri,
+	}, nil
+}
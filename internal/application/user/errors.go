@@ -6,4 +6,7 @@ var (
 	ErrUsernameAlreadyExists = errors.New("USERNAME_ALREADY_EXISTS")
 	ErrInvalidCredentials    = errors.New("INVALID_CREDENTIALS")
 	ErrUserNotFound          = errors.New("USER_NOT_FOUND")
+	ErrCannotManageSelf      = errors.New("CANNOT_MANAGE_SELF")
+	ErrUserHasCampaignData   = errors.New("USER_HAS_CAMPAIGN_DATA")
+	ErrAPIKeyNotFound        = errors.New("API_KEY_NOT_FOUND")
 )
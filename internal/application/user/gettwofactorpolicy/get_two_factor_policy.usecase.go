@@ -0,0 +1,30 @@
+package gettwofactorpolicy
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.GetTwoFactorPolicyUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	twoFactorPolicyRepository domainuser.TwoFactorPolicyRepository
+}
+
+func New(twoFactorPolicyRepo domainuser.TwoFactorPolicyRepository) *UseCase {
+	return &UseCase{
+		twoFactorPolicyRepository: twoFactorPolicyRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context) (applicationuser.TwoFactorPolicyOutput, error) {
+	policy, err := uc.twoFactorPolicyRepository.Get(ctx)
+	if err != nil {
+		return applicationuser.TwoFactorPolicyOutput{}, err
+	}
+
+	return applicationuser.TwoFactorPolicyOutput{
+		RequiredRoles: policy.RequiredRoles(),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package issuepasswordreset
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.IssuePasswordResetUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository         domainuser.Repository
+	refreshTokenRepository domainuser.RefreshTokenRepository
+	tokenService           domainuser.TokenService
+	eventPublisher         event.Publisher
+	resetTokenTTL          time.Duration
+}
+
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	tokenServ domainuser.TokenService,
+	eventPub event.Publisher,
+	resetTokenTTL time.Duration,
+) *UseCase {
+	return &UseCase{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		tokenService:           tokenServ,
+		eventPublisher:         eventPub,
+		resetTokenTTL:          resetTokenTTL,
+	}
+}
+
+// Execute issues a reset token for the user and logs it out everywhere, in case the account was compromised.
+func (uc *UseCase) Execute(ctx context.Context, userID string) (applicationuser.PasswordResetOutput, error) {
+	u, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return applicationuser.PasswordResetOutput{}, err
+	}
+
+	if u == nil {
+		return applicationuser.PasswordResetOutput{}, applicationuser.ErrUserNotFound
+	}
+
+	token, expiresAt, err := u.IssuePasswordReset(uc.resetTokenTTL, uc.tokenService)
+	if err != nil {
+		return applicationuser.PasswordResetOutput{}, err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return applicationuser.PasswordResetOutput{}, err
+	}
+
+	if err := uc.refreshTokenRepository.RevokeUserTokens(ctx, u.ID(), time.Now()); err != nil {
+		return applicationuser.PasswordResetOutput{}, err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, u.UncommittedEvents()); err != nil {
+		return applicationuser.PasswordResetOutput{}, err
+	}
+
+	return applicationuser.PasswordResetOutput{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
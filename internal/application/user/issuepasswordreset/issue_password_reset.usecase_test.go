@@ -0,0 +1,128 @@
+package issuepasswordreset_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/issuepasswordreset"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	resetToken     = "reset-token"
+	resetTokenHash = "reset-token-hash"
+	resetTokenTTL  = time.Hour
+)
+
+func TestIssuePasswordResetUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	tests := []struct {
+		name         string
+		pendingReset *user.PasswordReset
+		userFound    bool
+		findErr      error
+		generateErr  error
+		save         bool
+		saveErr      error
+		wantErr      error
+	}{
+		{
+			name:      "issues a reset token",
+			userFound: true,
+			save:      true,
+		},
+		{
+			name:         "replaces the pending reset",
+			pendingReset: user.CreatePasswordResetWithoutValidation("previous-hash", time.Now().Add(time.Minute)),
+			userFound:    true,
+			save:         true,
+		},
+		{
+			name:    "user not found",
+			wantErr: applicationuser.ErrUserNotFound,
+		},
+		{
+			name:    "error on user lookup",
+			findErr: errTest,
+			wantErr: errTest,
+		},
+		{
+			name:        "error on token generation",
+			userFound:   true,
+			generateErr: errTest,
+			wantErr:     errTest,
+		},
+		{
+			name:      "error on save",
+			userFound: true,
+			save:      true,
+			saveErr:   errTest,
+			wantErr:   errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepoMock := mocks.NewMockUserRepository(ctrl)
+			refreshTokenRepoMock := mocks.NewMockRefreshTokenRepository(ctrl)
+			tokenServiceMock := mocks.NewMockTokenService(ctrl)
+			publisherMock := mocks.NewMockPublisher(ctrl)
+
+			var u *user.User
+			if tt.userFound {
+				u = user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, tt.pendingReset, false, nil)
+				tokenServiceMock.EXPECT().Generate().Return(resetToken, tt.generateErr)
+				tokenServiceMock.EXPECT().Hash(resetToken).Return(resetTokenHash).AnyTimes()
+			}
+
+			userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(u, tt.findErr)
+
+			if tt.save {
+				userRepoMock.EXPECT().Save(ctx, u).Return(tt.saveErr)
+
+				if tt.saveErr == nil {
+					refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, data.UserID, gomock.Any()).Return(nil)
+				}
+			}
+
+			if tt.wantErr == nil {
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, event.EventTypePasswordResetIssued, events[0].Type())
+					return nil
+				})
+			}
+
+			uc := issuepasswordreset.New(userRepoMock, refreshTokenRepoMock, tokenServiceMock, publisherMock, resetTokenTTL)
+
+			before := time.Now()
+			output, err := uc.Execute(ctx, data.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, resetToken, output.Token)
+				assert.WithinRange(t, output.ExpiresAt, before.Add(resetTokenTTL), time.Now().Add(resetTokenTTL))
+
+				reset := u.PasswordReset()
+				assert.Equal(t, resetTokenHash, reset.TokenHash())
+				assert.Equal(t, output.ExpiresAt, reset.ExpiresAt())
+				assert.False(t, reset.IsExpired(time.Now()))
+				assert.Equal(t, 1, u.TokenVersion())
+			}
+		})
+	}
+}
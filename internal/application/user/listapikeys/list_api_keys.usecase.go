@@ -0,0 +1,33 @@
+package listapikeys
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.ListAPIKeysUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	apiKeyRepository domainuser.APIKeyRepository
+}
+
+func New(apiKeyRepo domainuser.APIKeyRepository) *UseCase {
+	return &UseCase{
+		apiKeyRepository: apiKeyRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context) ([]applicationuser.APIKeyOutput, error) {
+	keys, err := uc.apiKeyRepository.List(ctx)
+	if err != nil {
+		return []applicationuser.APIKeyOutput{}, err
+	}
+
+	output := make([]applicationuser.APIKeyOutput, 0, len(keys))
+	for i := range keys {
+		output = append(output, applicationuser.MapAPIKeyOutput(keys[i]))
+	}
+
+	return output, nil
+}
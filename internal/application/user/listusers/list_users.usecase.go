@@ -0,0 +1,38 @@
+package listusers
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.ListUsersUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+}
+
+func New(userRepo domainuser.Repository) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ListUsersInput) ([]applicationuser.UserOutput, error) {
+	users, err := uc.userRepository.Find(ctx, domainuser.UserFilter{
+		Role:           input.Role,
+		UsernamePrefix: input.UsernamePrefix,
+		Page:           input.Page,
+		Size:           input.Size,
+	})
+	if err != nil {
+		return []applicationuser.UserOutput{}, err
+	}
+
+	output := make([]applicationuser.UserOutput, 0, len(users))
+	for i := range users {
+		output = append(output, applicationuser.MapUserOutput(users[i]))
+	}
+
+	return output, nil
+}
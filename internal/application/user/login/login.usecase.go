@@ -3,44 +3,250 @@ package login
 import (
 	"context"
 	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/shared"
 	domainuser "meye-core/internal/domain/user"
+	"sync"
+	"time"
 )
 
+// dummyPassword is hashed once to be compared with the password of the unknown usernames
+const dummyPassword = "meye-core-dummy-password"
+
 // Compile-time check to ensure UseCase implements the port interface
 var _ applicationuser.LoginUseCase = (*UseCase)(nil)
 
 type UseCase struct {
-	userRepository domainuser.Repository
-	hashService    domainuser.HashService
-	jwtService     domainuser.JWTService
+	userRepository            domainuser.Repository
+	refreshTokenRepository    domainuser.RefreshTokenRepository
+	loginChallengeRepository  domainuser.LoginChallengeRepository
+	twoFactorPolicyRepository domainuser.TwoFactorPolicyRepository
+	hashService               domainuser.HashService
+	jwtService                domainuser.JWTService
+	identificationService     shared.IdentificationService
+	tokenService              domainuser.TokenService
+	totpService               domainuser.TOTPService
+	refreshTokenTTL           time.Duration
+	loginChallengeTTL         time.Duration
+	loginGuard                *domainuser.LoginGuard
+	eventPublisher            event.Publisher
+
+	dummyHashOnce sync.Once
+	dummyHash     string
 }
 
-func New(userRepo domainuser.Repository, hashServ domainuser.HashService, jwtServ domainuser.JWTService) *UseCase {
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	loginChallengeRepo domainuser.LoginChallengeRepository,
+	twoFactorPolicyRepo domainuser.TwoFactorPolicyRepository,
+	hashServ domainuser.HashService,
+	jwtServ domainuser.JWTService,
+	idServ shared.IdentificationService,
+	tokenServ domainuser.TokenService,
+	totpServ domainuser.TOTPService,
+	refreshTokenTTL time.Duration,
+	loginChallengeTTL time.Duration,
+	loginGuard *domainuser.LoginGuard,
+	eventPub event.Publisher,
+) *UseCase {
 	return &UseCase{
-		userRepository: userRepo,
-		hashService:    hashServ,
-		jwtService:     jwtServ,
+		userRepository:            userRepo,
+		refreshTokenRepository:    refreshTokenRepo,
+		loginChallengeRepository:  loginChallengeRepo,
+		twoFactorPolicyRepository: twoFactorPolicyRepo,
+		hashService:               hashServ,
+		jwtService:                jwtServ,
+		identificationService:     idServ,
+		tokenService:              tokenServ,
+		totpService:               totpServ,
+		refreshTokenTTL:           refreshTokenTTL,
+		loginChallengeTTL:         loginChallengeTTL,
+		loginGuard:                loginGuard,
+		eventPublisher:            eventPub,
 	}
 }
 
-func (uc *UseCase) Execute(ctx context.Context, input applicationuser.LoginInput) (string, error) {
+// Execute checks the credentials, unless the username or the IP address failed too often lately.
+// Unknown usernames are throttled like existing ones, so the responses don't tell them apart.
+// Users with a second factor, or holding a role that requires one, get a challenge instead of the tokens.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.LoginInput) (applicationuser.LoginOutput, error) {
+	now := time.Now()
+
+	if err := uc.loginGuard.Check(ctx, input.Username, input.IPAddress, now); err != nil {
+		return applicationuser.LoginOutput{}, err
+	}
+
 	user, err := uc.userRepository.FindByUsername(ctx, input.Username)
 	if err != nil {
-		return "", err
+		return applicationuser.LoginOutput{}, err
 	}
 
 	if user == nil {
-		return "", applicationuser.ErrInvalidCredentials
+		// Compared anyway, so unknown usernames take as long to answer as wrong passwords
+		_ = uc.hashService.Compare(input.Password, uc.getDummyHash())
+
+		return applicationuser.LoginOutput{}, uc.recordFailure(ctx, input, now)
 	}
 
 	if err := uc.hashService.Compare(input.Password, user.HashedPassword()); err != nil {
-		return "", applicationuser.ErrInvalidCredentials
+		return applicationuser.LoginOutput{}, uc.recordFailure(ctx, input, now)
+	}
+
+	// Checked once the password matched, not to reveal which accounts are disabled
+	if user.IsDisabled() {
+		return applicationuser.LoginOutput{}, domainuser.ErrUserDisabled
+	}
+
+	// The plain password is only known here, so this is where old hashes get upgraded
+	rehashed, err := user.RehashPassword(input.Password, uc.hashService)
+	if err != nil {
+		return applicationuser.LoginOutput{}, err
+	}
+
+	if rehashed {
+		if err := uc.userRepository.Save(ctx, user); err != nil {
+			return applicationuser.LoginOutput{}, err
+		}
+	}
+
+	challenged, err := uc.needsSecondFactor(ctx, user)
+	if err != nil {
+		return applicationuser.LoginOutput{}, err
+	}
+
+	// The failures are only reset once the second factor is checked too,
+	// so that the password alone doesn't allow guessing codes endlessly
+	if challenged {
+		challenge, err := uc.openChallenge(ctx, user)
+		if err != nil {
+			return applicationuser.LoginOutput{}, err
+		}
+
+		return applicationuser.LoginOutput{Challenge: &challenge}, nil
+	}
+
+	if err := uc.loginGuard.Reset(ctx, input.Username); err != nil {
+		return applicationuser.LoginOutput{}, err
+	}
+
+	tokens, err := uc.issueTokens(ctx, user, input)
+	if err != nil {
+		return applicationuser.LoginOutput{}, err
+	}
+
+	return applicationuser.LoginOutput{Tokens: &tokens}, nil
+}
+
+func (uc *UseCase) needsSecondFactor(ctx context.Context, user *domainuser.User) (bool, error) {
+	if user.HasTwoFactor() {
+		return true, nil
+	}
+
+	policy, err := uc.twoFactorPolicyRepository.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return policy.Requires(user.Roles()), nil
+}
+
+// openChallenge starts the second step of the login. A user who has to use a second factor
+// but has no secret yet gets one, confirmed by the code answering the challenge. A pending secret
+// is never replaced nor shown again: it was handed out once, replacing it on login would let anyone
+// knowing the password take the enrollment over. A user who lost it asks an admin to clear it.
+func (uc *UseCase) openChallenge(ctx context.Context, user *domainuser.User) (applicationuser.LoginChallengeOutput, error) {
+	var enrollment *applicationuser.TwoFactorEnrollmentOutput
+
+	if user.TwoFactor() == nil {
+		secret, uri, err := user.EnrollTwoFactor(uc.totpService)
+		if err != nil {
+			return applicationuser.LoginChallengeOutput{}, err
+		}
+
+		if err := uc.userRepository.Save(ctx, user); err != nil {
+			return applicationuser.LoginChallengeOutput{}, err
+		}
+
+		enrollment = &applicationuser.TwoFactorEnrollmentOutput{
+			Secret:          secret,
+			ProvisioningURI: uri,
+		}
 	}
 
+	challenge, token, err := domainuser.NewLoginChallenge(
+		user.ID(),
+		uc.loginChallengeTTL,
+		uc.identificationService,
+		uc.tokenService,
+	)
+	if err != nil {
+		return applicationuser.LoginChallengeOutput{}, err
+	}
+
+	if err := uc.loginChallengeRepository.Save(ctx, challenge); err != nil {
+		return applicationuser.LoginChallengeOutput{}, err
+	}
+
+	return applicationuser.LoginChallengeOutput{
+		Token:      token,
+		ExpiresAt:  challenge.ExpiresAt(),
+		Enrollment: enrollment,
+	}, nil
+}
+
+func (uc *UseCase) issueTokens(ctx context.Context, user *domainuser.User, input applicationuser.LoginInput) (applicationuser.TokensOutput, error) {
 	token, err := uc.jwtService.GenerateSignedToken(user)
 	if err != nil {
-		return "", err
+		return applicationuser.TokensOutput{}, err
+	}
+
+	refreshToken, secret, err := domainuser.NewRefreshToken(
+		user.ID(),
+		input.UserAgent,
+		input.IPAddress,
+		uc.refreshTokenTTL,
+		uc.identificationService,
+		uc.tokenService,
+	)
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	if err := uc.refreshTokenRepository.Save(ctx, refreshToken); err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	return applicationuser.TokensOutput{
+		AccessToken:  token,
+		RefreshToken: secret,
+	}, nil
+}
+
+// getDummyHash hashes the dummy password with the current algorithm and parameters on first use,
+// so comparing with it costs as much as comparing with the hash of a user.
+func (uc *UseCase) getDummyHash() string {
+	uc.dummyHashOnce.Do(func() {
+		hash, err := uc.hashService.Hash(dummyPassword)
+		if err == nil {
+			uc.dummyHash = hash
+		}
+	})
+
+	return uc.dummyHash
+}
+
+// recordFailure counts the failed login against the username and the IP address,
+// and returns the error to answer with.
+func (uc *UseCase) recordFailure(ctx context.Context, input applicationuser.LoginInput, now time.Time) error {
+	events, err := uc.loginGuard.RecordFailure(ctx, input.Username, input.IPAddress, now)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, events); err != nil {
+		return err
 	}
 
-	return token, nil
+	return applicationuser.ErrInvalidCredentials
 }
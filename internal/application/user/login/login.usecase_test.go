@@ -0,0 +1,272 @@
+package login_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/login"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	ipAddress   = "203.0.113.7"
+	usernameKey = "username:" + data.Username
+	ipKey       = "ip:" + ipAddress
+	window      = 15 * time.Minute
+)
+
+type loginMocks struct {
+	userRepo      *mocks.MockUserRepository
+	challengeRepo *mocks.MockLoginChallengeRepository
+	policyRepo    *mocks.MockTwoFactorPolicyRepository
+	hashService   *mocks.MockHashService
+	totpService   *mocks.MockTOTPService
+	store         *mocks.MockLoginAttemptStore
+	publisher     *mocks.MockPublisher
+}
+
+func TestLoginUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	input := applicationuser.LoginInput{Username: data.Username, Password: data.Password, IPAddress: ipAddress}
+	throttle := user.NewLoginThrottle(3, time.Second, 30*time.Second, 15*time.Minute, window)
+	existing := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+
+	setup := func(t *testing.T) (*login.UseCase, loginMocks) {
+		ctrl := gomock.NewController(t)
+
+		m := loginMocks{
+			userRepo:      mocks.NewMockUserRepository(ctrl),
+			challengeRepo: mocks.NewMockLoginChallengeRepository(ctrl),
+			policyRepo:    mocks.NewMockTwoFactorPolicyRepository(ctrl),
+			hashService:   mocks.NewMockHashService(ctrl),
+			totpService:   mocks.NewMockTOTPService(ctrl),
+			store:         mocks.NewMockLoginAttemptStore(ctrl),
+			publisher:     mocks.NewMockPublisher(ctrl),
+		}
+		refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+		jwtService := mocks.NewMockJWTService(ctrl)
+		idService := mocks.NewMockIdentificationService(ctrl)
+		tokenService := mocks.NewMockTokenService(ctrl)
+
+		jwtService.EXPECT().GenerateSignedToken(gomock.Any()).Return("access-token", nil).AnyTimes()
+		idService.EXPECT().GenerateID().Return("id").AnyTimes()
+		tokenService.EXPECT().Generate().Return("secret-token", nil).AnyTimes()
+		tokenService.EXPECT().Hash("secret-token").Return("secret-token-hash").AnyTimes()
+		refreshRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil).AnyTimes()
+
+		uc := login.New(
+			m.userRepo,
+			refreshRepo,
+			m.challengeRepo,
+			m.policyRepo,
+			m.hashService,
+			jwtService,
+			idService,
+			tokenService,
+			m.totpService,
+			time.Hour,
+			5*time.Minute,
+			user.NewLoginGuard(m.store, throttle, throttle),
+			m.publisher,
+		)
+
+		return uc, m
+	}
+
+	// passwordMatches expects a login of u with the right password, hashed with the current parameters
+	passwordMatches := func(m loginMocks, u *user.User) {
+		m.store.EXPECT().Find(ctx, usernameKey).Return(user.LoginAttempts{}, nil)
+		m.store.EXPECT().Find(ctx, ipKey).Return(user.LoginAttempts{}, nil)
+		m.userRepo.EXPECT().FindByUsername(ctx, data.Username).Return(u, nil)
+		m.hashService.EXPECT().Compare(data.Password, data.HashedPassword).Return(nil)
+		m.hashService.EXPECT().NeedsRehash(data.HashedPassword).Return(false)
+	}
+
+	t.Run("successful login resets the username failures", func(t *testing.T) {
+		uc, m := setup(t)
+
+		passwordMatches(m, existing)
+		m.policyRepo.EXPECT().Get(ctx).Return(user.TwoFactorPolicy{}, nil)
+		m.store.EXPECT().Reset(ctx, usernameKey).Return(nil)
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &applicationuser.TokensOutput{AccessToken: "access-token", RefreshToken: "secret-token"}, output.Tokens)
+		assert.Nil(t, output.Challenge)
+	})
+
+	t.Run("successful login upgrades an outdated password hash", func(t *testing.T) {
+		uc, m := setup(t)
+		outdated := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 4, nil, false, nil)
+
+		m.store.EXPECT().Find(ctx, usernameKey).Return(user.LoginAttempts{}, nil)
+		m.store.EXPECT().Find(ctx, ipKey).Return(user.LoginAttempts{}, nil)
+		m.userRepo.EXPECT().FindByUsername(ctx, data.Username).Return(outdated, nil)
+		m.hashService.EXPECT().Compare(data.Password, data.HashedPassword).Return(nil)
+		m.hashService.EXPECT().NeedsRehash(data.HashedPassword).Return(true)
+		m.hashService.EXPECT().Hash(data.Password).Return("new-hash", nil)
+		m.policyRepo.EXPECT().Get(ctx).Return(user.TwoFactorPolicy{}, nil)
+		m.store.EXPECT().Reset(ctx, usernameKey).Return(nil)
+		m.userRepo.EXPECT().Save(ctx, outdated).DoAndReturn(func(_ context.Context, u *user.User) error {
+			assert.Equal(t, "new-hash", u.HashedPassword())
+			assert.Equal(t, 4, u.TokenVersion())
+			assert.Empty(t, u.UncommittedEvents())
+			return nil
+		})
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("user with a second factor gets a challenge", func(t *testing.T) {
+		uc, m := setup(t)
+		twoFactor := user.CreateTwoFactorWithoutValidation("totp-secret", true, nil, 0)
+		enrolled := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, twoFactor)
+
+		passwordMatches(m, enrolled)
+		m.challengeRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *user.LoginChallenge) error {
+			assert.Equal(t, data.UserID, c.UserID())
+			assert.Equal(t, "secret-token-hash", c.TokenHash())
+			return nil
+		})
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.Tokens)
+		assert.Equal(t, "secret-token", output.Challenge.Token)
+		assert.Nil(t, output.Challenge.Enrollment)
+	})
+
+	t.Run("role requiring a second factor enrolls one", func(t *testing.T) {
+		uc, m := setup(t)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+
+		passwordMatches(m, u)
+		m.policyRepo.EXPECT().Get(ctx).Return(user.CreateTwoFactorPolicyWithoutValidation(data.Roles), nil)
+		m.totpService.EXPECT().GenerateSecret().Return("totp-secret", nil)
+		m.totpService.EXPECT().ProvisioningURI("totp-secret", data.Username).Return("otpauth://totp/uri")
+		m.userRepo.EXPECT().Save(ctx, u).Return(nil)
+		m.challengeRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.Tokens)
+		assert.Equal(t, &applicationuser.TwoFactorEnrollmentOutput{Secret: "totp-secret", ProvisioningURI: "otpauth://totp/uri"}, output.Challenge.Enrollment)
+		assert.False(t, u.HasTwoFactor())
+	})
+
+	t.Run("pending enrollment is neither replaced nor shown again", func(t *testing.T) {
+		uc, m := setup(t)
+		pending := user.CreateTwoFactorWithoutValidation("totp-secret", false, nil, 0)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, pending)
+
+		passwordMatches(m, u)
+		m.policyRepo.EXPECT().Get(ctx).Return(user.CreateTwoFactorPolicyWithoutValidation(data.Roles), nil)
+		m.challengeRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.Tokens)
+		assert.Equal(t, "secret-token", output.Challenge.Token)
+		assert.Nil(t, output.Challenge.Enrollment)
+		assert.Equal(t, "totp-secret", u.TwoFactor().Secret())
+	})
+
+	t.Run("second login of a pending enrollment shows a new secret once an admin cleared it", func(t *testing.T) {
+		uc, m := setup(t)
+		pending := user.CreateTwoFactorWithoutValidation("lost-secret", false, nil, 0)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, pending)
+
+		// Before the reset, the lost secret is kept and not shown
+		passwordMatches(m, u)
+		m.policyRepo.EXPECT().Get(ctx).Return(user.CreateTwoFactorPolicyWithoutValidation(data.Roles), nil)
+		m.challengeRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.Challenge.Enrollment)
+
+		assert.NoError(t, u.ClearPendingTwoFactor())
+
+		passwordMatches(m, u)
+		m.policyRepo.EXPECT().Get(ctx).Return(user.CreateTwoFactorPolicyWithoutValidation(data.Roles), nil)
+		m.totpService.EXPECT().GenerateSecret().Return("totp-secret", nil)
+		m.totpService.EXPECT().ProvisioningURI("totp-secret", data.Username).Return("otpauth://totp/uri")
+		m.userRepo.EXPECT().Save(ctx, u).Return(nil)
+		m.challengeRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+		output, err = uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.Tokens)
+		assert.Equal(t, &applicationuser.TwoFactorEnrollmentOutput{Secret: "totp-secret", ProvisioningURI: "otpauth://totp/uri"}, output.Challenge.Enrollment)
+		assert.Equal(t, "totp-secret", u.TwoFactor().Secret())
+	})
+
+	t.Run("throttled login doesn't check the credentials", func(t *testing.T) {
+		uc, m := setup(t)
+
+		m.store.EXPECT().Find(ctx, usernameKey).Return(user.LoginAttempts{Failures: 2, LastFailureAt: time.Now()}, nil)
+		m.store.EXPECT().Find(ctx, ipKey).Return(user.LoginAttempts{}, nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		var throttledErr *user.LoginThrottledError
+		assert.ErrorAs(t, err, &throttledErr)
+		assert.ErrorIs(t, err, user.ErrTooManyLoginAttempts)
+		assert.Greater(t, throttledErr.RetryAfter, time.Second)
+	})
+
+	t.Run("unknown username is compared with a dummy hash and counts as a failure", func(t *testing.T) {
+		uc, m := setup(t)
+
+		m.store.EXPECT().Find(ctx, usernameKey).Return(user.LoginAttempts{}, nil)
+		m.store.EXPECT().Find(ctx, ipKey).Return(user.LoginAttempts{}, nil)
+		m.userRepo.EXPECT().FindByUsername(ctx, data.Username).Return(nil, nil)
+		m.hashService.EXPECT().Hash(gomock.Any()).Return("dummy-hash", nil)
+		m.hashService.EXPECT().Compare(data.Password, "dummy-hash").Return(errors.New("mismatch"))
+		m.store.EXPECT().RecordFailure(ctx, usernameKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.store.EXPECT().RecordFailure(ctx, ipKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.publisher.EXPECT().Publish(ctx, gomock.Len(1)).Return(nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, applicationuser.ErrInvalidCredentials, err)
+	})
+
+	t.Run("too many wrong passwords lock the username out", func(t *testing.T) {
+		uc, m := setup(t)
+
+		m.store.EXPECT().Find(ctx, usernameKey).Return(user.LoginAttempts{}, nil)
+		m.store.EXPECT().Find(ctx, ipKey).Return(user.LoginAttempts{}, nil)
+		m.userRepo.EXPECT().FindByUsername(ctx, data.Username).Return(existing, nil)
+		m.hashService.EXPECT().Compare(data.Password, data.HashedPassword).Return(errors.New("mismatch"))
+		m.store.EXPECT().RecordFailure(ctx, usernameKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 3}, nil)
+		m.store.EXPECT().RecordFailure(ctx, ipKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.store.EXPECT().Lock(ctx, usernameKey, gomock.Any()).Return(nil)
+		m.publisher.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+			assert.Len(t, events, 2)
+			assert.Equal(t, event.EventTypeLoginFailed, events[0].Type())
+			assert.Equal(t, event.EventTypeAccountLocked, events[1].Type())
+			return nil
+		})
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, applicationuser.ErrInvalidCredentials, err)
+	})
+}
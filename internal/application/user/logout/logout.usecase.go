@@ -0,0 +1,37 @@
+package logout
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.LogoutUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	refreshTokenRepository domainuser.RefreshTokenRepository
+	tokenService           domainuser.TokenService
+}
+
+func New(refreshTokenRepo domainuser.RefreshTokenRepository, tokenServ domainuser.TokenService) *UseCase {
+	return &UseCase{
+		refreshTokenRepository: refreshTokenRepo,
+		tokenService:           tokenServ,
+	}
+}
+
+// Execute revokes the family of the refresh token, ending the session it was issued for.
+// Access tokens already issued stay valid until they expire.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.LogoutInput) error {
+	token, err := uc.refreshTokenRepository.FindByHash(ctx, uc.tokenService.Hash(input.RefreshToken))
+	if err != nil {
+		return err
+	}
+
+	if token == nil || token.UserID() != input.UserID {
+		return domainuser.ErrInvalidRefreshToken
+	}
+
+	return uc.refreshTokenRepository.RevokeFamily(ctx, token.FamilyID(), time.Now())
+}
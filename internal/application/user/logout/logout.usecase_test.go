@@ -0,0 +1,96 @@
+package logout_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/logout"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	refreshToken     = "refresh-token"
+	refreshTokenHash = "refresh-token-hash"
+	familyID         = "family-id"
+)
+
+func TestLogoutUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+	token := user.CreateRefreshTokenWithoutValidation("token-id", data.UserID, familyID, refreshTokenHash, "", "", now, now.Add(time.Hour), nil, nil)
+
+	errTest := errors.New("mock_err")
+
+	tests := []struct {
+		name         string
+		userID       string
+		findToken    *user.RefreshToken
+		findErr      error
+		revokeFamily bool
+		revokeErr    error
+		wantErr      error
+	}{
+		{
+			name:         "revokes the family of the token",
+			userID:       data.UserID,
+			findToken:    token,
+			revokeFamily: true,
+		},
+		{
+			name:    "unknown token",
+			userID:  data.UserID,
+			wantErr: user.ErrInvalidRefreshToken,
+		},
+		{
+			name:      "token of another user",
+			userID:    "other-user-id",
+			findToken: token,
+			wantErr:   user.ErrInvalidRefreshToken,
+		},
+		{
+			name:    "error on token lookup",
+			userID:  data.UserID,
+			findErr: errTest,
+			wantErr: errTest,
+		},
+		{
+			name:         "error on revocation",
+			userID:       data.UserID,
+			findToken:    token,
+			revokeFamily: true,
+			revokeErr:    errTest,
+			wantErr:      errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			refreshTokenRepoMock := mocks.NewMockRefreshTokenRepository(ctrl)
+			tokenServiceMock := mocks.NewMockTokenService(ctrl)
+
+			tokenServiceMock.EXPECT().Hash(refreshToken).Return(refreshTokenHash)
+			refreshTokenRepoMock.EXPECT().FindByHash(ctx, refreshTokenHash).Return(tt.findToken, tt.findErr)
+
+			if tt.revokeFamily {
+				refreshTokenRepoMock.EXPECT().RevokeFamily(ctx, familyID, gomock.Any()).Return(tt.revokeErr)
+			}
+
+			uc := logout.New(refreshTokenRepoMock, tokenServiceMock)
+
+			err := uc.Execute(ctx, applicationuser.LogoutInput{UserID: tt.userID, RefreshToken: refreshToken})
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
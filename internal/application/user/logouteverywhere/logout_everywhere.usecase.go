@@ -0,0 +1,50 @@
+package logouteverywhere
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.LogoutEverywhereUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository         domainuser.Repository
+	refreshTokenRepository domainuser.RefreshTokenRepository
+	eventPublisher         event.Publisher
+}
+
+func New(userRepo domainuser.Repository, refreshTokenRepo domainuser.RefreshTokenRepository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		eventPublisher:         eventPub,
+	}
+}
+
+// Execute revokes every refresh token of the user and bumps its token version,
+// so the access tokens still in flight are rejected too.
+func (uc *UseCase) Execute(ctx context.Context, userID string) error {
+	u, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	if err := uc.refreshTokenRepository.RevokeUserTokens(ctx, u.ID(), time.Now()); err != nil {
+		return err
+	}
+
+	u.RevokeAccessTokens()
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
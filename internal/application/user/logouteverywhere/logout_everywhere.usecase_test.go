@@ -0,0 +1,99 @@
+package logouteverywhere_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/logouteverywhere"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogoutEverywhereUseCase_Execute(t *testing.T) {
+	var userRepoMock *mocks.MockUserRepository
+	var refreshTokenRepoMock *mocks.MockRefreshTokenRepository
+	var publisherMock *mocks.MockPublisher
+
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	newUser := func() *user.User {
+		return user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, []user.UserRole{user.UserRolePlayer}, "", 1, nil, false, nil)
+	}
+
+	tests := []struct {
+		name       string
+		wantErr    error
+		setupMocks func()
+	}{
+		{
+			name: "revokes the refresh tokens and the access tokens",
+			setupMocks: func() {
+				userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(newUser(), nil)
+				refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, data.UserID, gomock.Any()).Return(nil)
+				userRepoMock.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *user.User) error {
+					assert.Equal(t, 2, u.TokenVersion())
+					return nil
+				})
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, event.EventTypeUserTokensRevoked, events[0].Type())
+					return nil
+				})
+			},
+		},
+		{
+			name:    "user not found",
+			wantErr: applicationuser.ErrUserNotFound,
+			setupMocks: func() {
+				userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(nil, nil)
+				refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "error on refresh tokens revocation",
+			wantErr: errTest,
+			setupMocks: func() {
+				userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(newUser(), nil)
+				refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, data.UserID, gomock.Any()).Return(errTest)
+				userRepoMock.EXPECT().Save(ctx, gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:    "error on repository save",
+			wantErr: errTest,
+			setupMocks: func() {
+				userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(newUser(), nil)
+				refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, data.UserID, gomock.Any()).Return(nil)
+				userRepoMock.EXPECT().Save(ctx, gomock.Any()).Return(errTest)
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepoMock = mocks.NewMockUserRepository(ctrl)
+			refreshTokenRepoMock = mocks.NewMockRefreshTokenRepository(ctrl)
+			publisherMock = mocks.NewMockPublisher(ctrl)
+
+			tt.setupMocks()
+
+			uc := logouteverywhere.New(userRepoMock, refreshTokenRepoMock, publisherMock)
+
+			err := uc.Execute(ctx, data.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"meye-core/internal/domain/user"
 )
 
 // CreateUserUseCase defines the port for creating a new user.
@@ -15,7 +16,27 @@ type CreateUserUseCase interface {
 // This interface allows the infrastructure layer to depend on an abstraction
 // rather than concrete use case implementations.
 type LoginUseCase interface {
-	Execute(ctx context.Context, input LoginInput) (string, error)
+	Execute(ctx context.Context, input LoginInput) (LoginOutput, error)
+}
+
+// VerifyLoginUseCase completes a login challenged for a second factor.
+type VerifyLoginUseCase interface {
+	Execute(ctx context.Context, input VerifyLoginInput) (VerifyLoginOutput, error)
+}
+
+// RefreshTokenUseCase exchanges a refresh token for a new pair of tokens, rotating it.
+type RefreshTokenUseCase interface {
+	Execute(ctx context.Context, input RefreshTokenInput) (TokensOutput, error)
+}
+
+// LogoutUseCase revokes the session of the presented refresh token.
+type LogoutUseCase interface {
+	Execute(ctx context.Context, input LogoutInput) error
+}
+
+// LogoutEverywhereUseCase revokes every refresh and access token of a user.
+type LogoutEverywhereUseCase interface {
+	Execute(ctx context.Context, userID string) error
 }
 
 type GetPlayersUseCase interface {
@@ -25,3 +46,90 @@ type GetPlayersUseCase interface {
 type GetUserUseCase interface {
 	Execute(ctx context.Context, userID string) (UserOutput, error)
 }
+
+// RotateCalendarTokenUseCase issues the secret token of the calendar feed of a user.
+type RotateCalendarTokenUseCase interface {
+	Execute(ctx context.Context, userID string) (string, error)
+}
+
+type RevokeCalendarTokenUseCase interface {
+	Execute(ctx context.Context, userID string) error
+}
+
+// ChangePasswordUseCase replaces the password of a user who knows the current one.
+type ChangePasswordUseCase interface {
+	Execute(ctx context.Context, input ChangePasswordInput) error
+}
+
+// IssuePasswordResetUseCase lets an admin hand a user a one-time token to choose a new password.
+type IssuePasswordResetUseCase interface {
+	Execute(ctx context.Context, userID string) (PasswordResetOutput, error)
+}
+
+type ResetPasswordUseCase interface {
+	Execute(ctx context.Context, input ResetPasswordInput) error
+}
+
+// ListUsersUseCase lists the users for the admins, optionally filtered by role and username prefix.
+type ListUsersUseCase interface {
+	Execute(ctx context.Context, input ListUsersInput) ([]UserOutput, error)
+}
+
+// DisableUserUseCase locks a user out, logging it out everywhere.
+type DisableUserUseCase interface {
+	Execute(ctx context.Context, input ManageUserInput) error
+}
+
+type EnableUserUseCase interface {
+	Execute(ctx context.Context, input ManageUserInput) error
+}
+
+type ChangeUserRolesUseCase interface {
+	Execute(ctx context.Context, input ChangeUserRolesInput) (UserOutput, error)
+}
+
+// DeleteUserUseCase removes a user that holds no campaign data.
+type DeleteUserUseCase interface {
+	Execute(ctx context.Context, input ManageUserInput) error
+}
+
+// EnrollTwoFactorUseCase starts the enrollment of a TOTP second factor for a user.
+type EnrollTwoFactorUseCase interface {
+	Execute(ctx context.Context, userID string) (TwoFactorEnrollmentOutput, error)
+}
+
+// ConfirmTwoFactorUseCase enables the pending second factor of a user, returning its recovery codes.
+type ConfirmTwoFactorUseCase interface {
+	Execute(ctx context.Context, input TwoFactorCodeInput) ([]string, error)
+}
+
+type DisableTwoFactorUseCase interface {
+	Execute(ctx context.Context, input TwoFactorCodeInput) error
+}
+
+// ClearTwoFactorUseCase lets an admin drop the pending enrollment of a user who lost its secret.
+type ClearTwoFactorUseCase interface {
+	Execute(ctx context.Context, userID string) error
+}
+
+type GetTwoFactorPolicyUseCase interface {
+	Execute(ctx context.Context) (TwoFactorPolicyOutput, error)
+}
+
+// UpdateTwoFactorPolicyUseCase sets the roles whose users can't login without a second factor.
+type UpdateTwoFactorPolicyUseCase interface {
+	Execute(ctx context.Context, requiredRoles []user.UserRole) (TwoFactorPolicyOutput, error)
+}
+
+// CreateAPIKeyUseCase issues a scoped API key for a service account, returning its secret once.
+type CreateAPIKeyUseCase interface {
+	Execute(ctx context.Context, input CreateAPIKeyInput) (CreatedAPIKeyOutput, error)
+}
+
+type ListAPIKeysUseCase interface {
+	Execute(ctx context.Context) ([]APIKeyOutput, error)
+}
+
+type RevokeAPIKeyUseCase interface {
+	Execute(ctx context.Context, apiKeyID string) error
+}
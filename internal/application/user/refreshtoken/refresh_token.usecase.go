@@ -0,0 +1,120 @@
+package refreshtoken
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/shared"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.RefreshTokenUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository            domainuser.Repository
+	refreshTokenRepository    domainuser.RefreshTokenRepository
+	twoFactorPolicyRepository domainuser.TwoFactorPolicyRepository
+	jwtService                domainuser.JWTService
+	identificationService     shared.IdentificationService
+	tokenService              domainuser.TokenService
+	refreshTokenTTL           time.Duration
+}
+
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	twoFactorPolicyRepo domainuser.TwoFactorPolicyRepository,
+	jwtServ domainuser.JWTService,
+	idServ shared.IdentificationService,
+	tokenServ domainuser.TokenService,
+	refreshTokenTTL time.Duration,
+) *UseCase {
+	return &UseCase{
+		userRepository:            userRepo,
+		refreshTokenRepository:    refreshTokenRepo,
+		twoFactorPolicyRepository: twoFactorPolicyRepo,
+		jwtService:                jwtServ,
+		identificationService:     idServ,
+		tokenService:              tokenServ,
+		refreshTokenTTL:           refreshTokenTTL,
+	}
+}
+
+// Execute rotates the refresh token and issues a new access token.
+// Presenting a token that was already rotated revokes its whole family,
+// so a stolen token can only be used until its legitimate owner refreshes.
+// A user holding a role that now requires a second factor, without one, has its family revoked
+// instead: it has to login again, which enrolls the second factor.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.RefreshTokenInput) (applicationuser.TokensOutput, error) {
+	current, err := uc.refreshTokenRepository.FindByHash(ctx, uc.tokenService.Hash(input.RefreshToken))
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	if current == nil {
+		return applicationuser.TokensOutput{}, domainuser.ErrInvalidRefreshToken
+	}
+
+	u, err := uc.userRepository.FindByID(ctx, current.UserID())
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	if u == nil {
+		return applicationuser.TokensOutput{}, domainuser.ErrInvalidRefreshToken
+	}
+
+	if u.IsDisabled() {
+		return applicationuser.TokensOutput{}, domainuser.ErrUserDisabled
+	}
+
+	next, secret, err := current.Rotate(
+		input.UserAgent,
+		input.IPAddress,
+		uc.refreshTokenTTL,
+		uc.identificationService,
+		uc.tokenService,
+	)
+	if err == nil {
+		err = uc.refreshTokenRepository.SaveRotation(ctx, current, next)
+	}
+
+	if errors.Is(err, domainuser.ErrRefreshTokenReused) {
+		if revokeErr := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID(), time.Now()); revokeErr != nil {
+			return applicationuser.TokensOutput{}, revokeErr
+		}
+
+		return applicationuser.TokensOutput{}, err
+	}
+
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	// Checked once the token is known valid, the family revoked includes the token just rotated
+	if !u.HasTwoFactor() {
+		policy, err := uc.twoFactorPolicyRepository.Get(ctx)
+		if err != nil {
+			return applicationuser.TokensOutput{}, err
+		}
+
+		if policy.Requires(u.Roles()) {
+			if err := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID(), time.Now()); err != nil {
+				return applicationuser.TokensOutput{}, err
+			}
+
+			return applicationuser.TokensOutput{}, domainuser.ErrTwoFactorRequired
+		}
+	}
+
+	token, err := uc.jwtService.GenerateSignedToken(u)
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	return applicationuser.TokensOutput{
+		AccessToken:  token,
+		RefreshToken: secret,
+	}, nil
+}
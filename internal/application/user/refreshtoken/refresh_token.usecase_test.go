@@ -0,0 +1,179 @@
+package refreshtoken_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/refreshtoken"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	refreshToken     = "refresh-token"
+	refreshTokenHash = "refresh-token-hash"
+	nextToken        = "next-refresh-token"
+	familyID         = "family-id"
+	ttl              = 24 * time.Hour
+)
+
+func TestRefreshTokenUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	owner := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, []user.UserRole{user.UserRolePlayer}, "", 0, nil, false, nil)
+	ownerWithTwoFactor := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, []user.UserRole{user.UserRolePlayer}, "", 0, nil, false,
+		user.CreateTwoFactorWithoutValidation("secret", true, nil, 0))
+	playersNeedTwoFactor := user.CreateTwoFactorPolicyWithoutValidation([]user.UserRole{user.UserRolePlayer})
+
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+
+	active := func() *user.RefreshToken {
+		return user.CreateRefreshTokenWithoutValidation("token-id", data.UserID, familyID, refreshTokenHash, "", "", now, now.Add(ttl), nil, nil)
+	}
+
+	tests := []struct {
+		name         string
+		findToken    *user.RefreshToken
+		findUser     *user.User
+		rotate       bool
+		saveErr      error
+		checkPolicy  bool
+		policy       user.TwoFactorPolicy
+		revokeFamily bool
+		wantOutput   applicationuser.TokensOutput
+		wantErr      error
+	}{
+		{
+			name:        "rotates the token and issues a new access token",
+			findToken:   active(),
+			findUser:    owner,
+			rotate:      true,
+			checkPolicy: true,
+			wantOutput:  applicationuser.TokensOutput{AccessToken: "access-token", RefreshToken: nextToken},
+		},
+		{
+			name:       "user with a second factor is not checked against the policy",
+			findToken:  active(),
+			findUser:   ownerWithTwoFactor,
+			rotate:     true,
+			wantOutput: applicationuser.TokensOutput{AccessToken: "access-token", RefreshToken: nextToken},
+		},
+		{
+			name:         "user required to use a second factor without one has its family revoked",
+			findToken:    active(),
+			findUser:     owner,
+			rotate:       true,
+			checkPolicy:  true,
+			policy:       playersNeedTwoFactor,
+			revokeFamily: true,
+			wantErr:      user.ErrTwoFactorRequired,
+		},
+		{
+			name:    "unknown token",
+			wantErr: user.ErrInvalidRefreshToken,
+		},
+		{
+			name:      "expired token",
+			findToken: user.CreateRefreshTokenWithoutValidation("token-id", data.UserID, familyID, refreshTokenHash, "", "", now.Add(-2*ttl), now.Add(-ttl), nil, nil),
+			findUser:  owner,
+			wantErr:   user.ErrInvalidRefreshToken,
+		},
+		{
+			name:      "revoked token",
+			findToken: user.CreateRefreshTokenWithoutValidation("token-id", data.UserID, familyID, refreshTokenHash, "", "", now, now.Add(ttl), nil, &earlier),
+			findUser:  owner,
+			wantErr:   user.ErrInvalidRefreshToken,
+		},
+		{
+			name:         "reused token revokes the family",
+			findToken:    user.CreateRefreshTokenWithoutValidation("token-id", data.UserID, familyID, refreshTokenHash, "", "", now, now.Add(ttl), &earlier, nil),
+			findUser:     owner,
+			revokeFamily: true,
+			wantErr:      user.ErrRefreshTokenReused,
+		},
+		{
+			name:         "token rotated concurrently revokes the family",
+			findToken:    active(),
+			findUser:     owner,
+			rotate:       true,
+			saveErr:      user.ErrRefreshTokenReused,
+			revokeFamily: true,
+			wantErr:      user.ErrRefreshTokenReused,
+		},
+		{
+			name:      "save error",
+			findToken: active(),
+			findUser:  owner,
+			rotate:    true,
+			saveErr:   errors.New("mock_err"),
+			wantErr:   errors.New("mock_err"),
+		},
+		{
+			name:      "deleted user",
+			findToken: active(),
+			wantErr:   user.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepoMock := mocks.NewMockUserRepository(ctrl)
+			refreshTokenRepoMock := mocks.NewMockRefreshTokenRepository(ctrl)
+			jwtServiceMock := mocks.NewMockJWTService(ctrl)
+			idServiceMock := mocks.NewMockIdentificationService(ctrl)
+			tokenServiceMock := mocks.NewMockTokenService(ctrl)
+			policyRepoMock := mocks.NewMockTwoFactorPolicyRepository(ctrl)
+
+			tokenServiceMock.EXPECT().Hash(refreshToken).Return(refreshTokenHash)
+			refreshTokenRepoMock.EXPECT().FindByHash(ctx, refreshTokenHash).Return(tt.findToken, nil)
+
+			if tt.findToken != nil {
+				userRepoMock.EXPECT().FindByID(ctx, data.UserID).Return(tt.findUser, nil)
+			}
+
+			if tt.rotate {
+				idServiceMock.EXPECT().GenerateID().Return("next-token-id")
+				tokenServiceMock.EXPECT().Generate().Return(nextToken, nil)
+				tokenServiceMock.EXPECT().Hash(nextToken).Return("next-refresh-token-hash")
+				refreshTokenRepoMock.EXPECT().
+					SaveRotation(ctx, tt.findToken, gomock.Any()).
+					DoAndReturn(func(_ context.Context, rotated, next *user.RefreshToken) error {
+						assert.True(t, rotated.IsRotated())
+						assert.Equal(t, familyID, next.FamilyID())
+						assert.Equal(t, data.UserID, next.UserID())
+						assert.Equal(t, "next-refresh-token-hash", next.TokenHash())
+						return tt.saveErr
+					})
+			}
+
+			if tt.checkPolicy {
+				policyRepoMock.EXPECT().Get(ctx).Return(tt.policy, nil)
+			}
+
+			if tt.revokeFamily {
+				refreshTokenRepoMock.EXPECT().RevokeFamily(ctx, familyID, gomock.Any()).Return(nil)
+			}
+
+			if tt.wantErr == nil {
+				jwtServiceMock.EXPECT().GenerateSignedToken(tt.findUser).Return("access-token", nil)
+			}
+
+			uc := refreshtoken.New(userRepoMock, refreshTokenRepoMock, policyRepoMock, jwtServiceMock, idServiceMock, tokenServiceMock, ttl)
+
+			output, err := uc.Execute(ctx, applicationuser.RefreshTokenInput{RefreshToken: refreshToken})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantOutput, output)
+		})
+	}
+}
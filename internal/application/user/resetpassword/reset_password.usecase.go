@@ -0,0 +1,67 @@
+package resetpassword
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.ResetPasswordUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository         domainuser.Repository
+	refreshTokenRepository domainuser.RefreshTokenRepository
+	hashService            domainuser.HashService
+	tokenService           domainuser.TokenService
+	passwordPolicy         domainuser.PasswordPolicy
+	eventPublisher         event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	hashServ domainuser.HashService,
+	tokenServ domainuser.TokenService,
+	passwordPolicy domainuser.PasswordPolicy,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		hashService:            hashServ,
+		tokenService:           tokenServ,
+		passwordPolicy:         passwordPolicy,
+		eventPublisher:         eventPub,
+	}
+}
+
+// Execute sets a new password with a reset token, which can't be used again.
+// The token is consumed when the user is saved, two concurrent resets with it can't both succeed.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.ResetPasswordInput) error {
+	tokenHash := uc.tokenService.Hash(input.Token)
+
+	u, err := uc.userRepository.FindByPasswordResetTokenHash(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return domainuser.ErrInvalidPasswordResetToken
+	}
+
+	if err := u.ResetPassword(input.Token, input.NewPassword, uc.passwordPolicy, uc.hashService, uc.tokenService); err != nil {
+		return err
+	}
+
+	if err := uc.userRepository.SaveResetPassword(ctx, u, tokenHash); err != nil {
+		return err
+	}
+
+	if err := uc.refreshTokenRepository.RevokeUserTokens(ctx, u.ID(), time.Now()); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, u.UncommittedEvents())
+}
@@ -0,0 +1,138 @@
+package resetpassword_test
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/resetpassword"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	resetToken     = "reset-token"
+	resetTokenHash = "reset-token-hash"
+	newPassword    = "new-password"
+	hashedPassword = "hashed:new-password"
+)
+
+var policy = user.NewPasswordPolicy(8, false, false, false)
+
+func newUser(expiresAt time.Time) *user.User {
+	reset := user.CreatePasswordResetWithoutValidation(resetTokenHash, expiresAt)
+	return user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 1, reset, false, nil)
+}
+
+func TestResetPasswordUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	errTest := errors.New("mock_err")
+
+	tests := []struct {
+		name        string
+		newPassword string
+		findUser    *user.User
+		findErr     error
+		save        bool
+		saveErr     error
+		revokeErr   error
+		wantErr     error
+	}{
+		{
+			name:        "resets the password with a valid token",
+			newPassword: newPassword,
+			findUser:    newUser(time.Now().Add(time.Hour)),
+			save:        true,
+		},
+		{
+			name:        "unknown token",
+			newPassword: newPassword,
+			wantErr:     user.ErrInvalidPasswordResetToken,
+		},
+		{
+			name:        "expired token",
+			newPassword: newPassword,
+			findUser:    newUser(time.Now().Add(-time.Minute)),
+			wantErr:     user.ErrInvalidPasswordResetToken,
+		},
+		{
+			name:        "token consumed by a concurrent reset",
+			newPassword: newPassword,
+			findUser:    newUser(time.Now().Add(time.Hour)),
+			save:        true,
+			saveErr:     user.ErrInvalidPasswordResetToken,
+			wantErr:     user.ErrInvalidPasswordResetToken,
+		},
+		{
+			name:        "password breaking the policy keeps the token",
+			newPassword: "short",
+			findUser:    newUser(time.Now().Add(time.Hour)),
+			wantErr:     user.ErrPasswordTooShort,
+		},
+		{
+			name:        "error on user lookup",
+			newPassword: newPassword,
+			findErr:     errTest,
+			wantErr:     errTest,
+		},
+		{
+			name:        "error on token revocation",
+			newPassword: newPassword,
+			findUser:    newUser(time.Now().Add(time.Hour)),
+			save:        true,
+			revokeErr:   errTest,
+			wantErr:     errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepoMock := mocks.NewMockUserRepository(ctrl)
+			refreshTokenRepoMock := mocks.NewMockRefreshTokenRepository(ctrl)
+			hashServiceMock := mocks.NewMockHashService(ctrl)
+			tokenServiceMock := mocks.NewMockTokenService(ctrl)
+			publisherMock := mocks.NewMockPublisher(ctrl)
+
+			tokenServiceMock.EXPECT().Hash(resetToken).Return(resetTokenHash).AnyTimes()
+			hashServiceMock.EXPECT().Hash(newPassword).Return(hashedPassword, nil).AnyTimes()
+			userRepoMock.EXPECT().FindByPasswordResetTokenHash(ctx, resetTokenHash).Return(tt.findUser, tt.findErr)
+
+			if tt.save {
+				userRepoMock.EXPECT().SaveResetPassword(ctx, tt.findUser, resetTokenHash).Return(tt.saveErr)
+
+				if tt.saveErr == nil {
+					refreshTokenRepoMock.EXPECT().RevokeUserTokens(ctx, data.UserID, gomock.Any()).Return(tt.revokeErr)
+				}
+			}
+
+			if tt.wantErr == nil {
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, event.EventTypePasswordChanged, events[0].Type())
+					return nil
+				})
+			}
+
+			uc := resetpassword.New(userRepoMock, refreshTokenRepoMock, hashServiceMock, tokenServiceMock, policy, publisherMock)
+
+			err := uc.Execute(ctx, applicationuser.ResetPasswordInput{Token: resetToken, NewPassword: tt.newPassword})
+
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, hashedPassword, tt.findUser.HashedPassword())
+				assert.Nil(t, tt.findUser.PasswordReset())
+				assert.Equal(t, 2, tt.findUser.TokenVersion())
+			}
+		})
+	}
+}
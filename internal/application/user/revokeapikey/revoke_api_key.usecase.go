@@ -0,0 +1,42 @@
+package revokeapikey
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.RevokeAPIKeyUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	apiKeyRepository domainuser.APIKeyRepository
+	eventPublisher   event.Publisher
+}
+
+func New(apiKeyRepo domainuser.APIKeyRepository, eventPub event.Publisher) *UseCase {
+	return &UseCase{
+		apiKeyRepository: apiKeyRepo,
+		eventPublisher:   eventPub,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, apiKeyID string) error {
+	k, err := uc.apiKeyRepository.FindByID(ctx, apiKeyID)
+	if err != nil {
+		return err
+	}
+
+	if k == nil {
+		return applicationuser.ErrAPIKeyNotFound
+	}
+
+	k.Revoke(time.Now())
+
+	if err := uc.apiKeyRepository.Save(ctx, k); err != nil {
+		return err
+	}
+
+	return uc.eventPublisher.Publish(ctx, k.UncommittedEvents())
+}
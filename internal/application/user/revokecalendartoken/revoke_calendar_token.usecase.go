@@ -0,0 +1,34 @@
+package revokecalendartoken
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.RevokeCalendarTokenUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+}
+
+func New(userRepo domainuser.Repository) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, userID string) error {
+	u, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return applicationuser.ErrUserNotFound
+	}
+
+	u.RevokeCalendarToken()
+
+	return uc.userRepository.Save(ctx, u)
+}
@@ -0,0 +1,44 @@
+package rotatecalendartoken
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.RotateCalendarTokenUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository domainuser.Repository
+	tokenService   domainuser.TokenService
+}
+
+func New(userRepo domainuser.Repository, tokenServ domainuser.TokenService) *UseCase {
+	return &UseCase{
+		userRepository: userRepo,
+		tokenService:   tokenServ,
+	}
+}
+
+// Execute issues a new calendar token for the user, the previous feed URL stops working.
+func (uc *UseCase) Execute(ctx context.Context, userID string) (string, error) {
+	u, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if u == nil {
+		return "", applicationuser.ErrUserNotFound
+	}
+
+	token, err := u.RotateCalendarToken(uc.tokenService)
+	if err != nil {
+		return "", err
+	}
+
+	if err := uc.userRepository.Save(ctx, u); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,36 @@
+package updatetwofactorpolicy
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+)
+
+var _ applicationuser.UpdateTwoFactorPolicyUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	twoFactorPolicyRepository domainuser.TwoFactorPolicyRepository
+}
+
+func New(twoFactorPolicyRepo domainuser.TwoFactorPolicyRepository) *UseCase {
+	return &UseCase{
+		twoFactorPolicyRepository: twoFactorPolicyRepo,
+	}
+}
+
+// Execute replaces the roles requiring a second factor. Their users without one
+// enroll it on their next login: their sessions end on the next refresh, which is refused.
+func (uc *UseCase) Execute(ctx context.Context, requiredRoles []domainuser.UserRole) (applicationuser.TwoFactorPolicyOutput, error) {
+	policy, err := domainuser.NewTwoFactorPolicy(requiredRoles)
+	if err != nil {
+		return applicationuser.TwoFactorPolicyOutput{}, err
+	}
+
+	if err := uc.twoFactorPolicyRepository.Save(ctx, policy); err != nil {
+		return applicationuser.TwoFactorPolicyOutput{}, err
+	}
+
+	return applicationuser.TwoFactorPolicyOutput{
+		RequiredRoles: policy.RequiredRoles(),
+	}, nil
+}
@@ -0,0 +1,203 @@
+package verifylogin
+
+import (
+	"context"
+	"errors"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/shared"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+var _ applicationuser.VerifyLoginUseCase = (*UseCase)(nil)
+
+type UseCase struct {
+	userRepository           domainuser.Repository
+	refreshTokenRepository   domainuser.RefreshTokenRepository
+	loginChallengeRepository domainuser.LoginChallengeRepository
+	jwtService               domainuser.JWTService
+	identificationService    shared.IdentificationService
+	tokenService             domainuser.TokenService
+	totpService              domainuser.TOTPService
+	refreshTokenTTL          time.Duration
+	loginGuard               *domainuser.LoginGuard
+	eventPublisher           event.Publisher
+}
+
+func New(
+	userRepo domainuser.Repository,
+	refreshTokenRepo domainuser.RefreshTokenRepository,
+	loginChallengeRepo domainuser.LoginChallengeRepository,
+	jwtServ domainuser.JWTService,
+	idServ shared.IdentificationService,
+	tokenServ domainuser.TokenService,
+	totpServ domainuser.TOTPService,
+	refreshTokenTTL time.Duration,
+	loginGuard *domainuser.LoginGuard,
+	eventPub event.Publisher,
+) *UseCase {
+	return &UseCase{
+		userRepository:           userRepo,
+		refreshTokenRepository:   refreshTokenRepo,
+		loginChallengeRepository: loginChallengeRepo,
+		jwtService:               jwtServ,
+		identificationService:    idServ,
+		tokenService:             tokenServ,
+		totpService:              totpServ,
+		refreshTokenTTL:          refreshTokenTTL,
+		loginGuard:               loginGuard,
+		eventPublisher:           eventPub,
+	}
+}
+
+// Execute answers the challenge of a login with a code of the second factor, or confirms
+// its enrollment when the login enrolled it. Wrong codes count as failed logins.
+func (uc *UseCase) Execute(ctx context.Context, input applicationuser.VerifyLoginInput) (applicationuser.VerifyLoginOutput, error) {
+	now := time.Now()
+
+	challenge, err := uc.loginChallengeRepository.FindByHash(ctx, uc.tokenService.Hash(input.ChallengeToken))
+	if err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	if challenge == nil || challenge.IsExpired(now) {
+		return applicationuser.VerifyLoginOutput{}, domainuser.ErrInvalidLoginChallenge
+	}
+
+	user, err := uc.userRepository.FindByID(ctx, challenge.UserID())
+	if err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	if user == nil {
+		return applicationuser.VerifyLoginOutput{}, domainuser.ErrInvalidLoginChallenge
+	}
+
+	if err := uc.loginGuard.Check(ctx, user.Username(), input.IPAddress, now); err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	previous := snapshotTwoFactor(user)
+
+	var recoveryCodes []string
+	if user.HasTwoFactor() {
+		err = user.VerifyTwoFactor(input.Code, now, uc.totpService, uc.tokenService)
+	} else {
+		recoveryCodes, err = user.ConfirmTwoFactor(input.Code, now, uc.totpService, uc.tokenService)
+	}
+
+	if errors.Is(err, domainuser.ErrInvalidTwoFactorCode) {
+		return applicationuser.VerifyLoginOutput{}, uc.recordFailure(ctx, challenge, user, input, now)
+	}
+
+	if err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	// The user may have been disabled since the password was checked
+	if user.IsDisabled() {
+		return applicationuser.VerifyLoginOutput{}, domainuser.ErrUserDisabled
+	}
+
+	if err := uc.loginChallengeRepository.Delete(ctx, challenge); err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	// Keeps the last used step and the consumed recovery codes, unless a concurrent login
+	// accepted a code first, so that no code is accepted twice
+	if err := uc.userRepository.SaveTwoFactorUse(ctx, user, previous); err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	if err := uc.loginGuard.Reset(ctx, user.Username()); err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, user.UncommittedEvents()); err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	tokens, err := uc.issueTokens(ctx, user, input)
+	if err != nil {
+		return applicationuser.VerifyLoginOutput{}, err
+	}
+
+	return applicationuser.VerifyLoginOutput{
+		Tokens:        tokens,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// snapshotTwoFactor copies the second factor of the user as loaded, before a code changes it.
+// The user has one, a login challenge is only opened once it has a secret.
+func snapshotTwoFactor(user *domainuser.User) *domainuser.TwoFactor {
+	twoFactor := user.TwoFactor()
+	if twoFactor == nil {
+		return nil
+	}
+
+	return domainuser.CreateTwoFactorWithoutValidation(
+		twoFactor.Secret(),
+		twoFactor.IsEnabled(),
+		twoFactor.RecoveryCodeHashes(),
+		twoFactor.LastUsedStep(),
+	)
+}
+
+func (uc *UseCase) issueTokens(ctx context.Context, user *domainuser.User, input applicationuser.VerifyLoginInput) (applicationuser.TokensOutput, error) {
+	token, err := uc.jwtService.GenerateSignedToken(user)
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	refreshToken, secret, err := domainuser.NewRefreshToken(
+		user.ID(),
+		input.UserAgent,
+		input.IPAddress,
+		uc.refreshTokenTTL,
+		uc.identificationService,
+		uc.tokenService,
+	)
+	if err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	if err := uc.refreshTokenRepository.Save(ctx, refreshToken); err != nil {
+		return applicationuser.TokensOutput{}, err
+	}
+
+	return applicationuser.TokensOutput{
+		AccessToken:  token,
+		RefreshToken: secret,
+	}, nil
+}
+
+// recordFailure counts the wrong code against the challenge, which is dropped after a few,
+// and as a failed login of the username and the IP address. It returns the error to answer with.
+func (uc *UseCase) recordFailure(
+	ctx context.Context,
+	challenge *domainuser.LoginChallenge,
+	user *domainuser.User,
+	input applicationuser.VerifyLoginInput,
+	now time.Time,
+) error {
+	if challenge.RecordFailure() {
+		if err := uc.loginChallengeRepository.Save(ctx, challenge); err != nil {
+			return err
+		}
+	} else if err := uc.loginChallengeRepository.Delete(ctx, challenge); err != nil {
+		return err
+	}
+
+	events, err := uc.loginGuard.RecordFailure(ctx, user.Username(), input.IPAddress, now)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, events); err != nil {
+		return err
+	}
+
+	return domainuser.ErrInvalidTwoFactorCode
+}
@@ -0,0 +1,215 @@
+package verifylogin_test
+
+import (
+	"context"
+	applicationuser "meye-core/internal/application/user"
+	"meye-core/internal/application/user/verifylogin"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	challengeToken = "challenge-token"
+	challengeHash  = "challenge-token-hash"
+	totpSecret     = "totp-secret"
+	ipAddress      = "203.0.113.7"
+	usernameKey    = "username:" + data.Username
+	ipKey          = "ip:" + ipAddress
+	window         = 15 * time.Minute
+)
+
+type verifyMocks struct {
+	userRepo      *mocks.MockUserRepository
+	challengeRepo *mocks.MockLoginChallengeRepository
+	tokenService  *mocks.MockTokenService
+	totpService   *mocks.MockTOTPService
+	store         *mocks.MockLoginAttemptStore
+	publisher     *mocks.MockPublisher
+}
+
+func TestVerifyLoginUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	input := applicationuser.VerifyLoginInput{ChallengeToken: challengeToken, Code: "123456", IPAddress: ipAddress}
+	throttle := user.NewLoginThrottle(3, time.Second, 30*time.Second, 15*time.Minute, window)
+
+	challenge := func(failures int) *user.LoginChallenge {
+		return user.CreateLoginChallengeWithoutValidation("challenge-id", data.UserID, challengeHash, time.Now().Add(time.Minute), failures)
+	}
+	enrolled := func(enabled bool) *user.User {
+		twoFactor := user.CreateTwoFactorWithoutValidation(totpSecret, enabled, nil, 10)
+		return user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, twoFactor)
+	}
+
+	setup := func(t *testing.T) (*verifylogin.UseCase, verifyMocks) {
+		ctrl := gomock.NewController(t)
+
+		m := verifyMocks{
+			userRepo:      mocks.NewMockUserRepository(ctrl),
+			challengeRepo: mocks.NewMockLoginChallengeRepository(ctrl),
+			tokenService:  mocks.NewMockTokenService(ctrl),
+			totpService:   mocks.NewMockTOTPService(ctrl),
+			store:         mocks.NewMockLoginAttemptStore(ctrl),
+			publisher:     mocks.NewMockPublisher(ctrl),
+		}
+		refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+		jwtService := mocks.NewMockJWTService(ctrl)
+		idService := mocks.NewMockIdentificationService(ctrl)
+
+		jwtService.EXPECT().GenerateSignedToken(gomock.Any()).Return("access-token", nil).AnyTimes()
+		idService.EXPECT().GenerateID().Return("id").AnyTimes()
+		m.tokenService.EXPECT().Hash(challengeToken).Return(challengeHash).AnyTimes()
+		refreshRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil).AnyTimes()
+
+		uc := verifylogin.New(
+			m.userRepo,
+			refreshRepo,
+			m.challengeRepo,
+			jwtService,
+			idService,
+			m.tokenService,
+			m.totpService,
+			time.Hour,
+			user.NewLoginGuard(m.store, throttle, throttle),
+			m.publisher,
+		)
+
+		return uc, m
+	}
+
+	// challengeOf expects the challenge to be found along with its user, neither of them throttled
+	challengeOf := func(m verifyMocks, c *user.LoginChallenge, u *user.User) {
+		m.challengeRepo.EXPECT().FindByHash(ctx, challengeHash).Return(c, nil)
+		m.userRepo.EXPECT().FindByID(ctx, data.UserID).Return(u, nil)
+		m.store.EXPECT().Find(ctx, usernameKey).Return(user.LoginAttempts{}, nil)
+		m.store.EXPECT().Find(ctx, ipKey).Return(user.LoginAttempts{}, nil)
+	}
+
+	t.Run("valid code issues the tokens", func(t *testing.T) {
+		uc, m := setup(t)
+		c := challenge(0)
+		u := enrolled(true)
+
+		challengeOf(m, c, u)
+		m.totpService.EXPECT().Verify(totpSecret, "123456", gomock.Any()).Return(int64(11), true)
+		m.challengeRepo.EXPECT().Delete(ctx, c).Return(nil)
+		m.userRepo.EXPECT().SaveTwoFactorUse(ctx, u, user.CreateTwoFactorWithoutValidation(totpSecret, true, nil, 10)).Return(nil)
+		m.store.EXPECT().Reset(ctx, usernameKey).Return(nil)
+		m.publisher.EXPECT().Publish(ctx, gomock.Len(0)).Return(nil)
+		m.tokenService.EXPECT().Generate().Return("refresh-token", nil)
+		m.tokenService.EXPECT().Hash("refresh-token").Return("refresh-token-hash")
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, applicationuser.TokensOutput{AccessToken: "access-token", RefreshToken: "refresh-token"}, output.Tokens)
+		assert.Empty(t, output.RecoveryCodes)
+		assert.Equal(t, int64(11), u.TwoFactor().LastUsedStep())
+	})
+
+	t.Run("valid code confirms a pending enrollment", func(t *testing.T) {
+		uc, m := setup(t)
+		c := challenge(0)
+		u := enrolled(false)
+
+		challengeOf(m, c, u)
+		m.totpService.EXPECT().Verify(totpSecret, "123456", gomock.Any()).Return(int64(11), true)
+		m.totpService.EXPECT().GenerateRecoveryCode().Return("abcde-fghij", nil).Times(10)
+		m.tokenService.EXPECT().Hash("abcde-fghij").Return("recovery-hash").Times(10)
+		m.challengeRepo.EXPECT().Delete(ctx, c).Return(nil)
+		m.userRepo.EXPECT().SaveTwoFactorUse(ctx, u, user.CreateTwoFactorWithoutValidation(totpSecret, false, nil, 10)).Return(nil)
+		m.store.EXPECT().Reset(ctx, usernameKey).Return(nil)
+		m.publisher.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []event.DomainEvent) error {
+			if assert.Len(t, events, 1) {
+				assert.Equal(t, event.EventTypeTwoFactorEnabled, events[0].Type())
+			}
+			return nil
+		})
+		m.tokenService.EXPECT().Generate().Return("refresh-token", nil)
+		m.tokenService.EXPECT().Hash("refresh-token").Return("refresh-token-hash")
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Len(t, output.RecoveryCodes, 10)
+		assert.True(t, u.HasTwoFactor())
+	})
+
+	t.Run("code accepted by a concurrent login is refused", func(t *testing.T) {
+		uc, m := setup(t)
+		c := challenge(0)
+		u := enrolled(true)
+
+		challengeOf(m, c, u)
+		m.totpService.EXPECT().Verify(totpSecret, "123456", gomock.Any()).Return(int64(11), true)
+		m.challengeRepo.EXPECT().Delete(ctx, c).Return(nil)
+		m.userRepo.EXPECT().SaveTwoFactorUse(ctx, u, gomock.Any()).Return(user.ErrInvalidTwoFactorCode)
+
+		output, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, user.ErrInvalidTwoFactorCode, err)
+		assert.Empty(t, output.Tokens)
+	})
+
+	t.Run("unknown challenge", func(t *testing.T) {
+		uc, m := setup(t)
+
+		m.challengeRepo.EXPECT().FindByHash(ctx, challengeHash).Return(nil, nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, user.ErrInvalidLoginChallenge, err)
+	})
+
+	t.Run("expired challenge", func(t *testing.T) {
+		uc, m := setup(t)
+		expired := user.CreateLoginChallengeWithoutValidation("challenge-id", data.UserID, challengeHash, time.Now().Add(-time.Second), 0)
+
+		m.challengeRepo.EXPECT().FindByHash(ctx, challengeHash).Return(expired, nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, user.ErrInvalidLoginChallenge, err)
+	})
+
+	t.Run("wrong code counts as a failed login", func(t *testing.T) {
+		uc, m := setup(t)
+		c := challenge(0)
+
+		challengeOf(m, c, enrolled(true))
+		m.totpService.EXPECT().Verify(totpSecret, "123456", gomock.Any()).Return(int64(0), false)
+		m.tokenService.EXPECT().Hash("123456").Return("code-hash")
+		m.challengeRepo.EXPECT().Save(ctx, c).Return(nil)
+		m.store.EXPECT().RecordFailure(ctx, usernameKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.store.EXPECT().RecordFailure(ctx, ipKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.publisher.EXPECT().Publish(ctx, gomock.Len(1)).Return(nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, user.ErrInvalidTwoFactorCode, err)
+		assert.Equal(t, 1, c.Failures())
+	})
+
+	t.Run("too many wrong codes drop the challenge", func(t *testing.T) {
+		uc, m := setup(t)
+		c := challenge(4)
+
+		challengeOf(m, c, enrolled(true))
+		m.totpService.EXPECT().Verify(totpSecret, "123456", gomock.Any()).Return(int64(0), false)
+		m.tokenService.EXPECT().Hash("123456").Return("code-hash")
+		m.challengeRepo.EXPECT().Delete(ctx, c).Return(nil)
+		m.store.EXPECT().RecordFailure(ctx, usernameKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.store.EXPECT().RecordFailure(ctx, ipKey, gomock.Any(), window).Return(user.LoginAttempts{Failures: 1}, nil)
+		m.publisher.EXPECT().Publish(ctx, gomock.Len(1)).Return(nil)
+
+		_, err := uc.Execute(ctx, input)
+
+		assert.Equal(t, user.ErrInvalidTwoFactorCode, err)
+	})
+}
@@ -1,16 +1,19 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
+	"net"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
 
 type Api struct {
 	Port           string
-	ApiKey         string
 	AllowedOrigins []string
+	TrustedProxies []string // IPs or CIDRs whose forwarded headers give the client IP, none when empty
 }
 
 type Database struct {
@@ -18,9 +21,51 @@ type Database struct {
 }
 
 type JWT struct {
-	Secret         string
-	Issuer         string
-	ExpirationTime time.Duration
+	SigningMethod         string        // HS256, RS256 or EdDSA
+	Secret                string        // only used by HS256
+	KeysDir               string        // directory with the private keys of RS256 and EdDSA, one <kid>.pem file each
+	KeyRotationInterval   time.Duration // zero disables the automatic rotation of the signing key
+	Issuer                string
+	ExpirationTime        time.Duration
+	RefreshExpirationTime time.Duration
+}
+
+type Auth struct {
+	// CacheTTL is how long user auth states and memberships are cached by an instance. Changes made
+	// through another instance (revocations, role changes, disabled accounts) are only seen once it expires.
+	CacheTTL time.Duration
+}
+
+type Passwords struct {
+	MinLength        int
+	RequireMixedCase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	ResetTokenTTL    time.Duration // how long the one-time token issued by an admin can be used
+}
+
+type PasswordHashing struct {
+	Algorithm         string // argon2id or bcrypt, hashes of the other one still verify and are replaced on login
+	BcryptCost        int
+	Argon2Memory      uint32 // KiB
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+type LoginThrottle struct {
+	Store                  string // memory or postgres, postgres sharing the counters between instances
+	MaxFailuresPerUsername int
+	MaxFailuresPerIP       int
+	BaseDelay              time.Duration // wait after the first failure, doubled by each next one
+	MaxDelay               time.Duration
+	LockoutDuration        time.Duration
+	FailureWindow          time.Duration // failures older than this are forgotten
+}
+
+type TwoFactor struct {
+	Issuer       string        // name of the application shown by the authenticator apps
+	ChallengeTTL time.Duration // how long a login can wait for the code of the second factor
+	SecretKey    []byte        // AES-256 key encrypting the TOTP secrets in the database
 }
 
 type RabbitMQ struct {
@@ -28,11 +73,38 @@ type RabbitMQ struct {
 	EventsQueue string
 }
 
+type Supernatural struct {
+	SkillUnlockXPCost          uint
+	TransformationUnlockXPCost uint
+	MaxSkillsPerPJ             uint
+}
+
+type Rulesets struct {
+	Dir string // directory with the YAML ruleset definitions
+}
+
+type Scheduler struct {
+	Interval time.Duration // how often the worker starts the planned sessions that are due
+}
+
+type Recaps struct {
+	TemplatesDir string // optional directory with templates overriding the built-in ones
+}
+
 type Config struct {
-	Api      Api
-	Database Database
-	JWT      JWT
-	RabbitMQ RabbitMQ
+	Api             Api
+	Database        Database
+	JWT             JWT
+	Auth            Auth
+	Passwords       Passwords
+	PasswordHashing PasswordHashing
+	LoginThrottle   LoginThrottle
+	TwoFactor       TwoFactor
+	RabbitMQ        RabbitMQ
+	Supernatural    Supernatural
+	Rulesets        Rulesets
+	Scheduler       Scheduler
+	Recaps          Recaps
 }
 
 func getInvalidVarErr(varName string) error {
@@ -45,11 +117,6 @@ func (cfg *Config) loadApi() error {
 		return getInvalidVarErr("API_PORT")
 	}
 
-	cfg.Api.ApiKey = os.Getenv("API_KEY")
-	if cfg.Api.ApiKey == "" {
-		return getInvalidVarErr("API_KEY")
-	}
-
 	allowedOrigins := os.Getenv("ALLOWED_ORIGINS")
 	if allowedOrigins == "" {
 		return getInvalidVarErr("ALLOWED_ORIGINS")
@@ -57,6 +124,17 @@ func (cfg *Config) loadApi() error {
 
 	cfg.Api.AllowedOrigins = strings.Split(allowedOrigins, ",")
 
+	if trustedProxies := os.Getenv("TRUSTED_PROXIES"); trustedProxies != "" {
+		for _, proxy := range strings.Split(trustedProxies, ",") {
+			proxy = strings.TrimSpace(proxy)
+			if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+				return getInvalidVarErr("TRUSTED_PROXIES")
+			}
+
+			cfg.Api.TrustedProxies = append(cfg.Api.TrustedProxies, proxy)
+		}
+	}
+
 	return nil
 }
 
@@ -70,9 +148,32 @@ func (cfg *Config) loadDB() error {
 }
 
 func (cfg *Config) loadJWT() error {
-	cfg.JWT.Secret = os.Getenv("JWT_SECRET")
-	if cfg.JWT.Secret == "" {
-		return getInvalidVarErr("JWT_SECRET")
+	cfg.JWT.SigningMethod = os.Getenv("JWT_SIGNING_METHOD")
+	if cfg.JWT.SigningMethod == "" {
+		cfg.JWT.SigningMethod = "HS256"
+	}
+
+	switch cfg.JWT.SigningMethod {
+	case "HS256":
+		cfg.JWT.Secret = os.Getenv("JWT_SECRET")
+		if cfg.JWT.Secret == "" {
+			return getInvalidVarErr("JWT_SECRET")
+		}
+	case "RS256", "EdDSA":
+		cfg.JWT.KeysDir = os.Getenv("JWT_KEYS_DIR")
+		if cfg.JWT.KeysDir == "" {
+			return getInvalidVarErr("JWT_KEYS_DIR")
+		}
+
+		if rotation := os.Getenv("JWT_KEY_ROTATION_INTERVAL"); rotation != "" {
+			interval, err := time.ParseDuration(rotation)
+			if err != nil || interval < 0 {
+				return getInvalidVarErr("JWT_KEY_ROTATION_INTERVAL")
+			}
+			cfg.JWT.KeyRotationInterval = interval
+		}
+	default:
+		return getInvalidVarErr("JWT_SIGNING_METHOD")
 	}
 
 	cfg.JWT.Issuer = os.Getenv("JWT_ISSUER")
@@ -86,6 +187,199 @@ func (cfg *Config) loadJWT() error {
 		return getInvalidVarErr("JWT_EXPIRATION_TIME")
 	}
 	cfg.JWT.ExpirationTime = jwtExpTime
+
+	cfg.JWT.RefreshExpirationTime, err = getOptionalDurationVar("JWT_REFRESH_EXPIRATION_TIME", 30*24*time.Hour)
+
+	return err
+}
+
+func (cfg *Config) loadAuth() error {
+	cfg.Auth.CacheTTL = 5 * time.Second
+
+	if ttl := os.Getenv("AUTH_CACHE_TTL"); ttl != "" {
+		cacheTTL, err := time.ParseDuration(ttl)
+		if err != nil || cacheTTL <= 0 {
+			return getInvalidVarErr("AUTH_CACHE_TTL")
+		}
+		cfg.Auth.CacheTTL = cacheTTL
+	}
+
+	return nil
+}
+
+func getOptionalBoolVar(varName string) (bool, error) {
+	value := os.Getenv(varName)
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, getInvalidVarErr(varName)
+	}
+
+	return b, nil
+}
+
+func (cfg *Config) loadPasswords() error {
+	var err error
+
+	cfg.Passwords.MinLength = 8
+	if minLength := os.Getenv("PASSWORD_MIN_LENGTH"); minLength != "" {
+		value, err := strconv.Atoi(minLength)
+		if err != nil || value < 1 || value > 72 {
+			return getInvalidVarErr("PASSWORD_MIN_LENGTH")
+		}
+		cfg.Passwords.MinLength = value
+	}
+
+	if cfg.Passwords.RequireMixedCase, err = getOptionalBoolVar("PASSWORD_REQUIRE_MIXED_CASE"); err != nil {
+		return err
+	}
+
+	if cfg.Passwords.RequireDigit, err = getOptionalBoolVar("PASSWORD_REQUIRE_DIGIT"); err != nil {
+		return err
+	}
+
+	if cfg.Passwords.RequireSymbol, err = getOptionalBoolVar("PASSWORD_REQUIRE_SYMBOL"); err != nil {
+		return err
+	}
+
+	cfg.Passwords.ResetTokenTTL = 24 * time.Hour
+	if ttl := os.Getenv("PASSWORD_RESET_TOKEN_TTL"); ttl != "" {
+		resetTTL, err := time.ParseDuration(ttl)
+		if err != nil || resetTTL <= 0 {
+			return getInvalidVarErr("PASSWORD_RESET_TOKEN_TTL")
+		}
+		cfg.Passwords.ResetTokenTTL = resetTTL
+	}
+
+	return nil
+}
+
+func (cfg *Config) loadPasswordHashing() error {
+	cfg.PasswordHashing.Algorithm = os.Getenv("PASSWORD_HASH_ALGORITHM")
+	switch cfg.PasswordHashing.Algorithm {
+	case "":
+		cfg.PasswordHashing.Algorithm = "argon2id"
+	case "argon2id", "bcrypt":
+	default:
+		return getInvalidVarErr("PASSWORD_HASH_ALGORITHM")
+	}
+
+	bcryptCost, err := getOptionalPositiveIntVar("PASSWORD_BCRYPT_COST", 12)
+	if err != nil || bcryptCost < 10 || bcryptCost > 31 {
+		return getInvalidVarErr("PASSWORD_BCRYPT_COST")
+	}
+	cfg.PasswordHashing.BcryptCost = bcryptCost
+
+	// Defaults follow the OWASP recommendation for argon2id
+	memory, err := getOptionalPositiveIntVar("PASSWORD_ARGON2_MEMORY", 19456)
+	if err != nil || memory < 8192 || memory > 4194304 {
+		return getInvalidVarErr("PASSWORD_ARGON2_MEMORY")
+	}
+	cfg.PasswordHashing.Argon2Memory = uint32(memory)
+
+	iterations, err := getOptionalPositiveIntVar("PASSWORD_ARGON2_ITERATIONS", 2)
+	if err != nil || iterations > 100 {
+		return getInvalidVarErr("PASSWORD_ARGON2_ITERATIONS")
+	}
+	cfg.PasswordHashing.Argon2Iterations = uint32(iterations)
+
+	parallelism, err := getOptionalPositiveIntVar("PASSWORD_ARGON2_PARALLELISM", 1)
+	if err != nil || parallelism > 255 {
+		return getInvalidVarErr("PASSWORD_ARGON2_PARALLELISM")
+	}
+	cfg.PasswordHashing.Argon2Parallelism = uint8(parallelism)
+
+	return nil
+}
+
+func getOptionalPositiveIntVar(varName string, defaultValue int) (int, error) {
+	value := os.Getenv(varName)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil || i <= 0 {
+		return 0, getInvalidVarErr(varName)
+	}
+
+	return i, nil
+}
+
+func getOptionalDurationVar(varName string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(varName)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, getInvalidVarErr(varName)
+	}
+
+	return d, nil
+}
+
+func (cfg *Config) loadLoginThrottle() error {
+	var err error
+
+	cfg.LoginThrottle.Store = os.Getenv("LOGIN_ATTEMPT_STORE")
+	switch cfg.LoginThrottle.Store {
+	case "":
+		cfg.LoginThrottle.Store = "postgres"
+	case "memory", "postgres":
+	default:
+		return getInvalidVarErr("LOGIN_ATTEMPT_STORE")
+	}
+
+	if cfg.LoginThrottle.MaxFailuresPerUsername, err = getOptionalPositiveIntVar("LOGIN_MAX_FAILURES_PER_USERNAME", 5); err != nil {
+		return err
+	}
+
+	if cfg.LoginThrottle.MaxFailuresPerIP, err = getOptionalPositiveIntVar("LOGIN_MAX_FAILURES_PER_IP", 50); err != nil {
+		return err
+	}
+
+	if cfg.LoginThrottle.BaseDelay, err = getOptionalDurationVar("LOGIN_BASE_DELAY", time.Second); err != nil {
+		return err
+	}
+
+	if cfg.LoginThrottle.MaxDelay, err = getOptionalDurationVar("LOGIN_MAX_DELAY", 30*time.Second); err != nil {
+		return err
+	}
+
+	if cfg.LoginThrottle.LockoutDuration, err = getOptionalDurationVar("LOGIN_LOCKOUT_DURATION", 15*time.Minute); err != nil {
+		return err
+	}
+
+	if cfg.LoginThrottle.FailureWindow, err = getOptionalDurationVar("LOGIN_FAILURE_WINDOW", 15*time.Minute); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cfg *Config) loadTwoFactor() error {
+	cfg.TwoFactor.Issuer = os.Getenv("TWO_FACTOR_ISSUER")
+	if cfg.TwoFactor.Issuer == "" {
+		cfg.TwoFactor.Issuer = "Meye"
+	}
+
+	challengeTTL, err := getOptionalDurationVar("LOGIN_CHALLENGE_TTL", 5*time.Minute)
+	if err != nil {
+		return err
+	}
+	cfg.TwoFactor.ChallengeTTL = challengeTTL
+
+	secretKey, err := base64.StdEncoding.DecodeString(os.Getenv("TWO_FACTOR_SECRET_KEY"))
+	if err != nil || len(secretKey) != 32 {
+		return getInvalidVarErr("TWO_FACTOR_SECRET_KEY")
+	}
+	cfg.TwoFactor.SecretKey = secretKey
+
 	return nil
 }
 
@@ -103,6 +397,57 @@ func (cfg *Config) loadRabbitMQ() error {
 	return nil
 }
 
+func getUintVar(varName string) (uint, error) {
+	value, err := strconv.ParseUint(os.Getenv(varName), 10, 32)
+	if err != nil {
+		return 0, getInvalidVarErr(varName)
+	}
+
+	return uint(value), nil
+}
+
+func (cfg *Config) loadSupernatural() error {
+	var err error
+
+	cfg.Supernatural.SkillUnlockXPCost, err = getUintVar("SKILL_UNLOCK_XP_COST")
+	if err != nil {
+		return err
+	}
+
+	cfg.Supernatural.TransformationUnlockXPCost, err = getUintVar("TRANSFORMATION_UNLOCK_XP_COST")
+	if err != nil {
+		return err
+	}
+
+	cfg.Supernatural.MaxSkillsPerPJ, err = getUintVar("MAX_SKILLS_PER_PJ")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cfg *Config) loadRulesets() error {
+	cfg.Rulesets.Dir = os.Getenv("RULESETS_DIR")
+	if cfg.Rulesets.Dir == "" {
+		return getInvalidVarErr("RULESETS_DIR")
+	}
+
+	return nil
+}
+
+func (cfg *Config) loadScheduler() error {
+	var err error
+
+	cfg.Scheduler.Interval, err = getOptionalDurationVar("SESSION_SCHEDULER_INTERVAL", time.Minute)
+
+	return err
+}
+
+func (cfg *Config) loadRecaps() {
+	cfg.Recaps.TemplatesDir = os.Getenv("RECAP_TEMPLATES_DIR")
+}
+
 // New loads configuration from environment and returns the structure.
 func New() (*Config, error) {
 	cfg := &Config{}
@@ -119,9 +464,43 @@ func New() (*Config, error) {
 		return nil, err
 	}
 
+	if err := cfg.loadAuth(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadPasswords(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadPasswordHashing(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadLoginThrottle(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadTwoFactor(); err != nil {
+		return nil, err
+	}
+
 	if err := cfg.loadRabbitMQ(); err != nil {
 		return nil, err
 	}
 
+	if err := cfg.loadSupernatural(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadRulesets(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadScheduler(); err != nil {
+		return nil, err
+	}
+
+	cfg.loadRecaps()
+
 	return cfg, nil
 }
@@ -1,20 +1,15 @@
 package campaign
 
-const (
-	levelStepBasic = 10
-	costLife       = 5
-)
-
-func (bs *BasicStats) GetRequiredXP() uint {
+func (bs *BasicStats) GetRequiredXP(ruleset Ruleset) uint {
 	physicalGroup := bs.physical.getGroup()
 	mentalGroup := bs.mental.getGroup()
 	coordGroup := bs.coordination.getGroup()
 
-	physicalXP := getGroupRequiredXP(physicalGroup, levelStepBasic, getFirstLevelCost(bs.physical.isTalented))
-	mentalXP := getGroupRequiredXP(mentalGroup, levelStepBasic, getFirstLevelCost(bs.mental.isTalented))
-	coordXP := getGroupRequiredXP(coordGroup, levelStepBasic, getFirstLevelCost(bs.coordination.isTalented))
+	physicalXP := getGroupRequiredXP(physicalGroup, ruleset.basicLevelStep, ruleset.getBasicFirstLevelCost(bs.physical.isTalented))
+	mentalXP := getGroupRequiredXP(mentalGroup, ruleset.basicLevelStep, ruleset.getBasicFirstLevelCost(bs.mental.isTalented))
+	coordXP := getGroupRequiredXP(coordGroup, ruleset.basicLevelStep, ruleset.getBasicFirstLevelCost(bs.coordination.isTalented))
 
-	lifeXP := costLife * bs.life
+	lifeXP := ruleset.lifeCost * bs.life
 
 	return physicalXP + mentalXP + coordXP + lifeXP
 }
@@ -31,11 +26,11 @@ func (c Coordination) getGroup() []uint {
 	return []uint{c.precision, c.calculation, c.coordRange, c.reflexes}
 }
 
-func getFirstLevelCost(isTalented bool) uint {
+func (r Ruleset) getBasicFirstLevelCost(isTalented bool) uint {
 	if isTalented {
-		return 1
+		return r.basicTalentedFirstLevelCost
 	}
-	return 3
+	return r.basicFirstLevelCost
 }
 
 func (bs BasicStats) isHigherThan(bsB BasicStats) bool {
@@ -12,7 +12,7 @@ func TestBasicStats_GetRequiredXP(t *testing.T) {
 	tests := []struct {
 		name       string
 		basicStats campaign.BasicStats
-		want       int
+		want       uint
 	}{
 		{
 			name:       "Works correctly with physical talent",
@@ -38,7 +38,7 @@ func TestBasicStats_GetRequiredXP(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.basicStats.GetRequiredXP()
+			got := test.basicStats.GetRequiredXP(campaign.DefaultRuleset())
 
 			assert.Equal(t, test.want, got)
 		})
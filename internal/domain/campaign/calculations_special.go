@@ -1,21 +1,15 @@
 package campaign
 
-const (
-	levelStepSpecial   = 100
-	energyDefaultCost  = 10
-	energyTalentedCost = energyDefaultCost / 2
-)
-
-func (ss *SpecialStats) GetRequiredXP() uint {
+func (ss *SpecialStats) GetRequiredXP(ruleset Ruleset) uint {
 	physicalGroup := ss.physical.getGroup()
 	energyGroup := ss.energy.getGroup()
 	mentalGroup := ss.mental.getGroup()
 
-	physicalXP := getGroupRequiredXP(physicalGroup, levelStepSpecial, getSpecialFirstLevelCost(ss.physical.isTalented))
-	energyXP := getGroupRequiredXP(energyGroup, levelStepSpecial, getSpecialFirstLevelCost(ss.energy.isTalented))
-	mentalXP := getGroupRequiredXP(mentalGroup, levelStepSpecial, getSpecialFirstLevelCost(ss.mental.isTalented))
+	physicalXP := getGroupRequiredXP(physicalGroup, ruleset.specialLevelStep, ruleset.getSpecialFirstLevelCost(ss.physical.isTalented))
+	energyXP := getGroupRequiredXP(energyGroup, ruleset.specialLevelStep, ruleset.getSpecialFirstLevelCost(ss.energy.isTalented))
+	mentalXP := getGroupRequiredXP(mentalGroup, ruleset.specialLevelStep, ruleset.getSpecialFirstLevelCost(ss.mental.isTalented))
 
-	energyCost := getEnergyTankCost(ss.isEnergyTalented)
+	energyCost := ruleset.getEnergyTankCost(ss.isEnergyTalented)
 
 	energyTankXP := energyCost * ss.energyTank
 
@@ -34,19 +28,19 @@ func (es EnergySkills) getGroup() []uint {
 	return []uint{es.energyHandling + es.objectHandling}
 }
 
-func getSpecialFirstLevelCost(isTalented bool) uint {
+func (r Ruleset) getSpecialFirstLevelCost(isTalented bool) uint {
 	if isTalented {
-		return 1
+		return r.specialTalentedFirstLevelCost
 	}
-	return 2
+	return r.specialFirstLevelCost
 }
 
-func getEnergyTankCost(isEnergyTalented bool) uint {
+func (r Ruleset) getEnergyTankCost(isEnergyTalented bool) uint {
 	if isEnergyTalented {
-		return energyTalentedCost
+		return r.energyTankTalentedCost
 	}
 
-	return energyDefaultCost
+	return r.energyTankCost
 }
 
 func (ss SpecialStats) getOrderedStats() []uint {
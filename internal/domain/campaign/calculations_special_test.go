@@ -12,7 +12,7 @@ func TestSpecialStats_GetRequiredXP(t *testing.T) {
 	tests := []struct {
 		name         string
 		specialStats campaign.SpecialStats
-		want         int
+		want         uint
 	}{
 		{
 			name:         "Works correctly with physical skills talented",
@@ -38,7 +38,7 @@ func TestSpecialStats_GetRequiredXP(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.specialStats.GetRequiredXP()
+			got := test.specialStats.GetRequiredXP(campaign.DefaultRuleset())
 
 			assert.Equal(t, test.want, got)
 		})
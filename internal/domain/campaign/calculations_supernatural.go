@@ -1,43 +1,58 @@
 package campaign
 
-const (
-	levelStepSupernatural = 100
-)
-
-func (sStats *SupernaturalStats) GetRequiredXP() uint {
+func (sStats *SupernaturalStats) GetRequiredXP(ruleset Ruleset) uint {
 	var requiredXP uint
 
 	for i := range sStats.skills {
 		g := sStats.skills[i].getGroup()
-		requiredXP += getGroupRequiredXP(g, levelStepSupernatural, 1)
+		requiredXP += getGroupRequiredXP(g, ruleset.supernaturalLevelStep, ruleset.supernaturalFirstLevelCost)
+		requiredXP += sStats.skills[i].getUnlockCost()
 	}
 
 	return requiredXP
 }
 
-func (skill Skill) getGroup() []uint {
-	var sum uint
+// getUnlockCost returns the XP paid to learn the skill and unlock its transformations.
+func (skill Skill) getUnlockCost() uint {
+	cost := skill.unlockCost
 
 	for i := range skill.transformations {
-		sum += skill.transformations[i]
+		cost += skill.transformations[i].unlockCost
 	}
 
-	return []uint{sum}
+	return cost
 }
 
-func (sStats *SupernaturalStats) getOrderedStats() []uint {
-	groups := make([][]uint, len(sStats.skills))
+func (skill Skill) getGroup() []uint {
+	var sum uint
 
-	for i, skill := range sStats.skills {
-		groups[i] = skill.transformations
+	for i := range skill.transformations {
+		sum += skill.transformations[i].level
 	}
 
-	return concatenateGroups(groups)
+	return []uint{sum}
 }
 
+// isHigherThan reports whether any transformation level in ss is higher than the
+// level of the transformation with the same ID in ssB.
 func (ss *SupernaturalStats) isHigherThan(ssB *SupernaturalStats) bool {
-	stats := ss.getOrderedStats()
-	statsB := ssB.getOrderedStats()
+	for _, skill := range ss.skills {
+		skillB, ok := ssB.FindSkillByID(skill.id)
+		if !ok {
+			continue
+		}
+
+		for _, transformation := range skill.transformations {
+			i := skillB.findTransformationIndex(transformation.id)
+			if i < 0 {
+				continue
+			}
+
+			if transformation.level > skillB.transformations[i].level {
+				return true
+			}
+		}
+	}
 
-	return isHigherThan(stats, statsB)
+	return false
 }
@@ -4,6 +4,7 @@ import (
 	"meye-core/internal/domain/campaign"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,13 +12,13 @@ func TestSupernaturalStats_GetRequiredXP(t *testing.T) {
 	tests := []struct {
 		name              string
 		supernaturalStats *campaign.SupernaturalStats
-		want              int
+		want              uint
 	}{
 		{
 			name: "Works correcly for single-skill/single-transformation",
 			supernaturalStats: campaign.CreateSupernaturalStatsWithoutValidation(
 				[]campaign.Skill{
-					campaign.CreateSkillWithoutValidation([]uint{210}),
+					newTestSkill(210),
 				},
 			),
 			want: 330,
@@ -26,7 +27,7 @@ func TestSupernaturalStats_GetRequiredXP(t *testing.T) {
 			name: "Works correcly for single-skill/several-transformations",
 			supernaturalStats: campaign.CreateSupernaturalStatsWithoutValidation(
 				[]campaign.Skill{
-					campaign.CreateSkillWithoutValidation([]uint{210, 110}),
+					newTestSkill(210, 110),
 				},
 			),
 			want: 680,
@@ -35,8 +36,8 @@ func TestSupernaturalStats_GetRequiredXP(t *testing.T) {
 			name: "Works correcly for several-skills/several-transformations",
 			supernaturalStats: campaign.CreateSupernaturalStatsWithoutValidation(
 				[]campaign.Skill{
-					campaign.CreateSkillWithoutValidation([]uint{220, 230}),
-					campaign.CreateSkillWithoutValidation([]uint{110, 330}),
+					newTestSkill(220, 230),
+					newTestSkill(110, 330),
 				},
 			),
 			want: 2450,
@@ -45,9 +46,18 @@ func TestSupernaturalStats_GetRequiredXP(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.supernaturalStats.GetRequiredXP()
+			got := test.supernaturalStats.GetRequiredXP(campaign.DefaultRuleset())
 
 			assert.Equal(t, test.want, got)
 		})
 	}
 }
+
+func newTestSkill(levels ...uint) campaign.Skill {
+	transformations := make([]campaign.Transformation, len(levels))
+	for i, level := range levels {
+		transformations[i] = campaign.CreateTransformationWithoutValidation(uuid.NewString(), "", "", level, 0)
+	}
+
+	return campaign.CreateSkillWithoutValidation(uuid.NewString(), "", "", 0, transformations)
+}
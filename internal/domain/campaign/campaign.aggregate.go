@@ -10,19 +10,24 @@ type Campaign struct {
 	id                string
 	masterID          string
 	name              string
+	startingXP        XP // XP budget every new PJ starts with
+	houseRules        HouseRules
+	ruleset           Ruleset
 	invitations       []*Invitation
 	pjs               []*PJ
 	sessions          []*session.Session
 	uncommittedEvents []event.DomainEvent
 }
 
-func NewCampaign(masterID, name string, identificationService shared.IdentificationService) *Campaign {
+func NewCampaign(masterID, name string, startingXP XP, ruleset Ruleset, identificationService shared.IdentificationService) *Campaign {
 	id := identificationService.GenerateID()
 
 	c := &Campaign{
-		id:       id,
-		masterID: masterID,
-		name:     name,
+		id:         id,
+		masterID:   masterID,
+		name:       name,
+		startingXP: startingXP,
+		ruleset:    ruleset,
 	}
 
 	c.uncommittedEvents = append(c.uncommittedEvents, newCampaignCreatedEvent(c))
@@ -65,6 +70,21 @@ type PJCreateParameters struct {
 	IsMentalSkillsTalented   bool
 	IsEnergySkillsTalented   bool
 	IsEnergyTalented         bool
+	InitialStats             *PjInitialStatsParameters // optional, stats bought with the campaign starting XP
+}
+
+type InitialSkillParameters struct {
+	Name                      string
+	Description               string
+	TransformationName        string
+	TransformationDescription string
+	TransformationLevel       uint
+}
+
+type PjInitialStatsParameters struct {
+	BasicStats   BasicStatsParameters
+	SpecialStats SpecialStatsParameters
+	Skill        *InitialSkillParameters // only for supernatural PJs
 }
 
 func (c *Campaign) AddPJ(userID string, params PJCreateParameters, identificationService shared.IdentificationService) (*PJ, error) {
@@ -73,19 +93,26 @@ func (c *Campaign) AddPJ(userID string, params PJCreateParameters, identificatio
 		return nil, ErrUserNotInvited
 	}
 
+	if params.PjType != PJTypeSupernatural && params.InitialStats != nil && params.InitialStats.Skill != nil {
+		return nil, ErrCannotUpdateSupernaturalStats
+	}
+
 	var supernaturalStats *SupernaturalStats
 	if params.PjType == PJTypeSupernatural {
 		supernaturalStats = &SupernaturalStats{
 			skills: []Skill{
 				{
-					transformations: []uint{0},
+					id: identificationService.GenerateID(),
+					transformations: []Transformation{
+						{
+							id: identificationService.GenerateID(),
+						},
+					},
 				},
 			},
 		}
 	}
 
-	inv.accept()
-
 	pj := &PJ{
 		id:                identificationService.GenerateID(),
 		campaignID:        c.id,
@@ -100,6 +127,7 @@ func (c *Campaign) AddPJ(userID string, params PJCreateParameters, identificatio
 		heroism:           params.Heroism,
 		pjType:            params.PjType,
 		supernaturalStats: supernaturalStats,
+		ruleset:           c.ruleset,
 	}
 
 	pj.basicStats.physical.isTalented = params.IsPhysicalTalented
@@ -112,10 +140,23 @@ func (c *Campaign) AddPJ(userID string, params PJCreateParameters, identificatio
 
 	pj.specialStats.isEnergyTalented = params.IsEnergyTalented
 
-	pj.xp = CreateXPWithoutValidation(0, 0, 0)
+	if err := c.houseRules.validateTalents(pj.talents()); err != nil {
+		return nil, err
+	}
+
+	pj.xp = c.startingXP
+	pj.LoadRequiredXp()
+
+	if params.InitialStats != nil {
+		if err := pj.buyInitialStats(*params.InitialStats, c.houseRules); err != nil {
+			return nil, err
+		}
+	}
+
+	inv.accept()
 
 	c.pjs = append(c.pjs, pj)
-	c.uncommittedEvents = append(c.uncommittedEvents, newPjAddedEvent(pj.id, c.id))
+	c.uncommittedEvents = append(c.uncommittedEvents, newPjAddedEvent(pj, c.id))
 
 	return pj, nil
 }
@@ -123,22 +164,50 @@ func (c *Campaign) AddPJ(userID string, params PJCreateParameters, identificatio
 func (c *Campaign) ID() string                             { return c.id }
 func (c *Campaign) MasterID() string                       { return c.masterID }
 func (c *Campaign) Name() string                           { return c.name }
+func (c *Campaign) StartingXP() XP                         { return c.startingXP }
+func (c *Campaign) HouseRules() HouseRules                 { return c.houseRules }
+func (c *Campaign) Ruleset() Ruleset                       { return c.ruleset }
 func (c *Campaign) Invitations() []*Invitation             { return c.invitations }
 func (c *Campaign) PJs() []*PJ                             { return c.pjs }
 func (c *Campaign) Sessions() []*session.Session           { return c.sessions }
 func (c *Campaign) UncommittedEvents() []event.DomainEvent { return c.uncommittedEvents }
 
-func CreateCampaignWithoutValidation(id, masterID, name string, invitations []*Invitation, pjs []*PJ, sessions []*session.Session) *Campaign {
+func CreateCampaignWithoutValidation(
+	id, masterID, name string,
+	startingXP XP,
+	houseRules HouseRules,
+	ruleset Ruleset,
+	invitations []*Invitation,
+	pjs []*PJ,
+	sessions []*session.Session,
+) *Campaign {
 	return &Campaign{
 		id:          id,
 		masterID:    masterID,
 		name:        name,
+		startingXP:  startingXP,
+		houseRules:  houseRules,
+		ruleset:     ruleset,
 		invitations: invitations,
 		pjs:         pjs,
 		sessions:    sessions,
 	}
 }
 
+// UpdateHouseRules replaces the campaign house rules. They apply to PJs created or updated and
+// sessions recorded from now on.
+func (c *Campaign) UpdateHouseRules(houseRules HouseRules) {
+	c.houseRules = houseRules
+	c.uncommittedEvents = append(c.uncommittedEvents, newHouseRulesUpdatedEvent(c))
+}
+
+// SessionAttendanceXP is the base XP every attendee of a new session earns.
+func (c *Campaign) SessionAttendanceXP() session.XPAmounts {
+	xp := c.houseRules.attendanceXP
+
+	return session.NewXPAmounts(xp.basic, xp.special, xp.supernatural)
+}
+
 func (c *Campaign) FindPjByID(pjID string) *PJ {
 	for i := range c.pjs {
 		if c.pjs[i].id == pjID {
@@ -149,6 +218,18 @@ func (c *Campaign) FindPjByID(pjID string) *PJ {
 	return nil
 }
 
+// FindUserPjIDs returns the IDs of the campaign PJs owned by the user.
+func (c *Campaign) FindUserPjIDs(userID string) []string {
+	var pjIDs []string
+	for i := range c.pjs {
+		if c.pjs[i].userID == userID {
+			pjIDs = append(pjIDs, c.pjs[i].id)
+		}
+	}
+
+	return pjIDs
+}
+
 func (c *Campaign) MustContainPjs(pjIDs []string) error {
 	campaignPJs := make(map[string]struct{}, len(c.pjs))
 	for _, pj := range c.pjs {
@@ -163,3 +244,52 @@ func (c *Campaign) MustContainPjs(pjIDs []string) error {
 
 	return nil
 }
+
+// ValidateSessionXP checks the XP lines of a session against the campaign: every PJ belongs to
+// it, human PJs don't get supernatural XP they could never spend, and no PJ earns more than the
+// session cap of the house rules.
+func (c *Campaign) ValidateSessionXP(xpAssignations []session.XPAssignation) error {
+	earned := make(map[string]XP)
+
+	for _, xpA := range xpAssignations {
+		pj := c.FindPjByID(xpA.PjID())
+		if pj == nil {
+			return ErrPJsNotInCampaign
+		}
+
+		if pj.pjType != PJTypeSupernatural && xpA.SuperNatural() > 0 {
+			return ErrSupernaturalXPForHuman
+		}
+
+		total := earned[pj.id]
+		total.basic += xpA.Basic()
+		total.special += xpA.Special()
+		total.supernatural += xpA.SuperNatural()
+		earned[pj.id] = total
+	}
+
+	for _, total := range earned {
+		if err := c.houseRules.validateSessionXP(total); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CanAmendSessionXP checks that every PJ affected by a session amendment belongs to the campaign
+// and still has the XP that the amendment takes back.
+func (c *Campaign) CanAmendSessionXP(deltas []session.XPDelta) error {
+	for _, delta := range deltas {
+		pj := c.FindPjByID(delta.PjID())
+		if pj == nil {
+			return ErrPJsNotInCampaign
+		}
+
+		if err := pj.CanAmendXp(delta.Basic(), delta.Special(), delta.Supernatural()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package campaign
 
 import "context"
 
+//go:generate mockgen -destination=../../../tests/mocks/campaign_query_service_mock.go -package=mocks meye-core/internal/domain/campaign CampaignQueryService
 type CampaignQueryService interface {
 	GetCampaignsBasicInfo(ctx context.Context, masterID string) ([]*CampaignBasicInfo, error)
+	// GetCampaignBasicInfo returns nil when the campaign does not exist.
+	GetCampaignBasicInfo(ctx context.Context, campaignID string) (*CampaignBasicInfo, error)
 }
@@ -0,0 +1,97 @@
+package campaign_test
+
+import (
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newCampaignWithInvitation(t *testing.T, startingXP campaign.XP, houseRules campaign.HouseRules) (*campaign.Campaign, *mocks.MockIdentificationService) {
+	ctrl := gomock.NewController(t)
+	idService := mocks.NewMockIdentificationService(ctrl)
+	idService.EXPECT().GenerateID().Return("generated-id").AnyTimes()
+
+	c := campaign.CreateCampaignWithoutValidation(
+		"campaign-id", "master-id", "campaign", startingXP, houseRules, campaign.DefaultRuleset(),
+		[]*campaign.Invitation{}, []*campaign.PJ{}, []*session.Session{},
+	)
+	_, err := c.InviteUser("user-id", idService)
+	require.NoError(t, err)
+
+	return c, idService
+}
+
+// basicStatsParameters matches data.BasicStatsWithNoTalents, which costs 1280 XP.
+func basicStatsParameters() campaign.BasicStatsParameters {
+	return campaign.BasicStatsParameters{
+		Physical:     campaign.PhysicalParameters{Strength: 13, Agility: 13, Speed: 13, Resistance: 13},
+		Mental:       campaign.MentalParameters{Intelligence: 23, Wisdom: 23, Concentration: 23, Will: 23},
+		Coordination: campaign.CoordinationParameters{Precision: 33, Calculation: 33, Range: 33, Reflexes: 33},
+		Life:         44,
+	}
+}
+
+func TestCampaign_AddPJ_StartingXP(t *testing.T) {
+	t.Run("starts with the campaign starting XP when no initial stats are given", func(t *testing.T) {
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(100, 200, 300), campaign.HouseRules{})
+
+		pj, err := c.AddPJ("user-id", campaign.PJCreateParameters{PjType: campaign.PJTypeHuman}, idService)
+
+		require.NoError(t, err)
+		assert.Equal(t, campaign.CreateXPWithoutValidation(100, 200, 300), pj.XP())
+	})
+
+	t.Run("buys the initial stats and keeps the leftover XP", func(t *testing.T) {
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(2000, 50, 0), campaign.HouseRules{})
+
+		pj, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType: campaign.PJTypeHuman,
+			InitialStats: &campaign.PjInitialStatsParameters{
+				BasicStats: basicStatsParameters(),
+			},
+		}, idService)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(13), pj.BasicStats().Physical().Strength())
+		assert.Equal(t, campaign.CreateXPWithoutValidation(720, 50, 0), pj.XP())
+		assert.Equal(t, uint(1280), pj.SpentXP().Basic())
+		assert.Nil(t, c.GetPendingUserInvitation("user-id"))
+	})
+
+	t.Run("buys the initial supernatural skill", func(t *testing.T) {
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(0, 0, 400), campaign.HouseRules{})
+
+		pj, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType: campaign.PJTypeSupernatural,
+			InitialStats: &campaign.PjInitialStatsParameters{
+				Skill: &campaign.InitialSkillParameters{Name: "Pyrokinesis", TransformationLevel: 210},
+			},
+		}, idService)
+
+		require.NoError(t, err)
+		skill := pj.SupernaturalStats().Skills()[0]
+		assert.Equal(t, "Pyrokinesis", skill.Name())
+		assert.Equal(t, uint(210), skill.Transformations()[0].Level())
+		assert.Equal(t, uint(70), pj.XP().Supernatural())
+	})
+
+	t.Run("fails when the starting XP doesn't cover the initial stats", func(t *testing.T) {
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(1279, 0, 0), campaign.HouseRules{})
+
+		_, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType: campaign.PJTypeHuman,
+			InitialStats: &campaign.PjInitialStatsParameters{
+				BasicStats: basicStatsParameters(),
+			},
+		}, idService)
+
+		assert.ErrorIs(t, err, campaign.ErrInsufficientXP)
+		assert.Empty(t, c.PJs())
+		assert.NotNil(t, c.GetPendingUserInvitation("user-id"))
+	})
+}
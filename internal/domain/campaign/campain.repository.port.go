@@ -8,4 +8,6 @@ import (
 type Repository interface {
 	Save(ctx context.Context, campaign *Campaign) error
 	FindByID(ctx context.Context, id string) (*Campaign, error)
+	// FindHouseRules reads only the house rules of the campaign, nil when it does not exist.
+	FindHouseRules(ctx context.Context, id string) (*HouseRules, error)
 }
@@ -10,4 +10,22 @@ var (
 	ErrInsufficientXP                = errors.New("ERR_INSUFFICIENT_XP")
 	ErrSupernaturalStatsRequired     = errors.New("ERR_SUPERNATURAL_STATS_REQUIRED")
 	ErrCannotUpdateSupernaturalStats = errors.New("ERR_CANNOT_UPDATE_SUPERNATURAL_STATS")
+	ErrSkillNotFound                 = errors.New("ERR_SKILL_NOT_FOUND")
+	ErrTransformationNotFound        = errors.New("ERR_TRANSFORMATION_NOT_FOUND")
+	ErrSkillRemoved                  = errors.New("ERR_SKILL_REMOVED")
+	ErrTransformationRemoved         = errors.New("ERR_TRANSFORMATION_REMOVED")
+	ErrDuplicateSkill                = errors.New("ERR_DUPLICATE_SKILL")
+	ErrDuplicateTransformation       = errors.New("ERR_DUPLICATE_TRANSFORMATION")
+	ErrSkillLimitReached             = errors.New("ERR_SKILL_LIMIT_REACHED")
+	ErrInvalidHouseRules             = errors.New("ERR_INVALID_HOUSE_RULES")
+	ErrTooManyTalents                = errors.New("ERR_TOO_MANY_TALENTS")
+	ErrExclusiveTalents              = errors.New("ERR_EXCLUSIVE_TALENTS")
+	ErrInvalidRuleset                = errors.New("ERR_INVALID_RULESET")
+	ErrRulesetNotFound               = errors.New("ERR_RULESET_NOT_FOUND")
+	ErrStatCapExceeded               = errors.New("ERR_STAT_CAP_EXCEEDED")
+	ErrLifeCapExceeded               = errors.New("ERR_LIFE_CAP_EXCEEDED")
+	ErrEnergyTankCapExceeded         = errors.New("ERR_ENERGY_TANK_CAP_EXCEEDED")
+	ErrXPAlreadySpent                = errors.New("ERR_XP_ALREADY_SPENT")
+	ErrSupernaturalXPForHuman        = errors.New("ERR_SUPERNATURAL_XP_FOR_HUMAN")
+	ErrSessionXPCapExceeded          = errors.New("ERR_SESSION_XP_CAP_EXCEEDED")
 )
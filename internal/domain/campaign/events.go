@@ -36,6 +36,60 @@ func newCampaignCreatedEvent(c *Campaign) CampaignCreatedEvent {
 	}
 }
 
+var _ event.DomainEvent = (*HouseRulesUpdatedEvent)(nil)
+
+type HouseRulesUpdatedEvent struct {
+	id         string
+	campaignID string
+	houseRules HouseRules
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e HouseRulesUpdatedEvent) ID() string            { return e.id }
+func (e HouseRulesUpdatedEvent) Type() event.EventType { return event.EventTypeHouseRulesUpdated }
+func (e HouseRulesUpdatedEvent) AggregateID() string   { return e.campaignID }
+func (e HouseRulesUpdatedEvent) AggregateType() event.AggregateType {
+	return event.AggregateTypeCampaign
+}
+func (e HouseRulesUpdatedEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e HouseRulesUpdatedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e HouseRulesUpdatedEvent) HouseRules() HouseRules { return e.houseRules }
+
+func (e HouseRulesUpdatedEvent) GetSerializedData() map[string]interface{} {
+	statCaps := make(map[string]interface{}, len(e.houseRules.statCaps))
+	for stat, limit := range e.houseRules.statCaps {
+		statCaps[string(stat)] = limit
+	}
+
+	return map[string]interface{}{
+		"max_talents":       e.houseRules.maxTalents,
+		"exclusive_talents": e.houseRules.exclusiveTalents,
+		"stat_caps":         statCaps,
+		"attendance_xp": map[string]interface{}{
+			"basic":        e.houseRules.attendanceXP.basic,
+			"special":      e.houseRules.attendanceXP.special,
+			"supernatural": e.houseRules.attendanceXP.supernatural,
+		},
+		"session_xp_cap": map[string]interface{}{
+			"basic":        e.houseRules.sessionXPCap.basic,
+			"special":      e.houseRules.sessionXPCap.special,
+			"supernatural": e.houseRules.sessionXPCap.supernatural,
+		},
+	}
+}
+
+func newHouseRulesUpdatedEvent(c *Campaign) HouseRulesUpdatedEvent {
+	return HouseRulesUpdatedEvent{
+		id:         uuid.NewString(),
+		campaignID: c.id,
+		houseRules: c.houseRules,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
 var _ event.DomainEvent = (*UserInvitedEvent)(nil)
 
 type UserInvitedEvent struct {
@@ -77,6 +131,7 @@ type PjAddedEvent struct {
 	id         string
 	campaignID string
 	pjID       string
+	spentXP    XP // starting XP spent on the initial stats
 	createdAt  time.Time
 	occurredAt time.Time
 }
@@ -89,18 +144,23 @@ func (e PjAddedEvent) CreatedAt() time.Time               { return e.createdAt }
 func (e PjAddedEvent) OccurredAt() time.Time              { return e.occurredAt }
 
 func (e PjAddedEvent) CampaignID() string { return e.campaignID }
+func (e PjAddedEvent) SpentXP() XP        { return e.spentXP }
 
 func (e PjAddedEvent) GetSerializedData() map[string]interface{} {
 	return map[string]interface{}{
-		"campaign_id": e.campaignID,
+		"campaign_id":           e.campaignID,
+		"basic_spent_xp":        e.spentXP.basic,
+		"special_spent_xp":      e.spentXP.special,
+		"supernatural_spent_xp": e.spentXP.supernatural,
 	}
 }
 
-func newPjAddedEvent(pjID, campaignID string) PjAddedEvent {
+func newPjAddedEvent(pj *PJ, campaignID string) PjAddedEvent {
 	return PjAddedEvent{
 		id:         uuid.NewString(),
 		campaignID: campaignID,
-		pjID:       pjID,
+		pjID:       pj.id,
+		spentXP:    pj.spentXP,
 		createdAt:  time.Now(),
 		occurredAt: time.Now(),
 	}
@@ -149,6 +209,98 @@ func newXpConsumendEvent(pj *PJ, basic, special, supernatural uint) XpConsumedEv
 	}
 }
 
+var _ event.DomainEvent = (*XpAmendedEvent)(nil)
+
+type XpAmendedEvent struct {
+	id           string
+	pjID         string
+	basic        int
+	special      int
+	supernatural int
+	createdAt    time.Time
+	occurredAt   time.Time
+}
+
+func (e XpAmendedEvent) ID() string                         { return e.id }
+func (e XpAmendedEvent) Type() event.EventType              { return event.EventTypeXpAmended }
+func (e XpAmendedEvent) AggregateID() string                { return e.pjID }
+func (e XpAmendedEvent) AggregateType() event.AggregateType { return event.AggregateTypePJ }
+func (e XpAmendedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e XpAmendedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e XpAmendedEvent) Basic() int        { return e.basic }
+func (e XpAmendedEvent) Special() int      { return e.special }
+func (e XpAmendedEvent) SuperNatural() int { return e.supernatural }
+
+func (e XpAmendedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"basic":        e.basic,
+		"special":      e.special,
+		"supernatural": e.supernatural,
+	}
+}
+
+func newXpAmendedEvent(pj *PJ, basic, special, supernatural int) XpAmendedEvent {
+	return XpAmendedEvent{
+		id:           uuid.NewString(),
+		pjID:         pj.id,
+		basic:        basic,
+		special:      special,
+		supernatural: supernatural,
+		createdAt:    time.Now(),
+		occurredAt:   time.Now(),
+	}
+}
+
+var _ event.DomainEvent = (*XpAmendmentRejectedEvent)(nil)
+
+// XpAmendmentRejectedEvent records a session amendment whose XP could not be taken back from the
+// PJ, so the master can settle it by hand.
+type XpAmendmentRejectedEvent struct {
+	id           string
+	pjID         string
+	basic        int
+	special      int
+	supernatural int
+	reason       string
+	createdAt    time.Time
+	occurredAt   time.Time
+}
+
+func (e XpAmendmentRejectedEvent) ID() string                         { return e.id }
+func (e XpAmendmentRejectedEvent) Type() event.EventType              { return event.EventTypeXpAmendmentRejected }
+func (e XpAmendmentRejectedEvent) AggregateID() string                { return e.pjID }
+func (e XpAmendmentRejectedEvent) AggregateType() event.AggregateType { return event.AggregateTypePJ }
+func (e XpAmendmentRejectedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e XpAmendmentRejectedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e XpAmendmentRejectedEvent) Basic() int        { return e.basic }
+func (e XpAmendmentRejectedEvent) Special() int      { return e.special }
+func (e XpAmendmentRejectedEvent) SuperNatural() int { return e.supernatural }
+func (e XpAmendmentRejectedEvent) Reason() string    { return e.reason }
+
+func (e XpAmendmentRejectedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"basic":        e.basic,
+		"special":      e.special,
+		"supernatural": e.supernatural,
+		"reason":       e.reason,
+	}
+}
+
+func newXpAmendmentRejectedEvent(pj *PJ, basic, special, supernatural int, reason error) XpAmendmentRejectedEvent {
+	return XpAmendmentRejectedEvent{
+		id:           uuid.NewString(),
+		pjID:         pj.id,
+		basic:        basic,
+		special:      special,
+		supernatural: supernatural,
+		reason:       reason.Error(),
+		createdAt:    time.Now(),
+		occurredAt:   time.Now(),
+	}
+}
+
 var _ event.DomainEvent = (*StatsUpdatedEvent)(nil)
 
 type StatsUpdatedEvent struct {
@@ -268,8 +420,23 @@ func (e StatsUpdatedEvent) serializeSpecialStats(ss SpecialStats) map[string]int
 func (e StatsUpdatedEvent) serializeSupernaturalStats(ss *SupernaturalStats) map[string]interface{} {
 	skills := make([]map[string]interface{}, len(ss.Skills()))
 	for i, skill := range ss.Skills() {
+		transformations := make([]map[string]interface{}, len(skill.Transformations()))
+		for j, transformation := range skill.Transformations() {
+			transformations[j] = map[string]interface{}{
+				"id":          transformation.ID(),
+				"name":        transformation.Name(),
+				"description": transformation.Description(),
+				"level":       transformation.Level(),
+				"unlock_cost": transformation.UnlockCost(),
+			}
+		}
+
 		skills[i] = map[string]interface{}{
-			"transformations": skill.Transformations(),
+			"id":              skill.ID(),
+			"name":            skill.Name(),
+			"description":     skill.Description(),
+			"unlock_cost":     skill.UnlockCost(),
+			"transformations": transformations,
 		}
 	}
 	return map[string]interface{}{
@@ -305,3 +472,93 @@ func newStatsUpdatedEvent(
 		occurredAt:                time.Now(),
 	}
 }
+
+var _ event.DomainEvent = (*SkillLearnedEvent)(nil)
+
+type SkillLearnedEvent struct {
+	id         string
+	pjID       string
+	skill      Skill
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e SkillLearnedEvent) ID() string                         { return e.id }
+func (e SkillLearnedEvent) Type() event.EventType              { return event.EventTypeSkillLearned }
+func (e SkillLearnedEvent) AggregateID() string                { return e.pjID }
+func (e SkillLearnedEvent) AggregateType() event.AggregateType { return event.AggregateTypePJ }
+func (e SkillLearnedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e SkillLearnedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e SkillLearnedEvent) Skill() Skill { return e.skill }
+
+func (e SkillLearnedEvent) GetSerializedData() map[string]interface{} {
+	transformationIDs := make([]string, len(e.skill.transformations))
+	for i, t := range e.skill.transformations {
+		transformationIDs[i] = t.id
+	}
+
+	return map[string]interface{}{
+		"skill_id":              e.skill.id,
+		"name":                  e.skill.name,
+		"description":           e.skill.description,
+		"transformation_ids":    transformationIDs,
+		"supernatural_spent_xp": e.skill.unlockCost,
+	}
+}
+
+func newSkillLearnedEvent(pj *PJ, skill Skill) SkillLearnedEvent {
+	return SkillLearnedEvent{
+		id:         uuid.NewString(),
+		pjID:       pj.id,
+		skill:      skill,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+var _ event.DomainEvent = (*TransformationUnlockedEvent)(nil)
+
+type TransformationUnlockedEvent struct {
+	id             string
+	pjID           string
+	skillID        string
+	transformation Transformation
+	createdAt      time.Time
+	occurredAt     time.Time
+}
+
+func (e TransformationUnlockedEvent) ID() string { return e.id }
+func (e TransformationUnlockedEvent) Type() event.EventType {
+	return event.EventTypeTransformationUnlocked
+}
+func (e TransformationUnlockedEvent) AggregateID() string { return e.pjID }
+func (e TransformationUnlockedEvent) AggregateType() event.AggregateType {
+	return event.AggregateTypePJ
+}
+func (e TransformationUnlockedEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e TransformationUnlockedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e TransformationUnlockedEvent) SkillID() string                { return e.skillID }
+func (e TransformationUnlockedEvent) Transformation() Transformation { return e.transformation }
+
+func (e TransformationUnlockedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"skill_id":              e.skillID,
+		"transformation_id":     e.transformation.id,
+		"name":                  e.transformation.name,
+		"description":           e.transformation.description,
+		"supernatural_spent_xp": e.transformation.unlockCost,
+	}
+}
+
+func newTransformationUnlockedEvent(pj *PJ, skillID string, transformation Transformation) TransformationUnlockedEvent {
+	return TransformationUnlockedEvent{
+		id:             uuid.NewString(),
+		pjID:           pj.id,
+		skillID:        skillID,
+		transformation: transformation,
+		createdAt:      time.Now(),
+		occurredAt:     time.Now(),
+	}
+}
@@ -0,0 +1,262 @@
+package campaign
+
+import "slices"
+
+type Talent string
+
+const (
+	TalentPhysical       Talent = "physical"
+	TalentMental         Talent = "mental"
+	TalentCoordination   Talent = "coordination"
+	TalentPhysicalSkills Talent = "physical_skills"
+	TalentMentalSkills   Talent = "mental_skills"
+	TalentEnergySkills   Talent = "energy_skills"
+	TalentEnergy         Talent = "energy"
+)
+
+var talents = map[Talent]struct{}{
+	TalentPhysical:       {},
+	TalentMental:         {},
+	TalentCoordination:   {},
+	TalentPhysicalSkills: {},
+	TalentMentalSkills:   {},
+	TalentEnergySkills:   {},
+	TalentEnergy:         {},
+}
+
+type Stat string
+
+// Basic stats.
+const (
+	StatStrength      Stat = "strength"
+	StatAgility       Stat = "agility"
+	StatSpeed         Stat = "speed"
+	StatResistance    Stat = "resistance"
+	StatIntelligence  Stat = "intelligence"
+	StatWisdom        Stat = "wisdom"
+	StatConcentration Stat = "concentration"
+	StatWill          Stat = "will"
+	StatPrecision     Stat = "precision"
+	StatCalculation   Stat = "calculation"
+	StatRange         Stat = "range"
+	StatReflexes      Stat = "reflexes"
+	StatLife          Stat = "life"
+)
+
+// Special stats.
+const (
+	StatEmpowerment    Stat = "empowerment"
+	StatVitalControl   Stat = "vital_control"
+	StatIllusion       Stat = "illusion"
+	StatMentalControl  Stat = "mental_control"
+	StatObjectHandling Stat = "object_handling"
+	StatEnergyHandling Stat = "energy_handling"
+	StatEnergyTank     Stat = "energy_tank"
+)
+
+// stats lists every stat in a fixed order, so checks over several stats always report the same one first.
+var stats = []Stat{
+	StatStrength,
+	StatAgility,
+	StatSpeed,
+	StatResistance,
+	StatIntelligence,
+	StatWisdom,
+	StatConcentration,
+	StatWill,
+	StatPrecision,
+	StatCalculation,
+	StatRange,
+	StatReflexes,
+	StatLife,
+	StatEmpowerment,
+	StatVitalControl,
+	StatIllusion,
+	StatMentalControl,
+	StatObjectHandling,
+	StatEnergyHandling,
+	StatEnergyTank,
+}
+
+// HouseRules are the campaign level restrictions on PJ talents, stats and session XP, and the
+// XP every session attendee earns. Zero values mean no restriction and no attendance XP.
+type HouseRules struct {
+	maxTalents       uint
+	exclusiveTalents [][]Talent // at most one talent of each group can be chosen
+	statCaps         map[Stat]uint
+	attendanceXP     XP // base XP of every attendee of a session, before bonuses
+	sessionXPCap     XP // maximum XP of each category a PJ can earn in a session
+}
+
+func (hr HouseRules) MaxTalents() uint             { return hr.maxTalents }
+func (hr HouseRules) ExclusiveTalents() [][]Talent { return hr.exclusiveTalents }
+func (hr HouseRules) StatCaps() map[Stat]uint      { return hr.statCaps }
+func (hr HouseRules) AttendanceXP() XP             { return hr.attendanceXP }
+func (hr HouseRules) SessionXPCap() XP             { return hr.sessionXPCap }
+
+// NewHouseRules validates that every talent and stat is known. Attendance XP can't be
+// supernatural, human attendees would never be able to spend it.
+func NewHouseRules(maxTalents uint, exclusiveTalents [][]Talent, statCaps map[Stat]uint, attendanceXP, sessionXPCap XP) (HouseRules, error) {
+	if attendanceXP.supernatural > 0 {
+		return HouseRules{}, ErrInvalidHouseRules
+	}
+
+	for _, group := range exclusiveTalents {
+		if len(group) < 2 {
+			return HouseRules{}, ErrInvalidHouseRules
+		}
+
+		for _, talent := range group {
+			if _, ok := talents[talent]; !ok {
+				return HouseRules{}, ErrInvalidHouseRules
+			}
+		}
+	}
+
+	for stat := range statCaps {
+		if !slices.Contains(stats, stat) {
+			return HouseRules{}, ErrInvalidHouseRules
+		}
+	}
+
+	return CreateHouseRulesWithoutValidation(maxTalents, exclusiveTalents, statCaps, attendanceXP, sessionXPCap), nil
+}
+
+func CreateHouseRulesWithoutValidation(maxTalents uint, exclusiveTalents [][]Talent, statCaps map[Stat]uint, attendanceXP, sessionXPCap XP) HouseRules {
+	return HouseRules{
+		maxTalents:       maxTalents,
+		exclusiveTalents: exclusiveTalents,
+		statCaps:         statCaps,
+		attendanceXP:     attendanceXP,
+		sessionXPCap:     sessionXPCap,
+	}
+}
+
+// validateSessionXP checks the total XP a PJ earns in a session against the caps.
+func (hr HouseRules) validateSessionXP(earned XP) error {
+	caps := hr.sessionXPCap
+
+	if (caps.basic > 0 && earned.basic > caps.basic) ||
+		(caps.special > 0 && earned.special > caps.special) ||
+		(caps.supernatural > 0 && earned.supernatural > caps.supernatural) {
+		return ErrSessionXPCapExceeded
+	}
+
+	return nil
+}
+
+func (hr HouseRules) validateTalents(pjTalents []Talent) error {
+	if hr.maxTalents > 0 && uint(len(pjTalents)) > hr.maxTalents {
+		return ErrTooManyTalents
+	}
+
+	chosen := make(map[Talent]struct{}, len(pjTalents))
+	for _, talent := range pjTalents {
+		chosen[talent] = struct{}{}
+	}
+
+	for _, group := range hr.exclusiveTalents {
+		var count int
+		for _, talent := range group {
+			if _, ok := chosen[talent]; ok {
+				count++
+			}
+		}
+
+		if count > 1 {
+			return ErrExclusiveTalents
+		}
+	}
+
+	return nil
+}
+
+// validateStats checks the stats that increase from previous to current against their caps.
+// Stats that don't increase are accepted, so lowering a cap doesn't block PJs already above it.
+func (hr HouseRules) validateStats(previous, current map[Stat]uint) error {
+	for _, stat := range stats {
+		value, ok := current[stat]
+		if !ok {
+			continue
+		}
+
+		limit, ok := hr.statCaps[stat]
+		if !ok || limit == 0 || value <= limit || value <= previous[stat] {
+			continue
+		}
+
+		switch stat {
+		case StatLife:
+			return ErrLifeCapExceeded
+		case StatEnergyTank:
+			return ErrEnergyTankCapExceeded
+		default:
+			return ErrStatCapExceeded
+		}
+	}
+
+	return nil
+}
+
+func (bs BasicStats) statValues() map[Stat]uint {
+	return map[Stat]uint{
+		StatStrength:      bs.physical.strength,
+		StatAgility:       bs.physical.agility,
+		StatSpeed:         bs.physical.speed,
+		StatResistance:    bs.physical.resistance,
+		StatIntelligence:  bs.mental.inteligence,
+		StatWisdom:        bs.mental.wisdom,
+		StatConcentration: bs.mental.concentration,
+		StatWill:          bs.mental.will,
+		StatPrecision:     bs.coordination.precision,
+		StatCalculation:   bs.coordination.calculation,
+		StatRange:         bs.coordination.coordRange,
+		StatReflexes:      bs.coordination.reflexes,
+		StatLife:          bs.life,
+	}
+}
+
+func (ss SpecialStats) statValues() map[Stat]uint {
+	return map[Stat]uint{
+		StatEmpowerment:    ss.physical.empowerment,
+		StatVitalControl:   ss.physical.vitalControl,
+		StatIllusion:       ss.mental.ilusion,
+		StatMentalControl:  ss.mental.mentalControl,
+		StatObjectHandling: ss.energy.objectHandling,
+		StatEnergyHandling: ss.energy.energyHandling,
+		StatEnergyTank:     ss.energyTank,
+	}
+}
+
+func (pj *PJ) statValues() map[Stat]uint {
+	values := pj.basicStats.statValues()
+	for stat, value := range pj.specialStats.statValues() {
+		values[stat] = value
+	}
+
+	return values
+}
+
+func (pj *PJ) talents() []Talent {
+	flags := []struct {
+		talent     Talent
+		isTalented bool
+	}{
+		{TalentPhysical, pj.basicStats.physical.isTalented},
+		{TalentMental, pj.basicStats.mental.isTalented},
+		{TalentCoordination, pj.basicStats.coordination.isTalented},
+		{TalentPhysicalSkills, pj.specialStats.physical.isTalented},
+		{TalentMentalSkills, pj.specialStats.mental.isTalented},
+		{TalentEnergySkills, pj.specialStats.energy.isTalented},
+		{TalentEnergy, pj.specialStats.isEnergyTalented},
+	}
+
+	var pjTalents []Talent
+	for _, flag := range flags {
+		if flag.isTalented {
+			pjTalents = append(pjTalents, flag.talent)
+		}
+	}
+
+	return pjTalents
+}
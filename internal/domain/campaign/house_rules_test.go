@@ -0,0 +1,218 @@
+package campaign_test
+
+import (
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/session"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHouseRules(t *testing.T) {
+	t.Run("accepts known talents and stats", func(t *testing.T) {
+		_, err := campaign.NewHouseRules(
+			2,
+			[][]campaign.Talent{{campaign.TalentPhysical, campaign.TalentMental}},
+			map[campaign.Stat]uint{campaign.StatLife: 60},
+			campaign.CreateXPWithoutValidation(10, 0, 0),
+			campaign.CreateXPWithoutValidation(30, 10, 5),
+		)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects unknown talents", func(t *testing.T) {
+		_, err := campaign.NewHouseRules(0, [][]campaign.Talent{{campaign.TalentPhysical, "flying"}}, nil, campaign.XP{}, campaign.XP{})
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidHouseRules)
+	})
+
+	t.Run("rejects exclusive groups with a single talent", func(t *testing.T) {
+		_, err := campaign.NewHouseRules(0, [][]campaign.Talent{{campaign.TalentPhysical}}, nil, campaign.XP{}, campaign.XP{})
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidHouseRules)
+	})
+
+	t.Run("rejects unknown stats", func(t *testing.T) {
+		_, err := campaign.NewHouseRules(0, nil, map[campaign.Stat]uint{"luck": 10}, campaign.XP{}, campaign.XP{})
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidHouseRules)
+	})
+
+	t.Run("rejects supernatural attendance XP", func(t *testing.T) {
+		_, err := campaign.NewHouseRules(0, nil, nil, campaign.CreateXPWithoutValidation(10, 0, 5), campaign.XP{})
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidHouseRules)
+	})
+}
+
+func TestCampaign_ValidateSessionXP(t *testing.T) {
+	newPJ := func(id string, pjType campaign.PJType) *campaign.PJ {
+		var supernaturalStats *campaign.SupernaturalStats
+		if pjType == campaign.PJTypeSupernatural {
+			supernaturalStats = campaign.CreateSupernaturalStatsWithoutValidation(nil)
+		}
+
+		return campaign.CreatePJWithoutValidation(
+			id, "campaign-id", "user-id", "pj", 0, 0, 0, 0, 0, 0, 0,
+			pjType,
+			campaign.BasicStats{},
+			campaign.SpecialStats{},
+			supernaturalStats,
+			campaign.XP{},
+			campaign.DefaultRuleset(),
+		)
+	}
+
+	c := campaign.CreateCampaignWithoutValidation(
+		"campaign-id", "master-id", "campaign",
+		campaign.XP{},
+		campaign.CreateHouseRulesWithoutValidation(0, nil, nil, campaign.XP{}, campaign.CreateXPWithoutValidation(20, 0, 0)),
+		campaign.DefaultRuleset(),
+		nil,
+		[]*campaign.PJ{newPJ("human-id", campaign.PJTypeHuman), newPJ("supernatural-id", campaign.PJTypeSupernatural)},
+		nil,
+	)
+
+	t.Run("accepts XP within the cap", func(t *testing.T) {
+		err := c.ValidateSessionXP([]session.XPAssignation{
+			session.CreateXPAssignationWithoutValidation("human-id", session.XPKindAttendance, 10, 0, 0, "attendance"),
+			session.NewXPAssignation("human-id", 10, 5, 0, "bonus"),
+			session.NewXPAssignation("supernatural-id", 0, 0, 50, "bonus"),
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects PJs outside the campaign", func(t *testing.T) {
+		err := c.ValidateSessionXP([]session.XPAssignation{session.NewXPAssignation("unknown-id", 1, 0, 0, "bonus")})
+
+		assert.ErrorIs(t, err, campaign.ErrPJsNotInCampaign)
+	})
+
+	t.Run("rejects supernatural XP for humans", func(t *testing.T) {
+		err := c.ValidateSessionXP([]session.XPAssignation{session.NewXPAssignation("human-id", 0, 0, 1, "bonus")})
+
+		assert.ErrorIs(t, err, campaign.ErrSupernaturalXPForHuman)
+	})
+
+	t.Run("adds every line of a PJ against the cap", func(t *testing.T) {
+		err := c.ValidateSessionXP([]session.XPAssignation{
+			session.CreateXPAssignationWithoutValidation("human-id", session.XPKindAttendance, 10, 0, 0, "attendance"),
+			session.NewXPAssignation("human-id", 11, 0, 0, "bonus"),
+		})
+
+		assert.ErrorIs(t, err, campaign.ErrSessionXPCapExceeded)
+	})
+}
+
+func TestCampaign_AddPJ_HouseRules(t *testing.T) {
+	t.Run("rejects more talents than allowed", func(t *testing.T) {
+		houseRules := campaign.CreateHouseRulesWithoutValidation(1, nil, nil, campaign.XP{}, campaign.XP{})
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(0, 0, 0), houseRules)
+
+		_, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType:             campaign.PJTypeHuman,
+			IsPhysicalTalented: true,
+			IsMentalTalented:   true,
+		}, idService)
+
+		assert.ErrorIs(t, err, campaign.ErrTooManyTalents)
+		assert.NotNil(t, c.GetPendingUserInvitation("user-id"))
+	})
+
+	t.Run("rejects exclusive talents chosen together", func(t *testing.T) {
+		houseRules := campaign.CreateHouseRulesWithoutValidation(0, [][]campaign.Talent{
+			{campaign.TalentPhysical, campaign.TalentEnergy},
+		}, nil, campaign.XP{}, campaign.XP{})
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(0, 0, 0), houseRules)
+
+		_, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType:             campaign.PJTypeHuman,
+			IsPhysicalTalented: true,
+			IsEnergyTalented:   true,
+		}, idService)
+
+		assert.ErrorIs(t, err, campaign.ErrExclusiveTalents)
+	})
+
+	t.Run("rejects initial stats above their cap", func(t *testing.T) {
+		houseRules := campaign.CreateHouseRulesWithoutValidation(0, nil, map[campaign.Stat]uint{campaign.StatLife: 40}, campaign.XP{}, campaign.XP{})
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(2000, 0, 0), houseRules)
+
+		_, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType: campaign.PJTypeHuman,
+			InitialStats: &campaign.PjInitialStatsParameters{
+				BasicStats: basicStatsParameters(),
+			},
+		}, idService)
+
+		assert.ErrorIs(t, err, campaign.ErrLifeCapExceeded)
+	})
+
+	t.Run("accepts initial stats within their caps", func(t *testing.T) {
+		houseRules := campaign.CreateHouseRulesWithoutValidation(1, nil, map[campaign.Stat]uint{campaign.StatStrength: 13}, campaign.XP{}, campaign.XP{})
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(2000, 0, 0), houseRules)
+
+		pj, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType:             campaign.PJTypeHuman,
+			IsPhysicalTalented: true,
+			InitialStats: &campaign.PjInitialStatsParameters{
+				BasicStats: basicStatsParameters(),
+			},
+		}, idService)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(13), pj.BasicStats().Physical().Strength())
+	})
+}
+
+func TestPJ_UpdateStats_HouseRules(t *testing.T) {
+	newPJ := func(t *testing.T) *campaign.PJ {
+		c, idService := newCampaignWithInvitation(t, campaign.CreateXPWithoutValidation(2000, 0, 0), campaign.HouseRules{})
+
+		pj, err := c.AddPJ("user-id", campaign.PJCreateParameters{
+			PjType: campaign.PJTypeHuman,
+			InitialStats: &campaign.PjInitialStatsParameters{
+				BasicStats: basicStatsParameters(),
+			},
+		}, idService)
+		require.NoError(t, err)
+
+		return pj
+	}
+
+	t.Run("rejects raising a stat above its cap", func(t *testing.T) {
+		pj := newPJ(t)
+		params := campaign.PjUpdateParameters{BasicStats: basicStatsParameters()}
+		params.BasicStats.Physical.Strength = 20
+
+		err := pj.UpdateStats(params, campaign.CreateHouseRulesWithoutValidation(0, nil, map[campaign.Stat]uint{campaign.StatStrength: 15}, campaign.XP{}, campaign.XP{}))
+
+		assert.ErrorIs(t, err, campaign.ErrStatCapExceeded)
+	})
+
+	t.Run("reports the first capped stat in a fixed order", func(t *testing.T) {
+		caps := map[campaign.Stat]uint{campaign.StatStrength: 15, campaign.StatLife: 45}
+		params := campaign.PjUpdateParameters{BasicStats: basicStatsParameters()}
+		params.BasicStats.Physical.Strength = 16
+		params.BasicStats.Life = 46
+
+		for range 20 {
+			err := newPJ(t).UpdateStats(params, campaign.CreateHouseRulesWithoutValidation(0, nil, caps, campaign.XP{}, campaign.XP{}))
+
+			assert.ErrorIs(t, err, campaign.ErrStatCapExceeded)
+		}
+	})
+
+	t.Run("keeps stats already above a lowered cap", func(t *testing.T) {
+		pj := newPJ(t)
+		params := campaign.PjUpdateParameters{BasicStats: basicStatsParameters()}
+		params.BasicStats.Physical.Agility = 14
+
+		err := pj.UpdateStats(params, campaign.CreateHouseRulesWithoutValidation(0, nil, map[campaign.Stat]uint{campaign.StatLife: 40}, campaign.XP{}, campaign.XP{}))
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,16 @@
+package campaign
+
+import "context"
+
+// MembershipQueryService answers the ownership checks of the authorization middlewares
+// without loading whole aggregates.
+//
+//go:generate mockgen -destination=../../../tests/mocks/membership_query_service_mock.go -package=mocks meye-core/internal/domain/campaign MembershipQueryService
+type MembershipQueryService interface {
+	// FindCampaignMasterID returns the ID of the master of the campaign, empty when it does not exist.
+	FindCampaignMasterID(ctx context.Context, campaignID string) (string, error)
+	// FindPjOwnerID returns the ID of the user the PJ belongs to, empty when it does not exist.
+	FindPjOwnerID(ctx context.Context, pjID string) (string, error)
+	// FindUserPjIDs returns the IDs of the campaign PJs owned by the user.
+	FindUserPjIDs(ctx context.Context, campaignID, userID string) ([]string, error)
+}
@@ -2,6 +2,7 @@ package campaign
 
 import (
 	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/shared"
 )
 
 type PJType string
@@ -182,24 +183,173 @@ func CreateSpecialStatsWithoutValidation(physical PhysicalSkills, mental MentalS
 	}
 }
 
+type Transformation struct {
+	id          string
+	name        string
+	description string
+	level       uint
+	unlockCost  uint // supernatural XP paid to unlock the transformation
+}
+
+func (t Transformation) ID() string          { return t.id }
+func (t Transformation) Name() string        { return t.name }
+func (t Transformation) Description() string { return t.description }
+func (t Transformation) Level() uint         { return t.level }
+func (t Transformation) UnlockCost() uint    { return t.unlockCost }
+
+func CreateTransformationWithoutValidation(id, name, description string, level, unlockCost uint) Transformation {
+	return Transformation{
+		id:          id,
+		name:        name,
+		description: description,
+		level:       level,
+		unlockCost:  unlockCost,
+	}
+}
+
 type Skill struct {
-	transformations []uint
+	id              string
+	name            string
+	description     string
+	unlockCost      uint // supernatural XP paid to learn the skill
+	transformations []Transformation
 }
 
-func (s Skill) Transformations() []uint { return s.transformations }
+func (s Skill) ID() string                        { return s.id }
+func (s Skill) Name() string                      { return s.name }
+func (s Skill) Description() string               { return s.description }
+func (s Skill) UnlockCost() uint                  { return s.unlockCost }
+func (s Skill) Transformations() []Transformation { return s.transformations }
 
-func CreateSkillWithoutValidation(transformations []uint) Skill {
+func CreateSkillWithoutValidation(id, name, description string, unlockCost uint, transformations []Transformation) Skill {
 	return Skill{
+		id:              id,
+		name:            name,
+		description:     description,
+		unlockCost:      unlockCost,
 		transformations: transformations,
 	}
 }
 
+func (s Skill) findTransformationIndex(transformationID string) int {
+	for i := range s.transformations {
+		if s.transformations[i].id == transformationID {
+			return i
+		}
+	}
+
+	return -1
+}
+
 type SupernaturalStats struct {
 	skills []Skill
 }
 
 func (ss SupernaturalStats) Skills() []Skill { return ss.skills }
 
+func (ss SupernaturalStats) FindSkillByID(skillID string) (Skill, bool) {
+	i := ss.findSkillIndex(skillID)
+	if i < 0 {
+		return Skill{}, false
+	}
+
+	return ss.skills[i], true
+}
+
+func (ss SupernaturalStats) findSkillIndex(skillID string) int {
+	for i := range ss.skills {
+		if ss.skills[i].id == skillID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// copy returns a deep copy so that changes on the result don't affect the original skills.
+func (ss SupernaturalStats) copy() *SupernaturalStats {
+	skills := make([]Skill, len(ss.skills))
+	for i, skill := range ss.skills {
+		transformations := make([]Transformation, len(skill.transformations))
+		copy(transformations, skill.transformations)
+		skill.transformations = transformations
+		skills[i] = skill
+	}
+
+	return CreateSupernaturalStatsWithoutValidation(skills)
+}
+
+// applyParameters returns new supernatural stats with the given parameters applied.
+// Every current skill and transformation must be referenced by ID: they can be renamed or leveled up,
+// but not removed. An empty name or description keeps the current one.
+// New skills and transformations are added through LearnSkill and UnlockTransformation.
+func (ss SupernaturalStats) applyParameters(params SupernaturalStatsParameters) (*SupernaturalStats, error) {
+	if len(params.Skills) < len(ss.skills) {
+		return nil, ErrSkillRemoved
+	}
+
+	if len(params.Skills) > len(ss.skills) {
+		return nil, ErrSkillNotFound
+	}
+
+	newStats := ss.copy()
+	seenSkills := make(map[string]struct{}, len(params.Skills))
+
+	for _, skillParam := range params.Skills {
+		skillIdx := newStats.findSkillIndex(skillParam.ID)
+		if skillIdx < 0 {
+			return nil, ErrSkillNotFound
+		}
+
+		if _, seen := seenSkills[skillParam.ID]; seen {
+			return nil, ErrDuplicateSkill
+		}
+		seenSkills[skillParam.ID] = struct{}{}
+
+		skill := &newStats.skills[skillIdx]
+		skill.name = valueOrCurrent(skillParam.Name, skill.name)
+		skill.description = valueOrCurrent(skillParam.Description, skill.description)
+
+		if len(skillParam.Transformations) < len(skill.transformations) {
+			return nil, ErrTransformationRemoved
+		}
+
+		if len(skillParam.Transformations) > len(skill.transformations) {
+			return nil, ErrTransformationNotFound
+		}
+
+		seenTransformations := make(map[string]struct{}, len(skillParam.Transformations))
+
+		for _, transformationParam := range skillParam.Transformations {
+			transformationIdx := skill.findTransformationIndex(transformationParam.ID)
+			if transformationIdx < 0 {
+				return nil, ErrTransformationNotFound
+			}
+
+			if _, seen := seenTransformations[transformationParam.ID]; seen {
+				return nil, ErrDuplicateTransformation
+			}
+			seenTransformations[transformationParam.ID] = struct{}{}
+
+			transformation := &skill.transformations[transformationIdx]
+			transformation.name = valueOrCurrent(transformationParam.Name, transformation.name)
+			transformation.description = valueOrCurrent(transformationParam.Description, transformation.description)
+			transformation.level = transformationParam.Level
+		}
+	}
+
+	return newStats, nil
+}
+
+// valueOrCurrent returns value, or current when value was omitted.
+func valueOrCurrent(value, current string) string {
+	if value == "" {
+		return current
+	}
+
+	return value
+}
+
 func CreateSupernaturalStatsWithoutValidation(skills []Skill) *SupernaturalStats {
 	return &SupernaturalStats{
 		skills: skills,
@@ -242,6 +392,7 @@ type PJ struct {
 	supernaturalStats *SupernaturalStats
 	xp                XP
 	spentXP           XP
+	ruleset           Ruleset // progression curves of the PJ campaign
 	uncommittedEvents []event.DomainEvent
 }
 
@@ -263,6 +414,7 @@ func (p *PJ) SpecialStats() SpecialStats             { return p.specialStats }
 func (p *PJ) SupernaturalStats() *SupernaturalStats  { return p.supernaturalStats }
 func (p *PJ) XP() XP                                 { return p.xp }
 func (p *PJ) SpentXP() XP                            { return p.spentXP }
+func (p *PJ) Ruleset() Ruleset                       { return p.ruleset }
 func (p *PJ) UncommittedEvents() []event.DomainEvent { return p.uncommittedEvents }
 
 // CreatePJWithoutValidation creates a PJ instance without validation.
@@ -285,6 +437,7 @@ func CreatePJWithoutValidation(
 	specialStats SpecialStats,
 	supernaturalStats *SupernaturalStats,
 	xp XP,
+	ruleset Ruleset,
 ) *PJ {
 	pj := &PJ{
 		id:                id,
@@ -303,6 +456,7 @@ func CreatePJWithoutValidation(
 		specialStats:      specialStats,
 		supernaturalStats: supernaturalStats,
 		xp:                xp,
+		ruleset:           ruleset,
 	}
 
 	pj.LoadRequiredXp()
@@ -318,6 +472,36 @@ func (pj *PJ) ConsumeXp(basic, special, supernatural uint) {
 	pj.uncommittedEvents = append(pj.uncommittedEvents, newXpConsumendEvent(pj, basic, special, supernatural))
 }
 
+// CanAmendXp checks that taking back XP from an amended session doesn't leave the PJ with negative XP,
+// which happens when the PJ has already spent it.
+func (pj *PJ) CanAmendXp(basic, special, supernatural int) error {
+	if int(pj.xp.basic)+basic < 0 || int(pj.xp.special)+special < 0 || int(pj.xp.supernatural)+supernatural < 0 {
+		return ErrXPAlreadySpent
+	}
+
+	return nil
+}
+
+// AmendXp applies the XP delta of an amended session.
+func (pj *PJ) AmendXp(basic, special, supernatural int) error {
+	if err := pj.CanAmendXp(basic, special, supernatural); err != nil {
+		return err
+	}
+
+	pj.xp.basic = uint(int(pj.xp.basic) + basic)
+	pj.xp.special = uint(int(pj.xp.special) + special)
+	pj.xp.supernatural = uint(int(pj.xp.supernatural) + supernatural)
+
+	pj.uncommittedEvents = append(pj.uncommittedEvents, newXpAmendedEvent(pj, basic, special, supernatural))
+
+	return nil
+}
+
+// RejectXpAmendment records that the XP delta of an amended session could not be applied.
+func (pj *PJ) RejectXpAmendment(basic, special, supernatural int, reason error) {
+	pj.uncommittedEvents = append(pj.uncommittedEvents, newXpAmendmentRejectedEvent(pj, basic, special, supernatural, reason))
+}
+
 type PhysicalParameters struct {
 	Strength   uint
 	Agility    uint
@@ -368,8 +552,18 @@ type SpecialStatsParameters struct {
 	EnergyTank uint
 }
 
+type TransformationParameters struct {
+	ID          string
+	Name        string
+	Description string
+	Level       uint
+}
+
 type SkillParameters struct {
-	Transformations []uint
+	ID              string
+	Name            string
+	Description     string
+	Transformations []TransformationParameters
 }
 
 type SupernaturalStatsParameters struct {
@@ -382,12 +576,20 @@ type PjUpdateParameters struct {
 	SupernaturalStats *SupernaturalStatsParameters // pointer because it's optional (only for supernatural PJs)
 }
 
-func (pj *PJ) UpdateStats(params PjUpdateParameters) error {
-	var basicSpentXP, specialSpentXP, supernaturalSpentXP uint
+// statsChange holds validated new stats together with the XP they cost.
+type statsChange struct {
+	basicSpentXP         uint
+	specialSpentXP       uint
+	supernaturalSpentXP  uint
+	newBasicStats        BasicStats
+	newSpecialStats      SpecialStats
+	newSupernaturalStats *SupernaturalStats
+}
 
-	previousBasicStats := pj.basicStats
-	previousSpecialStats := pj.specialStats
-	previousSupernaturalStats := pj.supernaturalStats
+// getStatsChange validates the given stats against the current ones and costs them with the available XP.
+// It doesn't modify the PJ.
+func (pj *PJ) getStatsChange(params PjUpdateParameters, houseRules HouseRules) (statsChange, error) {
+	var basicSpentXP, specialSpentXP, supernaturalSpentXP uint
 
 	newBasicStats := CreateBasicStatsWithoutValidation(
 		CreatePhysicalWithoutValidation(
@@ -415,15 +617,15 @@ func (pj *PJ) UpdateStats(params PjUpdateParameters) error {
 	)
 
 	if pj.basicStats.isHigherThan(newBasicStats) {
-		return ErrCannotReduceStats
+		return statsChange{}, ErrCannotReduceStats
 	}
 
-	newRequiredXP := newBasicStats.GetRequiredXP()
-	currentRequiredXP := pj.basicStats.GetRequiredXP()
+	newRequiredXP := newBasicStats.GetRequiredXP(pj.ruleset)
+	currentRequiredXP := pj.basicStats.GetRequiredXP(pj.ruleset)
 	basicSpentXP = newRequiredXP - currentRequiredXP
 
 	if basicSpentXP > pj.xp.basic {
-		return ErrInsufficientXP
+		return statsChange{}, ErrInsufficientXP
 	}
 
 	newSpecialStats := CreateSpecialStatsWithoutValidation(
@@ -447,83 +649,253 @@ func (pj *PJ) UpdateStats(params PjUpdateParameters) error {
 	)
 
 	if pj.specialStats.isHigherThan(newSpecialStats) {
-		return ErrCannotReduceStats
+		return statsChange{}, ErrCannotReduceStats
 	}
 
-	newRequiredXP = uint(newSpecialStats.GetRequiredXP())
-	currentRequiredXP = uint(pj.specialStats.GetRequiredXP())
+	newRequiredXP = newSpecialStats.GetRequiredXP(pj.ruleset)
+	currentRequiredXP = pj.specialStats.GetRequiredXP(pj.ruleset)
 	specialSpentXP = newRequiredXP - currentRequiredXP
 
 	if specialSpentXP > pj.xp.special {
-		return ErrInsufficientXP
+		return statsChange{}, ErrInsufficientXP
 	}
 
 	var newSupernaturalStats *SupernaturalStats
 	if pj.pjType == PJTypeSupernatural {
 		if params.SupernaturalStats == nil {
-			return ErrSupernaturalStatsRequired
+			return statsChange{}, ErrSupernaturalStatsRequired
 		}
 
-		skills := make([]Skill, len(params.SupernaturalStats.Skills))
-		for i, skillParam := range params.SupernaturalStats.Skills {
-			transformations := make([]uint, len(skillParam.Transformations))
-			copy(transformations, skillParam.Transformations)
-			skills[i] = CreateSkillWithoutValidation(transformations)
+		var err error
+		newSupernaturalStats, err = pj.supernaturalStats.applyParameters(*params.SupernaturalStats)
+		if err != nil {
+			return statsChange{}, err
 		}
 
-		newSupernaturalStats = CreateSupernaturalStatsWithoutValidation(skills)
-
 		if pj.supernaturalStats.isHigherThan(newSupernaturalStats) {
-			return ErrCannotReduceStats
+			return statsChange{}, ErrCannotReduceStats
 		}
 
-		newRequiredXP = uint(newSupernaturalStats.GetRequiredXP())
-		currentRequiredXP = uint(pj.supernaturalStats.GetRequiredXP())
+		newRequiredXP = newSupernaturalStats.GetRequiredXP(pj.ruleset)
+		currentRequiredXP = pj.supernaturalStats.GetRequiredXP(pj.ruleset)
 		supernaturalSpentXP = newRequiredXP - currentRequiredXP
 
 		if supernaturalSpentXP > pj.xp.supernatural {
-			return ErrInsufficientXP
+			return statsChange{}, ErrInsufficientXP
 		}
 	} else {
 		if params.SupernaturalStats != nil {
-			return ErrCannotUpdateSupernaturalStats
+			return statsChange{}, ErrCannotUpdateSupernaturalStats
 		}
 	}
 
+	newStatValues := newBasicStats.statValues()
+	for stat, value := range newSpecialStats.statValues() {
+		newStatValues[stat] = value
+	}
+
+	if err := houseRules.validateStats(pj.statValues(), newStatValues); err != nil {
+		return statsChange{}, err
+	}
+
+	return statsChange{
+		basicSpentXP:         basicSpentXP,
+		specialSpentXP:       specialSpentXP,
+		supernaturalSpentXP:  supernaturalSpentXP,
+		newBasicStats:        newBasicStats,
+		newSpecialStats:      newSpecialStats,
+		newSupernaturalStats: newSupernaturalStats,
+	}, nil
+}
+
+func (pj *PJ) applyStatsChange(change statsChange) {
+	pj.basicStats = change.newBasicStats
+	pj.specialStats = change.newSpecialStats
+	if change.newSupernaturalStats != nil {
+		pj.supernaturalStats = change.newSupernaturalStats
+	}
+	pj.xp.basic -= change.basicSpentXP
+	pj.xp.special -= change.specialSpentXP
+	pj.xp.supernatural -= change.supernaturalSpentXP
+
+	pj.LoadRequiredXp()
+}
+
+// buyInitialStats spends the PJ starting XP on its initial stats. Unlike UpdateStats it doesn't
+// register a stats update, the stats are part of the PJ creation.
+func (pj *PJ) buyInitialStats(params PjInitialStatsParameters, houseRules HouseRules) error {
+	updateParams := PjUpdateParameters{
+		BasicStats:   params.BasicStats,
+		SpecialStats: params.SpecialStats,
+	}
+
+	if pj.pjType == PJTypeSupernatural {
+		updateParams.SupernaturalStats = pj.getInitialSupernaturalStatsParameters(params.Skill)
+	}
+
+	change, err := pj.getStatsChange(updateParams, houseRules)
+	if err != nil {
+		return err
+	}
+
+	pj.applyStatsChange(change)
+
+	return nil
+}
+
+// getInitialSupernaturalStatsParameters maps the initial skill parameters onto the skill the PJ is created with.
+func (pj *PJ) getInitialSupernaturalStatsParameters(skillParams *InitialSkillParameters) *SupernaturalStatsParameters {
+	skill := pj.supernaturalStats.skills[0]
+	transformation := skill.transformations[0]
+
+	if skillParams == nil {
+		skillParams = &InitialSkillParameters{}
+	}
+
+	return &SupernaturalStatsParameters{
+		Skills: []SkillParameters{
+			{
+				ID:          skill.id,
+				Name:        skillParams.Name,
+				Description: skillParams.Description,
+				Transformations: []TransformationParameters{
+					{
+						ID:          transformation.id,
+						Name:        skillParams.TransformationName,
+						Description: skillParams.TransformationDescription,
+						Level:       skillParams.TransformationLevel,
+					},
+				},
+			},
+		},
+	}
+}
+
+// UpdateStats spends the available XP on the given stats, within the campaign house rules.
+func (pj *PJ) UpdateStats(params PjUpdateParameters, houseRules HouseRules) error {
+	change, err := pj.getStatsChange(params, houseRules)
+	if err != nil {
+		return err
+	}
+
 	statsUpdatedEvent := newStatsUpdatedEvent(
 		pj,
-		basicSpentXP,
-		specialSpentXP,
-		supernaturalSpentXP,
-		previousBasicStats,
-		previousSpecialStats,
-		previousSupernaturalStats,
-		newBasicStats,
-		newSpecialStats,
-		newSupernaturalStats,
+		change.basicSpentXP,
+		change.specialSpentXP,
+		change.supernaturalSpentXP,
+		pj.basicStats,
+		pj.specialStats,
+		pj.supernaturalStats,
+		change.newBasicStats,
+		change.newSpecialStats,
+		change.newSupernaturalStats,
 	)
 
-	pj.basicStats = newBasicStats
-	pj.specialStats = newSpecialStats
-	if newSupernaturalStats != nil {
-		pj.supernaturalStats = newSupernaturalStats
-	}
-	pj.xp.basic -= basicSpentXP
-	pj.xp.special -= specialSpentXP
-	pj.xp.supernatural -= supernaturalSpentXP
+	pj.applyStatsChange(change)
 
 	pj.uncommittedEvents = append(pj.uncommittedEvents, statsUpdatedEvent)
 
-	pj.LoadRequiredXp()
-
 	return nil
 }
 
 func (pj *PJ) LoadRequiredXp() {
-	pj.spentXP.basic = pj.basicStats.GetRequiredXP()
-	pj.spentXP.special = pj.specialStats.GetRequiredXP()
+	pj.spentXP.basic = pj.basicStats.GetRequiredXP(pj.ruleset)
+	pj.spentXP.special = pj.specialStats.GetRequiredXP(pj.ruleset)
 
 	if pj.pjType == PJTypeSupernatural {
-		pj.spentXP.supernatural = pj.supernaturalStats.GetRequiredXP()
+		pj.spentXP.supernatural = pj.supernaturalStats.GetRequiredXP(pj.ruleset)
 	}
 }
+
+type LearnSkillParameters struct {
+	Name        string
+	Description string
+	// First transformation of the skill, included in the skill unlock cost.
+	TransformationName        string
+	TransformationDescription string
+}
+
+// LearnSkill adds a new supernatural skill to the PJ, paying its unlock cost with supernatural XP.
+// The skill starts with a single transformation at level 0.
+func (pj *PJ) LearnSkill(params LearnSkillParameters, rules SkillRules, identificationService shared.IdentificationService) (Skill, error) {
+	if pj.pjType != PJTypeSupernatural {
+		return Skill{}, ErrCannotUpdateSupernaturalStats
+	}
+
+	if rules.MaxSkills() > 0 && uint(len(pj.supernaturalStats.skills)) >= rules.MaxSkills() {
+		return Skill{}, ErrSkillLimitReached
+	}
+
+	if rules.SkillUnlockCost() > pj.xp.supernatural {
+		return Skill{}, ErrInsufficientXP
+	}
+
+	skill := Skill{
+		id:          identificationService.GenerateID(),
+		name:        params.Name,
+		description: params.Description,
+		unlockCost:  rules.SkillUnlockCost(),
+		transformations: []Transformation{
+			{
+				id:          identificationService.GenerateID(),
+				name:        params.TransformationName,
+				description: params.TransformationDescription,
+			},
+		},
+	}
+
+	newSupernaturalStats := pj.supernaturalStats.copy()
+	newSupernaturalStats.skills = append(newSupernaturalStats.skills, skill)
+
+	pj.supernaturalStats = newSupernaturalStats
+	pj.xp.supernatural -= skill.unlockCost
+
+	pj.uncommittedEvents = append(pj.uncommittedEvents, newSkillLearnedEvent(pj, skill))
+
+	pj.LoadRequiredXp()
+
+	return skill, nil
+}
+
+type UnlockTransformationParameters struct {
+	SkillID     string
+	Name        string
+	Description string
+}
+
+// UnlockTransformation adds a new transformation tier at level 0 to one of the PJ skills,
+// paying its unlock cost with supernatural XP.
+func (pj *PJ) UnlockTransformation(params UnlockTransformationParameters, rules SkillRules, identificationService shared.IdentificationService) (Transformation, error) {
+	if pj.pjType != PJTypeSupernatural {
+		return Transformation{}, ErrCannotUpdateSupernaturalStats
+	}
+
+	skillIdx := pj.supernaturalStats.findSkillIndex(params.SkillID)
+	if skillIdx < 0 {
+		return Transformation{}, ErrSkillNotFound
+	}
+
+	if rules.TransformationUnlockCost() > pj.xp.supernatural {
+		return Transformation{}, ErrInsufficientXP
+	}
+
+	transformation := Transformation{
+		id:          identificationService.GenerateID(),
+		name:        params.Name,
+		description: params.Description,
+		unlockCost:  rules.TransformationUnlockCost(),
+	}
+
+	newSupernaturalStats := pj.supernaturalStats.copy()
+	skill := &newSupernaturalStats.skills[skillIdx]
+	skill.transformations = append(skill.transformations, transformation)
+
+	pj.supernaturalStats = newSupernaturalStats
+	pj.xp.supernatural -= transformation.unlockCost
+
+	pj.uncommittedEvents = append(pj.uncommittedEvents, newTransformationUnlockedEvent(pj, skill.id, transformation))
+
+	pj.LoadRequiredXp()
+
+	return transformation, nil
+}
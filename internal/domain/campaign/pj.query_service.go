@@ -1,7 +1,14 @@
 package campaign
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
+//go:generate mockgen -destination=../../../tests/mocks/pj_query_service_mock.go -package=mocks meye-core/internal/domain/campaign PjQueryService
 type PjQueryService interface {
 	GetPjsBasicInfo(ctx context.Context, userID string) ([]*PjBasicInfo, error)
+	GetCampaignPjsBasicInfo(ctx context.Context, campaignID string) ([]*PjBasicInfo, error)
+	// ListStatsUpgrades returns the stats the PJs bought after from and up to to, oldest first.
+	ListStatsUpgrades(ctx context.Context, pjIDs []string, from, to time.Time) ([]*StatsUpgrade, error)
 }
@@ -4,6 +4,10 @@ import "context"
 
 //go:generate mockgen -destination=../../../tests/mocks/pj_repository_mock.go -package=mocks meye-core/internal/domain/campaign PjRepository
 type PjRepository interface {
+	// Save writes the PJ as is, changes made to a loaded PJ go through Update instead.
 	Save(ctx context.Context, pj *PJ) error
 	FindByID(ctx context.Context, id string) (*PJ, error)
+	// Update locks the PJ, applies update and saves it in a single transaction, so the checks of
+	// update see the latest state. It returns nil when the PJ does not exist.
+	Update(ctx context.Context, id string, update func(pj *PJ) error) (*PJ, error)
 }
@@ -0,0 +1,217 @@
+package campaign_test
+
+import (
+	"meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	"meye-core/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newSupernaturalPJ(supernaturalXP uint, skills ...campaign.Skill) *campaign.PJ {
+	return campaign.CreatePJWithoutValidation(
+		"pj-id", "campaign-id", "user-id", "pj", 0, 0, 0, 0, 0, 0, 0,
+		campaign.PJTypeSupernatural,
+		campaign.BasicStats{},
+		campaign.SpecialStats{},
+		campaign.CreateSupernaturalStatsWithoutValidation(skills),
+		campaign.CreateXPWithoutValidation(0, 0, supernaturalXP),
+		campaign.DefaultRuleset(),
+	)
+}
+
+func newNamedSkill(id string, transformationIDs ...string) campaign.Skill {
+	transformations := make([]campaign.Transformation, len(transformationIDs))
+	for i, transformationID := range transformationIDs {
+		transformations[i] = campaign.CreateTransformationWithoutValidation(transformationID, "", "", 0, 0)
+	}
+
+	return campaign.CreateSkillWithoutValidation(id, "", "", 0, transformations)
+}
+
+func TestPJ_LearnSkill(t *testing.T) {
+	rules := campaign.NewSkillRules(300, 150, 2)
+
+	t.Run("learns a new skill paying its unlock cost", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		idService := mocks.NewMockIdentificationService(ctrl)
+		idService.EXPECT().GenerateID().Return("skill-2")
+		idService.EXPECT().GenerateID().Return("transformation-2")
+
+		pj := newSupernaturalPJ(500, newNamedSkill("skill-1", "transformation-1"))
+
+		skill, err := pj.LearnSkill(campaign.LearnSkillParameters{
+			Name:               "Pyrokinesis",
+			TransformationName: "Flame Cloak",
+		}, rules, idService)
+
+		require.NoError(t, err)
+		assert.Equal(t, "skill-2", skill.ID())
+		assert.Equal(t, "Pyrokinesis", skill.Name())
+		require.Len(t, skill.Transformations(), 1)
+		assert.Equal(t, "transformation-2", skill.Transformations()[0].ID())
+		assert.Len(t, pj.SupernaturalStats().Skills(), 2)
+		assert.Equal(t, uint(200), pj.XP().Supernatural())
+		assert.Equal(t, uint(300), pj.SpentXP().Supernatural())
+		require.Len(t, pj.UncommittedEvents(), 1)
+		assert.Equal(t, event.EventTypeSkillLearned, pj.UncommittedEvents()[0].Type())
+	})
+
+	t.Run("fails when the skills limit is reached", func(t *testing.T) {
+		pj := newSupernaturalPJ(500, newNamedSkill("skill-1", "t-1"), newNamedSkill("skill-2", "t-2"))
+
+		_, err := pj.LearnSkill(campaign.LearnSkillParameters{Name: "Telepathy"}, rules, nil)
+
+		assert.ErrorIs(t, err, campaign.ErrSkillLimitReached)
+	})
+
+	t.Run("fails without enough supernatural XP", func(t *testing.T) {
+		pj := newSupernaturalPJ(299, newNamedSkill("skill-1", "t-1"))
+
+		_, err := pj.LearnSkill(campaign.LearnSkillParameters{Name: "Telepathy"}, rules, nil)
+
+		assert.ErrorIs(t, err, campaign.ErrInsufficientXP)
+		assert.Len(t, pj.SupernaturalStats().Skills(), 1)
+	})
+}
+
+func TestPJ_UnlockTransformation(t *testing.T) {
+	rules := campaign.NewSkillRules(300, 150, 2)
+
+	t.Run("unlocks a new transformation paying its unlock cost", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		idService := mocks.NewMockIdentificationService(ctrl)
+		idService.EXPECT().GenerateID().Return("transformation-2")
+
+		pj := newSupernaturalPJ(150, newNamedSkill("skill-1", "transformation-1"))
+
+		transformation, err := pj.UnlockTransformation(campaign.UnlockTransformationParameters{
+			SkillID: "skill-1",
+			Name:    "Inferno",
+		}, rules, idService)
+
+		require.NoError(t, err)
+		assert.Equal(t, "transformation-2", transformation.ID())
+		assert.Len(t, pj.SupernaturalStats().Skills()[0].Transformations(), 2)
+		assert.Equal(t, uint(0), pj.XP().Supernatural())
+		assert.Equal(t, uint(150), pj.SpentXP().Supernatural())
+	})
+
+	t.Run("fails for an unknown skill", func(t *testing.T) {
+		pj := newSupernaturalPJ(150, newNamedSkill("skill-1", "transformation-1"))
+
+		_, err := pj.UnlockTransformation(campaign.UnlockTransformationParameters{SkillID: "unknown"}, rules, nil)
+
+		assert.ErrorIs(t, err, campaign.ErrSkillNotFound)
+	})
+}
+
+func TestPJ_UpdateStats_SupernaturalStructure(t *testing.T) {
+	tests := []struct {
+		name   string
+		skills []campaign.SkillParameters
+		want   error
+	}{
+		{
+			name:   "rejects removed skills",
+			skills: []campaign.SkillParameters{{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}}},
+			want:   campaign.ErrSkillRemoved,
+		},
+		{
+			name: "rejects removed transformations",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+				{ID: "skill-2", Transformations: []campaign.TransformationParameters{{ID: "t-2"}}},
+			},
+			want: campaign.ErrTransformationRemoved,
+		},
+		{
+			name: "rejects repeated skills",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+			},
+			want: campaign.ErrDuplicateSkill,
+		},
+		{
+			name: "rejects repeated transformations",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+				{ID: "skill-2", Transformations: []campaign.TransformationParameters{{ID: "t-2"}, {ID: "t-2"}}},
+			},
+			want: campaign.ErrDuplicateTransformation,
+		},
+		{
+			name: "rejects unknown skills",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+				{ID: "skill-3", Transformations: []campaign.TransformationParameters{{ID: "t-2"}, {ID: "t-3"}}},
+			},
+			want: campaign.ErrSkillNotFound,
+		},
+		{
+			name: "rejects added skills",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+				{ID: "skill-2", Transformations: []campaign.TransformationParameters{{ID: "t-2"}, {ID: "t-3"}}},
+				{ID: "skill-3"},
+			},
+			want: campaign.ErrSkillNotFound,
+		},
+		{
+			name: "rejects unknown transformations",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}}},
+				{ID: "skill-2", Transformations: []campaign.TransformationParameters{{ID: "t-2"}, {ID: "t-4"}}},
+			},
+			want: campaign.ErrTransformationNotFound,
+		},
+		{
+			name: "rejects added transformations",
+			skills: []campaign.SkillParameters{
+				{ID: "skill-1", Transformations: []campaign.TransformationParameters{{ID: "t-1"}, {ID: "t-4"}}},
+				{ID: "skill-2", Transformations: []campaign.TransformationParameters{{ID: "t-2"}, {ID: "t-3"}}},
+			},
+			want: campaign.ErrTransformationNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pj := newSupernaturalPJ(0, newNamedSkill("skill-1", "t-1"), newNamedSkill("skill-2", "t-2", "t-3"))
+
+			err := pj.UpdateStats(campaign.PjUpdateParameters{
+				SupernaturalStats: &campaign.SupernaturalStatsParameters{Skills: test.skills},
+			}, campaign.HouseRules{})
+
+			assert.ErrorIs(t, err, test.want)
+		})
+	}
+}
+
+func TestPJ_UpdateStats_SupernaturalNames(t *testing.T) {
+	skill := campaign.CreateSkillWithoutValidation("skill-1", "Pyrokinesis", "Controls fire", 0, []campaign.Transformation{
+		campaign.CreateTransformationWithoutValidation("t-1", "Flame Cloak", "Wreathed in flames", 0, 0),
+	})
+	pj := newSupernaturalPJ(0, skill)
+
+	err := pj.UpdateStats(campaign.PjUpdateParameters{
+		SupernaturalStats: &campaign.SupernaturalStatsParameters{Skills: []campaign.SkillParameters{
+			{
+				ID:              "skill-1",
+				Description:     "Controls fire and heat",
+				Transformations: []campaign.TransformationParameters{{ID: "t-1", Name: "Inferno"}},
+			},
+		}},
+	}, campaign.HouseRules{})
+
+	require.NoError(t, err)
+	updated := pj.SupernaturalStats().Skills()[0]
+	assert.Equal(t, "Pyrokinesis", updated.Name())
+	assert.Equal(t, "Controls fire and heat", updated.Description())
+	assert.Equal(t, "Inferno", updated.Transformations()[0].Name())
+	assert.Equal(t, "Wreathed in flames", updated.Transformations()[0].Description())
+}
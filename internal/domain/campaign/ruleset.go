@@ -0,0 +1,116 @@
+package campaign
+
+const DefaultRulesetName = "default"
+
+// Ruleset holds the parameters of the XP progression curves. Each campaign keeps its own copy,
+// so editing a ruleset definition doesn't reprice the PJs of existing campaigns.
+type Ruleset struct {
+	name string
+
+	basicLevelStep              uint
+	basicFirstLevelCost         uint
+	basicTalentedFirstLevelCost uint
+	lifeCost                    uint
+
+	specialLevelStep              uint
+	specialFirstLevelCost         uint
+	specialTalentedFirstLevelCost uint
+	energyTankCost                uint
+	energyTankTalentedCost        uint
+
+	supernaturalLevelStep      uint
+	supernaturalFirstLevelCost uint
+}
+
+type RulesetParameters struct {
+	Name string
+
+	BasicLevelStep              uint
+	BasicFirstLevelCost         uint
+	BasicTalentedFirstLevelCost uint
+	LifeCost                    uint
+
+	SpecialLevelStep              uint
+	SpecialFirstLevelCost         uint
+	SpecialTalentedFirstLevelCost uint
+	EnergyTankCost                uint
+	EnergyTankTalentedCost        uint
+
+	SupernaturalLevelStep      uint
+	SupernaturalFirstLevelCost uint
+}
+
+// DefaultRuleset returns the progression curves of the base system.
+func DefaultRuleset() Ruleset {
+	return CreateRulesetWithoutValidation(RulesetParameters{
+		Name:                          DefaultRulesetName,
+		BasicLevelStep:                10,
+		BasicFirstLevelCost:           3,
+		BasicTalentedFirstLevelCost:   1,
+		LifeCost:                      5,
+		SpecialLevelStep:              100,
+		SpecialFirstLevelCost:         2,
+		SpecialTalentedFirstLevelCost: 1,
+		EnergyTankCost:                10,
+		EnergyTankTalentedCost:        5,
+		SupernaturalLevelStep:         100,
+		SupernaturalFirstLevelCost:    1,
+	})
+}
+
+// NewRuleset validates that the ruleset is named and that level steps and first level costs
+// are positive, as the stat cost formula relies on both.
+func NewRuleset(params RulesetParameters) (Ruleset, error) {
+	if params.Name == "" {
+		return Ruleset{}, ErrInvalidRuleset
+	}
+
+	positive := []uint{
+		params.BasicLevelStep,
+		params.BasicFirstLevelCost,
+		params.BasicTalentedFirstLevelCost,
+		params.SpecialLevelStep,
+		params.SpecialFirstLevelCost,
+		params.SpecialTalentedFirstLevelCost,
+		params.SupernaturalLevelStep,
+		params.SupernaturalFirstLevelCost,
+	}
+
+	for _, value := range positive {
+		if value == 0 {
+			return Ruleset{}, ErrInvalidRuleset
+		}
+	}
+
+	return CreateRulesetWithoutValidation(params), nil
+}
+
+func CreateRulesetWithoutValidation(params RulesetParameters) Ruleset {
+	return Ruleset{
+		name:                          params.Name,
+		basicLevelStep:                params.BasicLevelStep,
+		basicFirstLevelCost:           params.BasicFirstLevelCost,
+		basicTalentedFirstLevelCost:   params.BasicTalentedFirstLevelCost,
+		lifeCost:                      params.LifeCost,
+		specialLevelStep:              params.SpecialLevelStep,
+		specialFirstLevelCost:         params.SpecialFirstLevelCost,
+		specialTalentedFirstLevelCost: params.SpecialTalentedFirstLevelCost,
+		energyTankCost:                params.EnergyTankCost,
+		energyTankTalentedCost:        params.EnergyTankTalentedCost,
+		supernaturalLevelStep:         params.SupernaturalLevelStep,
+		supernaturalFirstLevelCost:    params.SupernaturalFirstLevelCost,
+	}
+}
+
+func (r Ruleset) Name() string                        { return r.name }
+func (r Ruleset) BasicLevelStep() uint                { return r.basicLevelStep }
+func (r Ruleset) BasicFirstLevelCost() uint           { return r.basicFirstLevelCost }
+func (r Ruleset) BasicTalentedFirstLevelCost() uint   { return r.basicTalentedFirstLevelCost }
+func (r Ruleset) LifeCost() uint                      { return r.lifeCost }
+func (r Ruleset) SpecialLevelStep() uint              { return r.specialLevelStep }
+func (r Ruleset) SpecialFirstLevelCost() uint         { return r.specialFirstLevelCost }
+func (r Ruleset) SpecialTalentedFirstLevelCost() uint { return r.specialTalentedFirstLevelCost }
+func (r Ruleset) EnergyTankCost() uint                { return r.energyTankCost }
+func (r Ruleset) EnergyTankTalentedCost() uint        { return r.energyTankTalentedCost }
+func (r Ruleset) SupernaturalLevelStep() uint         { return r.supernaturalLevelStep }
+func (r Ruleset) SupernaturalFirstLevelCost() uint    { return r.supernaturalFirstLevelCost }
@@ -0,0 +1,11 @@
+package campaign
+
+import "context"
+
+// RulesetRepository provides the rulesets a campaign can be created with.
+//
+//go:generate mockgen -destination=../../../tests/mocks/ruleset_repository_mock.go -package=mocks meye-core/internal/domain/campaign RulesetRepository
+type RulesetRepository interface {
+	FindByName(ctx context.Context, name string) (*Ruleset, error)
+	FindAll(ctx context.Context) ([]Ruleset, error)
+}
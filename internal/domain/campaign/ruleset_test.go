@@ -0,0 +1,70 @@
+package campaign_test
+
+import (
+	"meye-core/internal/domain/campaign"
+	"meye-core/tests/data"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRuleset(t *testing.T) {
+	valid := campaign.RulesetParameters{
+		Name:                          "heroic",
+		BasicLevelStep:                10,
+		BasicFirstLevelCost:           2,
+		BasicTalentedFirstLevelCost:   1,
+		LifeCost:                      3,
+		SpecialLevelStep:              100,
+		SpecialFirstLevelCost:         1,
+		SpecialTalentedFirstLevelCost: 1,
+		EnergyTankCost:                6,
+		EnergyTankTalentedCost:        3,
+		SupernaturalLevelStep:         100,
+		SupernaturalFirstLevelCost:    1,
+	}
+
+	t.Run("accepts positive level steps and first level costs", func(t *testing.T) {
+		ruleset, err := campaign.NewRuleset(valid)
+
+		require.NoError(t, err)
+		assert.Equal(t, "heroic", ruleset.Name())
+	})
+
+	t.Run("rejects unnamed rulesets", func(t *testing.T) {
+		params := valid
+		params.Name = ""
+
+		_, err := campaign.NewRuleset(params)
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidRuleset)
+	})
+
+	t.Run("rejects zero level steps", func(t *testing.T) {
+		params := valid
+		params.SpecialLevelStep = 0
+
+		_, err := campaign.NewRuleset(params)
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidRuleset)
+	})
+
+	t.Run("rejects zero first level costs", func(t *testing.T) {
+		params := valid
+		params.BasicTalentedFirstLevelCost = 0
+
+		_, err := campaign.NewRuleset(params)
+
+		assert.ErrorIs(t, err, campaign.ErrInvalidRuleset)
+	})
+
+	t.Run("costs stats with its own curves", func(t *testing.T) {
+		ruleset, err := campaign.NewRuleset(valid)
+		require.NoError(t, err)
+
+		basicStats := data.BasicStatsWithNoTalents()
+
+		assert.Less(t, basicStats.GetRequiredXP(ruleset), basicStats.GetRequiredXP(campaign.DefaultRuleset()))
+	})
+}
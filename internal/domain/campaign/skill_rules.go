@@ -0,0 +1,20 @@
+package campaign
+
+// SkillRules holds the configurable costs and limits for learning supernatural skills.
+type SkillRules struct {
+	skillUnlockCost          uint
+	transformationUnlockCost uint
+	maxSkills                uint // 0 means no limit
+}
+
+func (r SkillRules) SkillUnlockCost() uint          { return r.skillUnlockCost }
+func (r SkillRules) TransformationUnlockCost() uint { return r.transformationUnlockCost }
+func (r SkillRules) MaxSkills() uint                { return r.maxSkills }
+
+func NewSkillRules(skillUnlockCost, transformationUnlockCost, maxSkills uint) SkillRules {
+	return SkillRules{
+		skillUnlockCost:          skillUnlockCost,
+		transformationUnlockCost: transformationUnlockCost,
+		maxSkills:                maxSkills,
+	}
+}
@@ -0,0 +1,40 @@
+package campaign
+
+import "time"
+
+// StatChange is the level change of one stat, named by its path, e.g. "physical.strength".
+type StatChange struct {
+	stat     string
+	previous uint
+	current  uint
+}
+
+func (c StatChange) Stat() string   { return c.stat }
+func (c StatChange) Previous() uint { return c.previous }
+func (c StatChange) Current() uint  { return c.current }
+
+func CreateStatChange(stat string, previous, current uint) StatChange {
+	return StatChange{stat: stat, previous: previous, current: current}
+}
+
+// StatsUpgrade is a stats purchase of a PJ, read from its stats_updated event.
+type StatsUpgrade struct {
+	pjID       string
+	spentXP    XP
+	changes    []StatChange
+	occurredAt time.Time
+}
+
+func (u *StatsUpgrade) PjID() string          { return u.pjID }
+func (u *StatsUpgrade) SpentXP() XP           { return u.spentXP }
+func (u *StatsUpgrade) Changes() []StatChange { return u.changes }
+func (u *StatsUpgrade) OccurredAt() time.Time { return u.occurredAt }
+
+func CreateStatsUpgrade(pjID string, spentXP XP, changes []StatChange, occurredAt time.Time) *StatsUpgrade {
+	return &StatsUpgrade{
+		pjID:       pjID,
+		spentXP:    spentXP,
+		changes:    changes,
+		occurredAt: occurredAt,
+	}
+}
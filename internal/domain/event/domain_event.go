@@ -6,26 +6,52 @@ type EventType string
 
 // User Events.
 const (
-	EventTypeUserCreated EventType = "user_created"
+	EventTypeUserCreated         EventType = "user_created"
+	EventTypeUserTokensRevoked   EventType = "user_tokens_revoked"
+	EventTypePasswordChanged     EventType = "password_changed"
+	EventTypePasswordResetIssued EventType = "password_reset_issued"
+	EventTypeUserDisabled        EventType = "user_disabled"
+	EventTypeUserEnabled         EventType = "user_enabled"
+	EventTypeUserRolesChanged    EventType = "user_roles_changed"
+	EventTypeUserDeleted         EventType = "user_deleted"
+	EventTypeLoginFailed         EventType = "login_failed"
+	EventTypeAccountLocked       EventType = "account_locked"
+	EventTypeTwoFactorEnabled    EventType = "two_factor_enabled"
+	EventTypeTwoFactorDisabled   EventType = "two_factor_disabled"
+	EventTypeTwoFactorCleared    EventType = "two_factor_cleared"
+	EventTypeRecoveryCodeUsed    EventType = "recovery_code_used"
+	EventTypeAPIKeyCreated       EventType = "api_key_created"
+	EventTypeAPIKeyRevoked       EventType = "api_key_revoked"
 )
 
 // Campaign Events.
 const (
-	EventTypeCampaignCreated EventType = "campaign_created"
-	EventTypeUserInvited     EventType = "user_invited"
-	EventTypePjAdded         EventType = "pj_added"
+	EventTypeCampaignCreated   EventType = "campaign_created"
+	EventTypeUserInvited       EventType = "user_invited"
+	EventTypePjAdded           EventType = "pj_added"
+	EventTypeHouseRulesUpdated EventType = "house_rules_updated"
 )
 
 // Session Events.
 const (
-	EventTypeSessionCreated EventType = "session_created"
-	EventTypeXPAssigned     EventType = "xp_assigned"
+	EventTypeSessionCreated       EventType = "session_created"
+	EventTypeSessionFinalized     EventType = "session_finalized"
+	EventTypeSessionScheduled     EventType = "session_scheduled"
+	EventTypeSessionRescheduled   EventType = "session_rescheduled"
+	EventTypeSessionStarted       EventType = "session_started"
+	EventTypeRSVPChanged          EventType = "rsvp_changed"
+	EventTypeXPAssigned           EventType = "xp_assigned"
+	EventTypeXPAssignationAmended EventType = "xp_assignation_amended"
 )
 
 // PJ Events.
 const (
-	EventTypeXpConsumed   EventType = "xp_consumed"
-	EventTypeStatsUpdated EventType = "stats_updated"
+	EventTypeXpConsumed             EventType = "xp_consumed"
+	EventTypeXpAmended              EventType = "xp_amended"
+	EventTypeXpAmendmentRejected    EventType = "xp_amendment_rejected"
+	EventTypeStatsUpdated           EventType = "stats_updated"
+	EventTypeSkillLearned           EventType = "skill_learned"
+	EventTypeTransformationUnlocked EventType = "transformation_unlocked"
 )
 
 type AggregateType string
@@ -35,6 +61,8 @@ const (
 	AggregateTypeCampaign AggregateType = "campaign"
 	AggregateTypePJ       AggregateType = "pj"
 	AggregateTypeUser     AggregateType = "user"
+	AggregateTypeLogin    AggregateType = "login" // attempts of a username or an IP address, which may match no user
+	AggregateTypeAPIKey   AggregateType = "api_key"
 )
 
 type DomainEvent interface {
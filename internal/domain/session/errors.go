@@ -0,0 +1,16 @@
+package session
+
+import "errors"
+
+var (
+	ErrInvalidCursor           = errors.New("ERR_INVALID_CURSOR")
+	ErrSessionFinalized        = errors.New("ERR_SESSION_FINALIZED")
+	ErrSessionDraft            = errors.New("ERR_SESSION_DRAFT")
+	ErrSessionPlanned          = errors.New("ERR_SESSION_PLANNED")
+	ErrSessionNotPlanned       = errors.New("ERR_SESSION_NOT_PLANNED")
+	ErrSessionNotStarted       = errors.New("ERR_SESSION_NOT_STARTED")
+	ErrInvalidSchedule         = errors.New("ERR_INVALID_SCHEDULE")
+	ErrInvalidRSVP             = errors.New("ERR_INVALID_RSVP")
+	ErrEmptyXPAssignation      = errors.New("ERR_EMPTY_XP_ASSIGNATION")
+	ErrDuplicatedXPAssignation = errors.New("ERR_DUPLICATED_XP_ASSIGNATION")
+)
@@ -45,6 +45,48 @@ func newSessionCreatedEvent(s *Session) SessionCreatedEvent {
 	}
 }
 
+// Compile-time check to ensure SessionFinalizedEvent implements the DomainEvent interface
+var _ event.DomainEvent = (*SessionFinalizedEvent)(nil)
+
+type SessionFinalizedEvent struct {
+	id         string
+	sessionID  string
+	createdAt  time.Time
+	occurredAt time.Time
+
+	campaignID string
+	attendees  []string
+}
+
+func (e SessionFinalizedEvent) ID() string            { return e.id }
+func (e SessionFinalizedEvent) AggregateID() string   { return e.sessionID }
+func (e SessionFinalizedEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e SessionFinalizedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e SessionFinalizedEvent) Type() event.EventType              { return event.EventTypeSessionFinalized }
+func (e SessionFinalizedEvent) AggregateType() event.AggregateType { return event.AggregateTypeSession }
+
+func (e SessionFinalizedEvent) CampaignID() string  { return e.campaignID }
+func (e SessionFinalizedEvent) Attendees() []string { return e.attendees }
+
+func (e SessionFinalizedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"campaign_id": e.campaignID,
+		"attendees":   e.attendees,
+	}
+}
+
+func newSessionFinalizedEvent(s *Session, finalizedAt time.Time) SessionFinalizedEvent {
+	return SessionFinalizedEvent{
+		id:         uuid.NewString(),
+		sessionID:  s.id,
+		createdAt:  time.Now(),
+		occurredAt: finalizedAt,
+		campaignID: s.campaignID,
+		attendees:  s.attendees,
+	}
+}
+
 type AssignedXP struct {
 	basic        uint
 	special      uint
@@ -62,6 +104,8 @@ type XPAssignedEvent struct {
 	createdAt  time.Time
 	occurredAt time.Time
 	assignedXP AssignedXP
+	kind       XPKind
+	reason     string
 }
 
 // Compile-time check to ensure XPAssignedEvent implements the DomainEvent interface
@@ -76,6 +120,8 @@ func (e XPAssignedEvent) AggregateType() event.AggregateType { return event.Aggr
 
 func (e XPAssignedEvent) SessionID() string      { return e.sessionID }
 func (e XPAssignedEvent) AssignedXP() AssignedXP { return e.assignedXP }
+func (e XPAssignedEvent) Kind() XPKind           { return e.kind }
+func (e XPAssignedEvent) Reason() string         { return e.reason }
 
 func (e XPAssignedEvent) GetSerializedData() map[string]interface{} {
 	return map[string]interface{}{
@@ -85,20 +131,252 @@ func (e XPAssignedEvent) GetSerializedData() map[string]interface{} {
 			"special":      e.assignedXP.Special(),
 			"supernatural": e.assignedXP.Supernatural(),
 		},
+		"kind":   e.kind,
+		"reason": e.reason,
 	}
 }
 
-func newXPAssignedEvent(xpAssignation XPAssignation, sessionID string, sessionCreatedAt time.Time) XPAssignedEvent {
+func newXPAssignedEvent(xpAssignation XPAssignation, sessionID string, sessionFinalizedAt time.Time) XPAssignedEvent {
 	return XPAssignedEvent{
 		id:         uuid.NewString(),
 		sessionID:  sessionID,
 		pjID:       xpAssignation.PjID(),
 		createdAt:  time.Now(),
-		occurredAt: sessionCreatedAt,
+		occurredAt: sessionFinalizedAt,
 		assignedXP: AssignedXP{
 			basic:        xpAssignation.Basic(),
 			special:      xpAssignation.Special(),
 			supernatural: xpAssignation.SuperNatural(),
 		},
+		kind:   xpAssignation.Kind(),
+		reason: xpAssignation.Reason(),
+	}
+}
+
+type XPAssignationAmendedEvent struct {
+	id         string
+	sessionID  string
+	createdAt  time.Time
+	occurredAt time.Time
+	delta      XPDelta
+}
+
+// Compile-time check to ensure XPAssignationAmendedEvent implements the DomainEvent interface
+var _ event.DomainEvent = (*XPAssignationAmendedEvent)(nil)
+
+func (e XPAssignationAmendedEvent) ID() string            { return e.id }
+func (e XPAssignationAmendedEvent) AggregateID() string   { return e.delta.pjID }
+func (e XPAssignationAmendedEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e XPAssignationAmendedEvent) OccurredAt() time.Time { return e.occurredAt }
+func (e XPAssignationAmendedEvent) Type() event.EventType {
+	return event.EventTypeXPAssignationAmended
+}
+func (e XPAssignationAmendedEvent) AggregateType() event.AggregateType {
+	return event.AggregateTypePJ
+}
+
+func (e XPAssignationAmendedEvent) SessionID() string { return e.sessionID }
+func (e XPAssignationAmendedEvent) Delta() XPDelta    { return e.delta }
+
+func (e XPAssignationAmendedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"session_id": e.sessionID,
+		"xp_delta": map[string]interface{}{
+			"basic":        e.delta.Basic(),
+			"special":      e.delta.Special(),
+			"supernatural": e.delta.Supernatural(),
+		},
+	}
+}
+
+func newXPAssignationAmendedEvent(delta XPDelta, sessionID string) XPAssignationAmendedEvent {
+	now := time.Now()
+
+	return XPAssignationAmendedEvent{
+		id:         uuid.NewString(),
+		sessionID:  sessionID,
+		createdAt:  now,
+		occurredAt: now,
+		delta:      delta,
+	}
+}
+
+// Compile-time check to ensure SessionScheduledEvent implements the DomainEvent interface
+var _ event.DomainEvent = (*SessionScheduledEvent)(nil)
+
+type SessionScheduledEvent struct {
+	id         string
+	sessionID  string
+	createdAt  time.Time
+	occurredAt time.Time
+
+	campaignID string
+	schedule   Schedule
+}
+
+func (e SessionScheduledEvent) ID() string            { return e.id }
+func (e SessionScheduledEvent) AggregateID() string   { return e.sessionID }
+func (e SessionScheduledEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e SessionScheduledEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e SessionScheduledEvent) Type() event.EventType              { return event.EventTypeSessionScheduled }
+func (e SessionScheduledEvent) AggregateType() event.AggregateType { return event.AggregateTypeSession }
+
+func (e SessionScheduledEvent) CampaignID() string { return e.campaignID }
+func (e SessionScheduledEvent) Schedule() Schedule { return e.schedule }
+
+func (e SessionScheduledEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"campaign_id":      e.campaignID,
+		"starts_at":        e.schedule.startsAt,
+		"duration_minutes": int(e.schedule.duration.Minutes()),
+		"location":         e.schedule.location,
+	}
+}
+
+func newSessionScheduledEvent(s *Session) SessionScheduledEvent {
+	return SessionScheduledEvent{
+		id:         uuid.NewString(),
+		sessionID:  s.id,
+		createdAt:  time.Now(),
+		occurredAt: s.createdAt,
+		campaignID: s.campaignID,
+		schedule:   *s.schedule,
+	}
+}
+
+// Compile-time check to ensure SessionRescheduledEvent implements the DomainEvent interface
+var _ event.DomainEvent = (*SessionRescheduledEvent)(nil)
+
+type SessionRescheduledEvent struct {
+	id         string
+	sessionID  string
+	createdAt  time.Time
+	occurredAt time.Time
+
+	campaignID       string
+	schedule         Schedule
+	previousSchedule Schedule
+}
+
+func (e SessionRescheduledEvent) ID() string            { return e.id }
+func (e SessionRescheduledEvent) AggregateID() string   { return e.sessionID }
+func (e SessionRescheduledEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e SessionRescheduledEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e SessionRescheduledEvent) Type() event.EventType {
+	return event.EventTypeSessionRescheduled
+}
+func (e SessionRescheduledEvent) AggregateType() event.AggregateType {
+	return event.AggregateTypeSession
+}
+
+func (e SessionRescheduledEvent) CampaignID() string         { return e.campaignID }
+func (e SessionRescheduledEvent) Schedule() Schedule         { return e.schedule }
+func (e SessionRescheduledEvent) PreviousSchedule() Schedule { return e.previousSchedule }
+
+func (e SessionRescheduledEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"campaign_id":        e.campaignID,
+		"starts_at":          e.schedule.startsAt,
+		"duration_minutes":   int(e.schedule.duration.Minutes()),
+		"location":           e.schedule.location,
+		"sequence":           e.schedule.sequence,
+		"previous_starts_at": e.previousSchedule.startsAt,
+	}
+}
+
+func newSessionRescheduledEvent(s *Session, previous Schedule) SessionRescheduledEvent {
+	now := time.Now()
+
+	return SessionRescheduledEvent{
+		id:               uuid.NewString(),
+		sessionID:        s.id,
+		createdAt:        now,
+		occurredAt:       now,
+		campaignID:       s.campaignID,
+		schedule:         *s.schedule,
+		previousSchedule: previous,
+	}
+}
+
+// Compile-time check to ensure RSVPChangedEvent implements the DomainEvent interface
+var _ event.DomainEvent = (*RSVPChangedEvent)(nil)
+
+type RSVPChangedEvent struct {
+	id         string
+	sessionID  string
+	createdAt  time.Time
+	occurredAt time.Time
+
+	campaignID string
+	rsvp       RSVP
+}
+
+func (e RSVPChangedEvent) ID() string            { return e.id }
+func (e RSVPChangedEvent) AggregateID() string   { return e.sessionID }
+func (e RSVPChangedEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e RSVPChangedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e RSVPChangedEvent) Type() event.EventType              { return event.EventTypeRSVPChanged }
+func (e RSVPChangedEvent) AggregateType() event.AggregateType { return event.AggregateTypeSession }
+
+func (e RSVPChangedEvent) CampaignID() string { return e.campaignID }
+func (e RSVPChangedEvent) RSVP() RSVP         { return e.rsvp }
+
+func (e RSVPChangedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"campaign_id": e.campaignID,
+		"user_id":     e.rsvp.userID,
+		"response":    string(e.rsvp.response),
+	}
+}
+
+func newRSVPChangedEvent(s *Session, rsvp RSVP) RSVPChangedEvent {
+	return RSVPChangedEvent{
+		id:         uuid.NewString(),
+		sessionID:  s.id,
+		createdAt:  time.Now(),
+		occurredAt: rsvp.updatedAt,
+		campaignID: s.campaignID,
+		rsvp:       rsvp,
+	}
+}
+
+// Compile-time check to ensure SessionStartedEvent implements the DomainEvent interface
+var _ event.DomainEvent = (*SessionStartedEvent)(nil)
+
+type SessionStartedEvent struct {
+	id         string
+	sessionID  string
+	createdAt  time.Time
+	occurredAt time.Time
+
+	campaignID string
+}
+
+func (e SessionStartedEvent) ID() string            { return e.id }
+func (e SessionStartedEvent) AggregateID() string   { return e.sessionID }
+func (e SessionStartedEvent) CreatedAt() time.Time  { return e.createdAt }
+func (e SessionStartedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+func (e SessionStartedEvent) Type() event.EventType              { return event.EventTypeSessionStarted }
+func (e SessionStartedEvent) AggregateType() event.AggregateType { return event.AggregateTypeSession }
+
+func (e SessionStartedEvent) CampaignID() string { return e.campaignID }
+
+func (e SessionStartedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"campaign_id": e.campaignID,
+	}
+}
+
+func newSessionStartedEvent(s *Session, startedAt time.Time) SessionStartedEvent {
+	return SessionStartedEvent{
+		id:         uuid.NewString(),
+		sessionID:  s.id,
+		createdAt:  time.Now(),
+		occurredAt: startedAt,
+		campaignID: s.campaignID,
 	}
 }
@@ -20,60 +20,232 @@ func NewXPAmounts(basic, special, superNatural uint) XPAmounts {
 	}
 }
 
+func (xpa XPAmounts) isZero() bool {
+	return xpa.basic == 0 && xpa.special == 0 && xpa.superNatural == 0
+}
+
+type XPKind string
+
+const (
+	XPKindAttendance XPKind = "attendance" // base XP every attendee earns
+	XPKindBonus      XPKind = "bonus"      // XP the master gives to a PJ on top
+)
+
+const attendanceReason = "attendance"
+
 type XPAssignation struct {
 	pjID    string
+	kind    XPKind
 	amounts XPAmounts
 	reason  string
 }
 
+// NewXPAssignation creates a bonus line. Attendance lines are generated by the session from its attendees.
 func NewXPAssignation(pjID string, basic, special, superNatural uint, reason string) XPAssignation {
+	return CreateXPAssignationWithoutValidation(pjID, XPKindBonus, basic, special, superNatural, reason)
+}
+
+func CreateXPAssignationWithoutValidation(pjID string, kind XPKind, basic, special, superNatural uint, reason string) XPAssignation {
 	return XPAssignation{
 		pjID:    pjID,
+		kind:    kind,
 		amounts: NewXPAmounts(basic, special, superNatural),
 		reason:  reason,
 	}
 }
 
+type Status string
+
+const (
+	StatusPlanned   Status = "planned"
+	StatusDraft     Status = "draft"
+	StatusFinalized Status = "finalized"
+)
+
 type Session struct {
 	id               string
 	campaignID       string
 	summary          string
+	status           Status
 	createdAt        time.Time
+	attendees        []string  // IDs of the PJs that played the session
+	attendanceXP     XPAmounts // base XP of every attendee, taken from the campaign when the session is created
 	xpAssignations   []XPAssignation
+	schedule         *Schedule // only for sessions planned ahead
+	rsvps            []RSVP
 	uncommitedEvents []event.DomainEvent
 }
 
-func NewSession(masterID, campID string, summary string, xpAssignations []XPAssignation, idServ shared.IdentificationService) (*Session, error) {
+// NewDraftSession creates a session the master can keep editing during play. Its XP isn't
+// handed out until the session is finalized.
+func NewDraftSession(
+	campID, summary string,
+	attendees []string,
+	attendanceXP XPAmounts,
+	bonuses []XPAssignation,
+	idServ shared.IdentificationService,
+) (*Session, error) {
 	s := &Session{
-		id:             idServ.GenerateID(),
-		campaignID:     campID,
-		summary:        summary,
-		createdAt:      time.Now(),
-		xpAssignations: xpAssignations,
+		id:           idServ.GenerateID(),
+		campaignID:   campID,
+		summary:      summary,
+		status:       StatusDraft,
+		createdAt:    time.Now(),
+		attendanceXP: attendanceXP,
+	}
+
+	if err := s.assignXP(attendees, bonuses); err != nil {
+		return nil, err
 	}
 
 	createdEvent := newSessionCreatedEvent(s)
 	s.addUncommitedEvent(createdEvent)
 
-	// Create XPAssignedEvent for each XP assignation
-	for _, xpAssignation := range xpAssignations {
-		xpAssignedEvent := newXPAssignedEvent(xpAssignation, s.id, s.createdAt)
-		s.addUncommitedEvent(xpAssignedEvent)
+	return s, nil
+}
+
+func NewSession(
+	masterID, campID, summary string,
+	attendees []string,
+	attendanceXP XPAmounts,
+	bonuses []XPAssignation,
+	idServ shared.IdentificationService,
+) (*Session, error) {
+	s, err := NewDraftSession(campID, summary, attendees, attendanceXP, bonuses, idServ)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Finalize(); err != nil {
+		return nil, err
 	}
 
 	return s, nil
 }
 
-func CreateSessionWithoutValidation(ID, campaignID, summary string, createdAt time.Time, xpAssignations []XPAssignation) *Session {
+func CreateSessionWithoutValidation(
+	ID, campaignID, summary string,
+	status Status,
+	createdAt time.Time,
+	attendees []string,
+	attendanceXP XPAmounts,
+	xpAssignations []XPAssignation,
+	schedule *Schedule,
+	rsvps []RSVP,
+) *Session {
 	return &Session{
 		id:             ID,
 		campaignID:     campaignID,
 		summary:        summary,
+		status:         status,
 		createdAt:      createdAt,
+		attendees:      attendees,
+		attendanceXP:   attendanceXP,
 		xpAssignations: xpAssignations,
+		schedule:       schedule,
+		rsvps:          rsvps,
 	}
 }
 
+// UpdateDraft replaces the content of a planned or draft session. No XP is handed out yet, so
+// there is nothing to compensate.
+func (s *Session) UpdateDraft(summary string, attendees []string, bonuses []XPAssignation) error {
+	if s.status == StatusFinalized {
+		return ErrSessionFinalized
+	}
+
+	if err := s.assignXP(attendees, bonuses); err != nil {
+		return err
+	}
+
+	s.summary = summary
+
+	return nil
+}
+
+func (s *Session) assignXP(attendees []string, bonuses []XPAssignation) error {
+	xpAssignations, err := s.MergeXPAssignations(attendees, bonuses)
+	if err != nil {
+		return err
+	}
+
+	s.attendees = attendees
+	s.xpAssignations = xpAssignations
+
+	return nil
+}
+
+// MergeXPAssignations validates the bonus lines and returns the XP lines the session would have
+// with the given attendees and bonuses, without changing it. Every PJ gets at most one bonus
+// line and bonus lines can't be empty.
+func (s *Session) MergeXPAssignations(attendees []string, bonuses []XPAssignation) ([]XPAssignation, error) {
+	pjIDs := make(map[string]struct{}, len(bonuses))
+	for _, bonus := range bonuses {
+		if bonus.amounts.isZero() {
+			return nil, ErrEmptyXPAssignation
+		}
+
+		if _, ok := pjIDs[bonus.pjID]; ok {
+			return nil, ErrDuplicatedXPAssignation
+		}
+		pjIDs[bonus.pjID] = struct{}{}
+	}
+
+	return s.mergeAttendanceXP(attendees, bonuses), nil
+}
+
+// mergeAttendanceXP returns the XP lines of the session: an attendance line with the base XP for
+// every attendee, followed by the bonus lines. Without base XP there are no attendance lines.
+func (s *Session) mergeAttendanceXP(attendees []string, bonuses []XPAssignation) []XPAssignation {
+	xpAssignations := make([]XPAssignation, 0, len(attendees)+len(bonuses))
+
+	if !s.attendanceXP.isZero() {
+		seen := make(map[string]struct{}, len(attendees))
+		for _, pjID := range attendees {
+			if _, ok := seen[pjID]; ok {
+				continue
+			}
+			seen[pjID] = struct{}{}
+
+			xpAssignations = append(xpAssignations, XPAssignation{
+				pjID:    pjID,
+				kind:    XPKindAttendance,
+				amounts: s.attendanceXP,
+				reason:  attendanceReason,
+			})
+		}
+	}
+
+	for _, bonus := range bonuses {
+		bonus.kind = XPKindBonus
+		xpAssignations = append(xpAssignations, bonus)
+	}
+
+	return xpAssignations
+}
+
+// Finalize closes the draft and hands out its XP with an XPAssignedEvent per assignation.
+func (s *Session) Finalize() error {
+	switch s.status {
+	case StatusPlanned:
+		return ErrSessionPlanned
+	case StatusFinalized:
+		return ErrSessionFinalized
+	}
+
+	s.status = StatusFinalized
+
+	finalizedAt := time.Now()
+	s.addUncommitedEvent(newSessionFinalizedEvent(s, finalizedAt))
+
+	for _, xpAssignation := range s.xpAssignations {
+		xpAssignedEvent := newXPAssignedEvent(xpAssignation, s.id, finalizedAt)
+		s.addUncommitedEvent(xpAssignedEvent)
+	}
+
+	return nil
+}
+
 func (s *Session) addUncommitedEvent(e event.DomainEvent) {
 	s.uncommitedEvents = append(s.uncommitedEvents, e)
 }
@@ -81,11 +253,19 @@ func (s *Session) addUncommitedEvent(e event.DomainEvent) {
 func (s *Session) ID() string                             { return s.id }
 func (s *Session) CampaignID() string                     { return s.campaignID }
 func (s *Session) Summary() string                        { return s.summary }
+func (s *Session) Status() Status                         { return s.status }
+func (s *Session) IsDraft() bool                          { return s.status == StatusDraft }
+func (s *Session) IsFinalized() bool                      { return s.status == StatusFinalized }
 func (s *Session) CreatedAt() time.Time                   { return s.createdAt }
+func (s *Session) Attendees() []string                    { return s.attendees }
+func (s *Session) AttendanceXP() XPAmounts                { return s.attendanceXP }
 func (s *Session) XPAssignations() []XPAssignation        { return s.xpAssignations }
+func (s *Session) Schedule() *Schedule                    { return s.schedule }
+func (s *Session) RSVPs() []RSVP                          { return s.rsvps }
 func (s *Session) UncommittedEvents() []event.DomainEvent { return s.uncommitedEvents }
 
 func (xp XPAssignation) PjID() string       { return xp.pjID }
+func (xp XPAssignation) Kind() XPKind       { return xp.kind }
 func (xp XPAssignation) Reason() string     { return xp.reason }
 func (xp XPAssignation) Amounts() XPAmounts { return xp.amounts }
 func (xp XPAssignation) Basic() uint        { return xp.amounts.basic }
@@ -95,3 +275,89 @@ func (xp XPAssignation) SuperNatural() uint { return xp.amounts.superNatural }
 func (xpa XPAmounts) Basic() uint        { return xpa.basic }
 func (xpa XPAmounts) Special() uint      { return xpa.special }
 func (xpa XPAmounts) SuperNatural() uint { return xpa.superNatural }
+
+// XPDelta is the signed XP difference for a PJ between two versions of the session assignations.
+type XPDelta struct {
+	pjID         string
+	basic        int
+	special      int
+	supernatural int
+}
+
+func (d XPDelta) PjID() string      { return d.pjID }
+func (d XPDelta) Basic() int        { return d.basic }
+func (d XPDelta) Special() int      { return d.special }
+func (d XPDelta) Supernatural() int { return d.supernatural }
+
+func (d XPDelta) isZero() bool {
+	return d.basic == 0 && d.special == 0 && d.supernatural == 0
+}
+
+// IsNegative reports whether the delta takes XP away from the PJ in any category.
+func (d XPDelta) IsNegative() bool {
+	return d.basic < 0 || d.special < 0 || d.supernatural < 0
+}
+
+// ComputeXPDeltas returns, per PJ, the XP to add or take back so the PJs end up with the amounts
+// of the given attendees and bonuses instead of the current ones. PJs without changes are left out.
+func (s *Session) ComputeXPDeltas(attendees []string, bonuses []XPAssignation) []XPDelta {
+	xpAssignations := s.mergeAttendanceXP(attendees, bonuses)
+
+	var pjIDs []string
+	deltas := make(map[string]*XPDelta)
+
+	add := func(xpA XPAssignation, sign int) {
+		delta, ok := deltas[xpA.pjID]
+		if !ok {
+			delta = &XPDelta{pjID: xpA.pjID}
+			deltas[xpA.pjID] = delta
+			pjIDs = append(pjIDs, xpA.pjID)
+		}
+
+		delta.basic += sign * int(xpA.Basic())
+		delta.special += sign * int(xpA.Special())
+		delta.supernatural += sign * int(xpA.SuperNatural())
+	}
+
+	for _, xpA := range s.xpAssignations {
+		add(xpA, -1)
+	}
+
+	for _, xpA := range xpAssignations {
+		add(xpA, 1)
+	}
+
+	result := make([]XPDelta, 0, len(pjIDs))
+	for _, pjID := range pjIDs {
+		if delta := *deltas[pjID]; !delta.isZero() {
+			result = append(result, delta)
+		}
+	}
+
+	return result
+}
+
+// Amend replaces the content of a finalized session. The XP already handed out is corrected
+// with an XPAssignationAmendedEvent per PJ carrying the delta. Drafts are edited with UpdateDraft.
+func (s *Session) Amend(summary string, attendees []string, bonuses []XPAssignation) ([]XPDelta, error) {
+	switch s.status {
+	case StatusPlanned:
+		return nil, ErrSessionPlanned
+	case StatusDraft:
+		return nil, ErrSessionDraft
+	}
+
+	deltas := s.ComputeXPDeltas(attendees, bonuses)
+
+	if err := s.assignXP(attendees, bonuses); err != nil {
+		return nil, err
+	}
+
+	s.summary = summary
+
+	for _, delta := range deltas {
+		s.addUncommitedEvent(newXPAssignationAmendedEvent(delta, s.id))
+	}
+
+	return deltas, nil
+}
@@ -0,0 +1,65 @@
+package session
+
+import (
+	"context"
+	"time"
+)
+
+// ListQuery filters and pages the sessions of a campaign, newest first.
+type ListQuery struct {
+	CampaignID string
+	From       *time.Time // inclusive
+	To         *time.Time // exclusive
+	Cursor     string     // opaque, from a previous page
+	Limit      int
+
+	IncludeDrafts bool
+}
+
+type Page struct {
+	Sessions   []*Session
+	NextCursor string // empty on the last page
+}
+
+// ScheduledSession is a session with a schedule, along with the name of its campaign.
+type ScheduledSession struct {
+	Session      *Session
+	CampaignName string
+}
+
+// SearchQuery looks for a text in the summaries and XP reasons of the sessions of a campaign.
+type SearchQuery struct {
+	CampaignID string
+	Text       string // web search syntax: quoted phrases, OR and -excluded words
+	Limit      int
+
+	IncludeDrafts bool
+	PjIDs         []string // PJs whose XP reasons are searched, nil searches every PJ
+}
+
+// ReasonMatch is an XP reason matching the search.
+type ReasonMatch struct {
+	PjID    string
+	Snippet string
+}
+
+// SearchResult is a session matching the search. Snippets are HTML escaped, with the matching
+// words wrapped in <mark>.
+type SearchResult struct {
+	SessionID      string
+	Status         Status
+	CreatedAt      time.Time
+	Rank           float64
+	SummarySnippet string // empty when only XP reasons match
+	ReasonMatches  []ReasonMatch
+}
+
+//go:generate mockgen -destination=../../../tests/mocks/session_query_service_mock.go -package=mocks meye-core/internal/domain/session QueryService
+type QueryService interface {
+	ListCampaignSessions(ctx context.Context, query ListQuery) (Page, error)
+	// ListUserScheduledSessions returns the sessions with a schedule of the campaigns the user
+	// masters or has a PJ in, by start time.
+	ListUserScheduledSessions(ctx context.Context, userID string) ([]ScheduledSession, error)
+	// Search returns the best ranked sessions first.
+	Search(ctx context.Context, query SearchQuery) ([]SearchResult, error)
+}
@@ -1,8 +1,15 @@
 package session
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate mockgen -destination=../../../tests/mocks/session_repository_mock.go -package=mocks -mock_names=Repository=MockSessionRepository meye-core/internal/domain/session Repository
 type Repository interface {
 	Save(ctx context.Context, s *Session) error
+	FindByID(ctx context.Context, id string) (*Session, error)
+	FindByCampaignID(ctx context.Context, campaignID string) ([]*Session, error)
+	// FindPlannedStartingBefore returns the planned sessions whose start time is before t.
+	FindPlannedStartingBefore(ctx context.Context, t time.Time) ([]*Session, error)
 }
@@ -0,0 +1,161 @@
+package session
+
+import (
+	"meye-core/internal/domain/shared"
+	"time"
+)
+
+type Schedule struct {
+	startsAt time.Time
+	duration time.Duration
+	location string // place or link of the game
+	sequence uint   // bumped on every reschedule, calendar clients use it to pick the latest version
+}
+
+func NewSchedule(startsAt time.Time, duration time.Duration, location string) (Schedule, error) {
+	if startsAt.IsZero() || duration <= 0 {
+		return Schedule{}, ErrInvalidSchedule
+	}
+
+	return CreateScheduleWithoutValidation(startsAt, duration, location, 0), nil
+}
+
+func CreateScheduleWithoutValidation(startsAt time.Time, duration time.Duration, location string, sequence uint) Schedule {
+	return Schedule{
+		startsAt: startsAt,
+		duration: duration,
+		location: location,
+		sequence: sequence,
+	}
+}
+
+func (sc Schedule) StartsAt() time.Time     { return sc.startsAt }
+func (sc Schedule) Duration() time.Duration { return sc.duration }
+func (sc Schedule) Location() string        { return sc.location }
+func (sc Schedule) Sequence() uint          { return sc.sequence }
+func (sc Schedule) EndsAt() time.Time       { return sc.startsAt.Add(sc.duration) }
+
+type RSVPResponse string
+
+const (
+	RSVPYes   RSVPResponse = "yes"
+	RSVPNo    RSVPResponse = "no"
+	RSVPMaybe RSVPResponse = "maybe"
+)
+
+func (r RSVPResponse) isValid() bool {
+	return r == RSVPYes || r == RSVPNo || r == RSVPMaybe
+}
+
+type RSVP struct {
+	userID    string
+	response  RSVPResponse
+	updatedAt time.Time
+}
+
+func CreateRSVPWithoutValidation(userID string, response RSVPResponse, updatedAt time.Time) RSVP {
+	return RSVP{
+		userID:    userID,
+		response:  response,
+		updatedAt: updatedAt,
+	}
+}
+
+func (r RSVP) UserID() string         { return r.userID }
+func (r RSVP) Response() RSVPResponse { return r.response }
+func (r RSVP) UpdatedAt() time.Time   { return r.updatedAt }
+
+// NewPlannedSession schedules a session ahead of time. It becomes a draft once it starts.
+func NewPlannedSession(
+	campID, summary string,
+	attendees []string,
+	attendanceXP XPAmounts,
+	bonuses []XPAssignation,
+	schedule Schedule,
+	idServ shared.IdentificationService,
+) (*Session, error) {
+	s := &Session{
+		id:           idServ.GenerateID(),
+		campaignID:   campID,
+		summary:      summary,
+		status:       StatusPlanned,
+		createdAt:    time.Now(),
+		attendanceXP: attendanceXP,
+		schedule:     &schedule,
+	}
+
+	if err := s.assignXP(attendees, bonuses); err != nil {
+		return nil, err
+	}
+
+	s.addUncommitedEvent(newSessionScheduledEvent(s))
+
+	return s, nil
+}
+
+func (s *Session) IsPlanned() bool { return s.status == StatusPlanned }
+
+func (s *Session) Reschedule(schedule Schedule) error {
+	if s.status != StatusPlanned {
+		return ErrSessionNotPlanned
+	}
+
+	previous := *s.schedule
+	schedule.sequence = previous.sequence + 1
+	s.schedule = &schedule
+
+	s.addUncommitedEvent(newSessionRescheduledEvent(s, previous))
+
+	return nil
+}
+
+// RSVP records the answer of a player, replacing the previous one.
+func (s *Session) RSVP(userID string, response RSVPResponse) error {
+	if s.status != StatusPlanned {
+		return ErrSessionNotPlanned
+	}
+
+	if !response.isValid() {
+		return ErrInvalidRSVP
+	}
+
+	rsvp := RSVP{userID: userID, response: response, updatedAt: time.Now()}
+
+	found := false
+	for i := range s.rsvps {
+		if s.rsvps[i].userID == userID {
+			if s.rsvps[i].response == response {
+				return nil
+			}
+
+			s.rsvps[i] = rsvp
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		s.rsvps = append(s.rsvps, rsvp)
+	}
+
+	s.addUncommitedEvent(newRSVPChangedEvent(s, rsvp))
+
+	return nil
+}
+
+// Start turns a planned session into a draft once its start time has passed, so the master
+// can write the notes during play.
+func (s *Session) Start(now time.Time) error {
+	if s.status != StatusPlanned {
+		return ErrSessionNotPlanned
+	}
+
+	if now.Before(s.schedule.startsAt) {
+		return ErrSessionNotStarted
+	}
+
+	s.status = StatusDraft
+	s.addUncommitedEvent(newSessionStartedEvent(s, now))
+
+	return nil
+}
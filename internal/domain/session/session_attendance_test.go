@@ -0,0 +1,109 @@
+package session_test
+
+import (
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type xpLine struct {
+	pjID   string
+	kind   session.XPKind
+	basic  uint
+	reason string
+}
+
+func xpLines(xpAssignations []session.XPAssignation) []xpLine {
+	var lines []xpLine
+	for _, xpA := range xpAssignations {
+		lines = append(lines, xpLine{xpA.PjID(), xpA.Kind(), xpA.Basic(), xpA.Reason()})
+	}
+
+	return lines
+}
+
+func TestNewSession_AttendanceXP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	idServ := mocks.NewMockIdentificationService(ctrl)
+	idServ.EXPECT().GenerateID().Return("session-id")
+
+	s, err := session.NewSession(
+		"master-id", "campaign-id", "summary",
+		[]string{"pj-1", "pj-2", "pj-1"},
+		session.NewXPAmounts(10, 0, 0),
+		[]session.XPAssignation{session.NewXPAssignation("pj-1", 5, 0, 0, "killed the dragon")},
+		idServ,
+	)
+	require.NoError(t, err)
+
+	// Every attendee gets the base once, bonuses go on top in their own line
+	assert.Equal(t, []xpLine{
+		{"pj-1", session.XPKindAttendance, 10, "attendance"},
+		{"pj-2", session.XPKindAttendance, 10, "attendance"},
+		{"pj-1", session.XPKindBonus, 5, "killed the dragon"},
+	}, xpLines(s.XPAssignations()))
+
+	var assigned []xpLine
+	for _, e := range s.UncommittedEvents() {
+		if e.Type() != event.EventTypeXPAssigned {
+			continue
+		}
+
+		data := e.GetSerializedData()
+		assigned = append(assigned, xpLine{
+			pjID:   e.AggregateID(),
+			kind:   data["kind"].(session.XPKind),
+			basic:  data["assigned_xp"].(map[string]interface{})["basic"].(uint),
+			reason: data["reason"].(string),
+		})
+	}
+	assert.Equal(t, xpLines(s.XPAssignations()), assigned)
+}
+
+func TestSession_AmendAttendance(t *testing.T) {
+	s := session.CreateSessionWithoutValidation(
+		"session-id", "campaign-id", "summary", session.StatusFinalized, time.Now(),
+		[]string{"pj-1"},
+		session.NewXPAmounts(10, 0, 0),
+		[]session.XPAssignation{
+			session.CreateXPAssignationWithoutValidation("pj-1", session.XPKindAttendance, 10, 0, 0, "attendance"),
+		},
+		nil, nil,
+	)
+
+	deltas, err := s.Amend("summary", []string{"pj-1", "pj-2"}, nil)
+	require.NoError(t, err)
+
+	// The new attendee gets the base XP of the session
+	require.Len(t, deltas, 1)
+	assert.Equal(t, "pj-2", deltas[0].PjID())
+	assert.Equal(t, 10, deltas[0].Basic())
+}
+
+func TestSession_MergeXPAssignations(t *testing.T) {
+	s := session.CreateSessionWithoutValidation(
+		"session-id", "campaign-id", "notes", session.StatusDraft, time.Now(),
+		nil, session.NewXPAmounts(10, 0, 0), nil, nil, nil,
+	)
+
+	t.Run("rejects empty bonuses", func(t *testing.T) {
+		_, err := s.MergeXPAssignations([]string{"pj-1"}, []session.XPAssignation{session.NewXPAssignation("pj-1", 0, 0, 0, "nothing")})
+
+		assert.ErrorIs(t, err, session.ErrEmptyXPAssignation)
+	})
+
+	t.Run("rejects several bonuses for the same PJ", func(t *testing.T) {
+		_, err := s.MergeXPAssignations(nil, []session.XPAssignation{
+			session.NewXPAssignation("pj-1", 5, 0, 0, "first"),
+			session.NewXPAssignation("pj-1", 5, 0, 0, "second"),
+		})
+
+		assert.ErrorIs(t, err, session.ErrDuplicatedXPAssignation)
+	})
+}
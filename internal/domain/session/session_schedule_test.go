@@ -0,0 +1,96 @@
+package session_test
+
+import (
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/session"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newPlannedSession(t *testing.T, startsAt time.Time) *session.Session {
+	ctrl := gomock.NewController(t)
+	idServ := mocks.NewMockIdentificationService(ctrl)
+	idServ.EXPECT().GenerateID().Return("session-id")
+
+	schedule, err := session.NewSchedule(startsAt, 3*time.Hour, "https://meet.example.com/table")
+	require.NoError(t, err)
+
+	s, err := session.NewPlannedSession("campaign-id", "", nil, session.XPAmounts{}, nil, schedule, idServ)
+	require.NoError(t, err)
+
+	return s
+}
+
+func eventTypes(s *session.Session) []event.EventType {
+	var types []event.EventType
+	for _, e := range s.UncommittedEvents() {
+		types = append(types, e.Type())
+	}
+
+	return types
+}
+
+func TestNewSchedule(t *testing.T) {
+	_, err := session.NewSchedule(time.Time{}, time.Hour, "")
+	assert.ErrorIs(t, err, session.ErrInvalidSchedule)
+
+	_, err = session.NewSchedule(time.Now(), 0, "")
+	assert.ErrorIs(t, err, session.ErrInvalidSchedule)
+}
+
+func TestSession_RSVP(t *testing.T) {
+	s := newPlannedSession(t, time.Now().Add(24*time.Hour))
+
+	require.NoError(t, s.RSVP("user-id", session.RSVPMaybe))
+	require.NoError(t, s.RSVP("user-id", session.RSVPYes))
+	// Answering the same again doesn't emit another event
+	require.NoError(t, s.RSVP("user-id", session.RSVPYes))
+
+	assert.ErrorIs(t, s.RSVP("user-id", "later"), session.ErrInvalidRSVP)
+
+	require.Len(t, s.RSVPs(), 1)
+	assert.Equal(t, session.RSVPYes, s.RSVPs()[0].Response())
+	assert.Equal(t, []event.EventType{
+		event.EventTypeSessionScheduled,
+		event.EventTypeRSVPChanged,
+		event.EventTypeRSVPChanged,
+	}, eventTypes(s))
+}
+
+func TestSession_Start(t *testing.T) {
+	startsAt := time.Now().Add(time.Hour)
+	s := newPlannedSession(t, startsAt)
+
+	assert.ErrorIs(t, s.Finalize(), session.ErrSessionPlanned)
+	assert.ErrorIs(t, s.Start(startsAt.Add(-time.Minute)), session.ErrSessionNotStarted)
+
+	require.NoError(t, s.Start(startsAt))
+	assert.True(t, s.IsDraft())
+
+	assert.ErrorIs(t, s.RSVP("user-id", session.RSVPYes), session.ErrSessionNotPlanned)
+
+	newSchedule, err := session.NewSchedule(startsAt.Add(time.Hour), time.Hour, "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, s.Reschedule(newSchedule), session.ErrSessionNotPlanned)
+}
+
+func TestSession_Reschedule(t *testing.T) {
+	startsAt := time.Now().Add(24 * time.Hour)
+	s := newPlannedSession(t, startsAt)
+	assert.Equal(t, uint(0), s.Schedule().Sequence())
+
+	for _, days := range []int{2, 3} {
+		schedule, err := session.NewSchedule(startsAt.AddDate(0, 0, days), time.Hour, "")
+		require.NoError(t, err)
+		require.NoError(t, s.Reschedule(schedule))
+	}
+
+	// Every reschedule is a new revision for calendar clients
+	assert.Equal(t, uint(2), s.Schedule().Sequence())
+	assert.Equal(t, startsAt.AddDate(0, 0, 3), s.Schedule().StartsAt())
+}
@@ -0,0 +1,143 @@
+package user
+
+import (
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/shared"
+	"slices"
+	"time"
+)
+
+// apiKeyUseResolution is how stale the last use of a key may get before it is written again,
+// so a busy key doesn't cost a write per request.
+const apiKeyUseResolution = time.Minute
+
+// APIKey lets a tool call the API as a service account, limited to some scopes.
+// Only the hash of its secret is kept; the prefix identifies the key in listings.
+type APIKey struct {
+	id               string
+	name             string
+	userID           string // the service account the requests act as
+	tokenHash        string
+	prefix           string
+	scopes           []APIKeyScope
+	createdAt        time.Time
+	expiresAt        time.Time
+	lastUsedAt       *time.Time
+	revokedAt        *time.Time
+	uncommitedEvents []event.DomainEvent
+}
+
+// NewAPIKey issues a key for the service account, the secret is returned to be handed to the admin once.
+func NewAPIKey(
+	name, userID string,
+	scopes []APIKeyScope,
+	expiresAt time.Time,
+	identificationService shared.IdentificationService,
+	tokenService TokenService,
+) (*APIKey, string, error) {
+	normalized, err := NormalizeAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, "", ErrInvalidAPIKeyExpiry
+	}
+
+	secret, err := tokenService.Generate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	k := &APIKey{
+		id:        identificationService.GenerateID(),
+		name:      name,
+		userID:    userID,
+		tokenHash: tokenService.Hash(secret),
+		prefix:    apiKeyPrefix(secret),
+		scopes:    normalized,
+		createdAt: now,
+		expiresAt: expiresAt,
+	}
+	k.uncommitedEvents = append(k.uncommitedEvents, newAPIKeyCreatedEvent(k))
+
+	return k, secret, nil
+}
+
+func CreateAPIKeyWithoutValidation(
+	id, name, userID, tokenHash, prefix string,
+	scopes []APIKeyScope,
+	createdAt, expiresAt time.Time,
+	lastUsedAt, revokedAt *time.Time,
+) *APIKey {
+	return &APIKey{
+		id:         id,
+		name:       name,
+		userID:     userID,
+		tokenHash:  tokenHash,
+		prefix:     prefix,
+		scopes:     scopes,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+	}
+}
+
+func apiKeyPrefix(secret string) string {
+	const length = 8
+	if len(secret) < length {
+		return secret
+	}
+
+	return secret[:length]
+}
+
+func (k *APIKey) ID() string                             { return k.id }
+func (k *APIKey) Name() string                           { return k.name }
+func (k *APIKey) UserID() string                         { return k.userID }
+func (k *APIKey) TokenHash() string                      { return k.tokenHash }
+func (k *APIKey) Prefix() string                         { return k.prefix }
+func (k *APIKey) Scopes() []APIKeyScope                  { return k.scopes }
+func (k *APIKey) CreatedAt() time.Time                   { return k.createdAt }
+func (k *APIKey) ExpiresAt() time.Time                   { return k.expiresAt }
+func (k *APIKey) LastUsedAt() *time.Time                 { return k.lastUsedAt }
+func (k *APIKey) RevokedAt() *time.Time                  { return k.revokedAt }
+func (k *APIKey) UncommittedEvents() []event.DomainEvent { return k.uncommitedEvents }
+
+func (k *APIKey) IsRevoked() bool { return k.revokedAt != nil }
+
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return !now.Before(k.expiresAt)
+}
+
+// IsActive tells whether requests can still be authenticated with the key.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return !k.IsRevoked() && !k.IsExpired(now)
+}
+
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.scopes, scope)
+}
+
+// Revoke disables the key for good, revoking it again is a no-op.
+func (k *APIKey) Revoke(now time.Time) {
+	if k.IsRevoked() {
+		return
+	}
+
+	k.revokedAt = &now
+	k.uncommitedEvents = append(k.uncommitedEvents, newAPIKeyRevokedEvent(k))
+}
+
+// RecordUse updates the last use of the key, and tells whether it changed enough to be saved.
+func (k *APIKey) RecordUse(now time.Time) bool {
+	if k.lastUsedAt != nil && now.Sub(*k.lastUsedAt) < apiKeyUseResolution {
+		return false
+	}
+
+	k.lastUsedAt = &now
+
+	return true
+}
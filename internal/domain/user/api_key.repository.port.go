@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination=../../../tests/mocks/api_key_repository_mock.go -package=mocks meye-core/internal/domain/user APIKeyRepository
+type APIKeyRepository interface {
+	Save(ctx context.Context, key *APIKey) error
+	FindByID(ctx context.Context, id string) (*APIKey, error)
+	FindByHash(ctx context.Context, hash string) (*APIKey, error)
+	// List returns every key, revoked and expired ones included, newest first.
+	List(ctx context.Context) ([]*APIKey, error)
+	// TouchLastUsed records the last use of a key, leaving a revoked key untouched.
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
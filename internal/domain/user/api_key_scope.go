@@ -0,0 +1,46 @@
+package user
+
+import "slices"
+
+// APIKeyScope is a group of routes an API key may call, on behalf of its service account.
+type APIKeyScope string
+
+const (
+	APIKeyScopeSessionsRead  APIKeyScope = "sessions:read"
+	APIKeyScopeSessionsWrite APIKeyScope = "sessions:write"
+	APIKeyScopeCampaignsRead APIKeyScope = "campaigns:read"
+	APIKeyScopeUsersRead     APIKeyScope = "users:read"
+)
+
+var apiKeyScopeOrder = []APIKeyScope{
+	APIKeyScopeCampaignsRead,
+	APIKeyScopeSessionsRead,
+	APIKeyScopeSessionsWrite,
+	APIKeyScopeUsersRead,
+}
+
+func IsValidAPIKeyScope(scope APIKeyScope) bool {
+	return slices.Contains(apiKeyScopeOrder, scope)
+}
+
+// NormalizeAPIKeyScopes validates a set of scopes and returns it without duplicates, in a stable order.
+func NormalizeAPIKeyScopes(scopes []APIKeyScope) ([]APIKeyScope, error) {
+	if len(scopes) == 0 {
+		return nil, ErrInvalidAPIKeyScope
+	}
+
+	for _, scope := range scopes {
+		if !IsValidAPIKeyScope(scope) {
+			return nil, ErrInvalidAPIKeyScope
+		}
+	}
+
+	normalized := make([]APIKeyScope, 0, len(scopes))
+	for _, scope := range apiKeyScopeOrder {
+		if slices.Contains(scopes, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+
+	return normalized, nil
+}
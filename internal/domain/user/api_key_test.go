@@ -0,0 +1,85 @@
+package user_test
+
+import (
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	t.Run("issues a key holding the hash of its secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		idService := mocks.NewMockIdentificationService(ctrl)
+		tokenService := mocks.NewMockTokenService(ctrl)
+
+		idService.EXPECT().GenerateID().Return("key-id")
+		tokenService.EXPECT().Generate().Return("secret-api-key", nil)
+		tokenService.EXPECT().Hash("secret-api-key").Return("api-key-hash")
+
+		scopes := []user.APIKeyScope{user.APIKeyScopeSessionsWrite, user.APIKeyScopeSessionsRead, user.APIKeyScopeSessionsWrite}
+		k, secret, err := user.NewAPIKey("discord-bot", data.UserID, scopes, expiresAt, idService, tokenService)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "secret-api-key", secret)
+		assert.Equal(t, "api-key-hash", k.TokenHash())
+		assert.Equal(t, "secret-a", k.Prefix())
+		assert.Equal(t, []user.APIKeyScope{user.APIKeyScopeSessionsRead, user.APIKeyScopeSessionsWrite}, k.Scopes())
+		assert.Nil(t, k.LastUsedAt())
+		assert.Len(t, k.UncommittedEvents(), 1)
+		assert.Equal(t, event.EventTypeAPIKeyCreated, k.UncommittedEvents()[0].Type())
+	})
+
+	t.Run("unknown scope is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		_, _, err := user.NewAPIKey("discord-bot", data.UserID, []user.APIKeyScope{"events:delete"}, expiresAt,
+			mocks.NewMockIdentificationService(ctrl), mocks.NewMockTokenService(ctrl))
+
+		assert.Equal(t, user.ErrInvalidAPIKeyScope, err)
+	})
+
+	t.Run("expiry in the past is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		_, _, err := user.NewAPIKey("discord-bot", data.UserID, []user.APIKeyScope{user.APIKeyScopeSessionsRead}, time.Now().Add(-time.Minute),
+			mocks.NewMockIdentificationService(ctrl), mocks.NewMockTokenService(ctrl))
+
+		assert.Equal(t, user.ErrInvalidAPIKeyExpiry, err)
+	})
+}
+
+func TestAPIKey_IsActive(t *testing.T) {
+	now := time.Now()
+	scopes := []user.APIKeyScope{user.APIKeyScopeSessionsRead}
+
+	active := user.CreateAPIKeyWithoutValidation("key-id", "bot", data.UserID, "hash", "prefix", scopes, now, now.Add(time.Hour), nil, nil)
+	expired := user.CreateAPIKeyWithoutValidation("key-id", "bot", data.UserID, "hash", "prefix", scopes, now, now, nil, nil)
+
+	assert.True(t, active.IsActive(now))
+	assert.False(t, expired.IsActive(now))
+
+	active.Revoke(now)
+	active.Revoke(now)
+
+	assert.False(t, active.IsActive(now))
+	assert.Len(t, active.UncommittedEvents(), 1)
+	assert.Equal(t, event.EventTypeAPIKeyRevoked, active.UncommittedEvents()[0].Type())
+}
+
+func TestAPIKey_RecordUse(t *testing.T) {
+	now := time.Now()
+	k := user.CreateAPIKeyWithoutValidation("key-id", "bot", data.UserID, "hash", "prefix", nil, now, now.Add(time.Hour), nil, nil)
+
+	assert.True(t, k.RecordUse(now))
+	assert.False(t, k.RecordUse(now.Add(10*time.Second)), "recent uses aren't written again")
+	assert.True(t, k.RecordUse(now.Add(2*time.Minute)))
+	assert.Equal(t, now.Add(2*time.Minute), *k.LastUsedAt())
+}
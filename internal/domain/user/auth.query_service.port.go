@@ -0,0 +1,16 @@
+package user
+
+import "context"
+
+// AuthState is the part of a user checked against the claims of every access token.
+type AuthState struct {
+	Roles        []UserRole
+	TokenVersion int
+	Disabled     bool
+}
+
+//go:generate mockgen -destination=../../../tests/mocks/auth_query_service_mock.go -package=mocks meye-core/internal/domain/user AuthQueryService
+type AuthQueryService interface {
+	// FindAuthState returns nil when the user does not exist.
+	FindAuthState(ctx context.Context, userID string) (*AuthState, error)
+}
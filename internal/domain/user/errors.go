@@ -3,5 +3,26 @@ package user
 import "errors"
 
 var (
-	ErrUserNotPlayer = errors.New("USER_NOT_PLAYER")
+	ErrUserNotPlayer             = errors.New("USER_NOT_PLAYER")
+	ErrInvalidRefreshToken       = errors.New("INVALID_REFRESH_TOKEN")
+	ErrRefreshTokenReused        = errors.New("REFRESH_TOKEN_REUSED")
+	ErrWrongPassword             = errors.New("WRONG_PASSWORD")
+	ErrPasswordUnchanged         = errors.New("PASSWORD_UNCHANGED")
+	ErrPasswordTooShort          = errors.New("PASSWORD_TOO_SHORT")
+	ErrPasswordTooLong           = errors.New("PASSWORD_TOO_LONG")
+	ErrPasswordNeedsMixedCase    = errors.New("PASSWORD_NEEDS_MIXED_CASE")
+	ErrPasswordNeedsDigit        = errors.New("PASSWORD_NEEDS_DIGIT")
+	ErrPasswordNeedsSymbol       = errors.New("PASSWORD_NEEDS_SYMBOL")
+	ErrInvalidPasswordResetToken = errors.New("INVALID_PASSWORD_RESET_TOKEN")
+	ErrUserDisabled              = errors.New("USER_DISABLED")
+	ErrInvalidRole               = errors.New("INVALID_ROLE")
+	ErrTooManyLoginAttempts      = errors.New("TOO_MANY_LOGIN_ATTEMPTS")
+	ErrTwoFactorAlreadyEnabled   = errors.New("TWO_FACTOR_ALREADY_ENABLED")
+	ErrTwoFactorNotEnrolled      = errors.New("TWO_FACTOR_NOT_ENROLLED")
+	ErrTwoFactorRequired         = errors.New("TWO_FACTOR_REQUIRED")
+	ErrInvalidTwoFactorCode      = errors.New("INVALID_TWO_FACTOR_CODE")
+	ErrInvalidLoginChallenge     = errors.New("INVALID_LOGIN_CHALLENGE")
+	ErrInvalidAPIKeyScope        = errors.New("INVALID_API_KEY_SCOPE")
+	ErrInvalidAPIKeyExpiry       = errors.New("INVALID_API_KEY_EXPIRY")
+	ErrMissingAPIKeyScope        = errors.New("MISSING_API_KEY_SCOPE")
 )
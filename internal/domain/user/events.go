@@ -9,11 +9,26 @@ import (
 
 // Compile-time check to ensure UseCase implements the port interface
 var _ event.DomainEvent = (*UserCreatedEvent)(nil)
+var _ event.DomainEvent = (*UserTokensRevokedEvent)(nil)
+var _ event.DomainEvent = (*PasswordChangedEvent)(nil)
+var _ event.DomainEvent = (*PasswordResetIssuedEvent)(nil)
+var _ event.DomainEvent = (*UserDisabledEvent)(nil)
+var _ event.DomainEvent = (*UserEnabledEvent)(nil)
+var _ event.DomainEvent = (*UserRolesChangedEvent)(nil)
+var _ event.DomainEvent = (*UserDeletedEvent)(nil)
+var _ event.DomainEvent = (*LoginFailedEvent)(nil)
+var _ event.DomainEvent = (*AccountLockedEvent)(nil)
+var _ event.DomainEvent = (*TwoFactorEnabledEvent)(nil)
+var _ event.DomainEvent = (*TwoFactorDisabledEvent)(nil)
+var _ event.DomainEvent = (*TwoFactorClearedEvent)(nil)
+var _ event.DomainEvent = (*RecoveryCodeUsedEvent)(nil)
+var _ event.DomainEvent = (*APIKeyCreatedEvent)(nil)
+var _ event.DomainEvent = (*APIKeyRevokedEvent)(nil)
 
 type UserCreatedEvent struct {
 	id         string
 	userID     string
-	role       UserRole
+	roles      []UserRole
 	createdAt  time.Time
 	occurredAt time.Time
 }
@@ -25,11 +40,11 @@ func (e UserCreatedEvent) AggregateType() event.AggregateType { return event.Agg
 func (e UserCreatedEvent) CreatedAt() time.Time               { return e.createdAt }
 func (e UserCreatedEvent) OccurredAt() time.Time              { return e.occurredAt }
 
-func (e UserCreatedEvent) Role() UserRole { return e.role }
+func (e UserCreatedEvent) Roles() []UserRole { return e.roles }
 
 func (e UserCreatedEvent) GetSerializedData() map[string]interface{} {
 	return map[string]interface{}{
-		"role": e.role,
+		"roles": e.roles,
 	}
 }
 
@@ -37,7 +52,515 @@ func newUserCreatedEvent(u *User) UserCreatedEvent {
 	return UserCreatedEvent{
 		id:         uuid.NewString(),
 		userID:     u.id,
-		role:       u.role,
+		roles:      u.Roles(),
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type UserTokensRevokedEvent struct {
+	id           string
+	userID       string
+	tokenVersion int
+	createdAt    time.Time
+	occurredAt   time.Time
+}
+
+func (e UserTokensRevokedEvent) ID() string                         { return e.id }
+func (e UserTokensRevokedEvent) Type() event.EventType              { return event.EventTypeUserTokensRevoked }
+func (e UserTokensRevokedEvent) AggregateID() string                { return e.userID }
+func (e UserTokensRevokedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e UserTokensRevokedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e UserTokensRevokedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e UserTokensRevokedEvent) TokenVersion() int { return e.tokenVersion }
+
+func (e UserTokensRevokedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"token_version": e.tokenVersion,
+	}
+}
+
+func newUserTokensRevokedEvent(u *User) UserTokensRevokedEvent {
+	return UserTokensRevokedEvent{
+		id:           uuid.NewString(),
+		userID:       u.id,
+		tokenVersion: u.tokenVersion,
+		createdAt:    time.Now(),
+		occurredAt:   time.Now(),
+	}
+}
+
+// PasswordChangedEvent is raised both when the user changes its password and when it resets it with a token.
+type PasswordChangedEvent struct {
+	id           string
+	userID       string
+	reset        bool
+	tokenVersion int
+	createdAt    time.Time
+	occurredAt   time.Time
+}
+
+func (e PasswordChangedEvent) ID() string                         { return e.id }
+func (e PasswordChangedEvent) Type() event.EventType              { return event.EventTypePasswordChanged }
+func (e PasswordChangedEvent) AggregateID() string                { return e.userID }
+func (e PasswordChangedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e PasswordChangedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e PasswordChangedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e PasswordChangedEvent) Reset() bool       { return e.reset }
+func (e PasswordChangedEvent) TokenVersion() int { return e.tokenVersion }
+
+func (e PasswordChangedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"reset":         e.reset,
+		"token_version": e.tokenVersion,
+	}
+}
+
+func newPasswordChangedEvent(u *User, reset bool) PasswordChangedEvent {
+	return PasswordChangedEvent{
+		id:           uuid.NewString(),
+		userID:       u.id,
+		reset:        reset,
+		tokenVersion: u.tokenVersion,
+		createdAt:    time.Now(),
+		occurredAt:   time.Now(),
+	}
+}
+
+type PasswordResetIssuedEvent struct {
+	id           string
+	userID       string
+	expiresAt    time.Time
+	tokenVersion int
+	createdAt    time.Time
+	occurredAt   time.Time
+}
+
+func (e PasswordResetIssuedEvent) ID() string                         { return e.id }
+func (e PasswordResetIssuedEvent) Type() event.EventType              { return event.EventTypePasswordResetIssued }
+func (e PasswordResetIssuedEvent) AggregateID() string                { return e.userID }
+func (e PasswordResetIssuedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e PasswordResetIssuedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e PasswordResetIssuedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e PasswordResetIssuedEvent) ExpiresAt() time.Time { return e.expiresAt }
+func (e PasswordResetIssuedEvent) TokenVersion() int    { return e.tokenVersion }
+
+func (e PasswordResetIssuedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"expires_at":    e.expiresAt,
+		"token_version": e.tokenVersion,
+	}
+}
+
+func newPasswordResetIssuedEvent(u *User) PasswordResetIssuedEvent {
+	return PasswordResetIssuedEvent{
+		id:           uuid.NewString(),
+		userID:       u.id,
+		expiresAt:    u.passwordReset.expiresAt,
+		tokenVersion: u.tokenVersion,
+		createdAt:    time.Now(),
+		occurredAt:   time.Now(),
+	}
+}
+
+type UserDisabledEvent struct {
+	id         string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e UserDisabledEvent) ID() string                         { return e.id }
+func (e UserDisabledEvent) Type() event.EventType              { return event.EventTypeUserDisabled }
+func (e UserDisabledEvent) AggregateID() string                { return e.userID }
+func (e UserDisabledEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e UserDisabledEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e UserDisabledEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e UserDisabledEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func newUserDisabledEvent(u *User) UserDisabledEvent {
+	return UserDisabledEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type UserEnabledEvent struct {
+	id         string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e UserEnabledEvent) ID() string                         { return e.id }
+func (e UserEnabledEvent) Type() event.EventType              { return event.EventTypeUserEnabled }
+func (e UserEnabledEvent) AggregateID() string                { return e.userID }
+func (e UserEnabledEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e UserEnabledEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e UserEnabledEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e UserEnabledEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func newUserEnabledEvent(u *User) UserEnabledEvent {
+	return UserEnabledEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type UserRolesChangedEvent struct {
+	id            string
+	userID        string
+	previousRoles []UserRole
+	roles         []UserRole
+	createdAt     time.Time
+	occurredAt    time.Time
+}
+
+func (e UserRolesChangedEvent) ID() string                         { return e.id }
+func (e UserRolesChangedEvent) Type() event.EventType              { return event.EventTypeUserRolesChanged }
+func (e UserRolesChangedEvent) AggregateID() string                { return e.userID }
+func (e UserRolesChangedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e UserRolesChangedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e UserRolesChangedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e UserRolesChangedEvent) PreviousRoles() []UserRole { return e.previousRoles }
+func (e UserRolesChangedEvent) Roles() []UserRole         { return e.roles }
+
+func (e UserRolesChangedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"previous_roles": e.previousRoles,
+		"roles":          e.roles,
+	}
+}
+
+func newUserRolesChangedEvent(u *User, previous []UserRole) UserRolesChangedEvent {
+	return UserRolesChangedEvent{
+		id:            uuid.NewString(),
+		userID:        u.id,
+		previousRoles: previous,
+		roles:         u.Roles(),
+		createdAt:     time.Now(),
+		occurredAt:    time.Now(),
+	}
+}
+
+type UserDeletedEvent struct {
+	id         string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e UserDeletedEvent) ID() string                         { return e.id }
+func (e UserDeletedEvent) Type() event.EventType              { return event.EventTypeUserDeleted }
+func (e UserDeletedEvent) AggregateID() string                { return e.userID }
+func (e UserDeletedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e UserDeletedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e UserDeletedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e UserDeletedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func newUserDeletedEvent(u *User) UserDeletedEvent {
+	return UserDeletedEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+// LoginFailedEvent is raised for every rejected login, whether the username exists or not.
+type LoginFailedEvent struct {
+	id         string
+	username   string
+	ipAddress  string
+	failures   int
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e LoginFailedEvent) ID() string                         { return e.id }
+func (e LoginFailedEvent) Type() event.EventType              { return event.EventTypeLoginFailed }
+func (e LoginFailedEvent) AggregateID() string                { return e.username }
+func (e LoginFailedEvent) AggregateType() event.AggregateType { return event.AggregateTypeLogin }
+func (e LoginFailedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e LoginFailedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e LoginFailedEvent) Username() string  { return e.username }
+func (e LoginFailedEvent) IPAddress() string { return e.ipAddress }
+func (e LoginFailedEvent) Failures() int     { return e.failures }
+
+func (e LoginFailedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"username":   e.username,
+		"ip_address": e.ipAddress,
+		"failures":   e.failures,
+	}
+}
+
+// newLoginFailedEvent records a failed login, failures being the count of the username within the window.
+func newLoginFailedEvent(username, ipAddress string, failures int) LoginFailedEvent {
+	return LoginFailedEvent{
+		id:         uuid.NewString(),
+		username:   username,
+		ipAddress:  ipAddress,
+		failures:   failures,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type LockScope string
+
+const (
+	LockScopeUsername  LockScope = "username"
+	LockScopeIPAddress LockScope = "ip_address"
+)
+
+// AccountLockedEvent is raised when a username or an IP address gets locked out after too many failed logins.
+type AccountLockedEvent struct {
+	id          string
+	scope       LockScope
+	subject     string
+	failures    int
+	lockedUntil time.Time
+	createdAt   time.Time
+	occurredAt  time.Time
+}
+
+func (e AccountLockedEvent) ID() string                         { return e.id }
+func (e AccountLockedEvent) Type() event.EventType              { return event.EventTypeAccountLocked }
+func (e AccountLockedEvent) AggregateID() string                { return e.subject }
+func (e AccountLockedEvent) AggregateType() event.AggregateType { return event.AggregateTypeLogin }
+func (e AccountLockedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e AccountLockedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e AccountLockedEvent) Scope() LockScope       { return e.scope }
+func (e AccountLockedEvent) Subject() string        { return e.subject }
+func (e AccountLockedEvent) LockedUntil() time.Time { return e.lockedUntil }
+
+func (e AccountLockedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"scope":        e.scope,
+		"subject":      e.subject,
+		"failures":     e.failures,
+		"locked_until": e.lockedUntil,
+	}
+}
+
+// newAccountLockedEvent records the lockout of subject, a username or an IP address depending on scope.
+func newAccountLockedEvent(scope LockScope, subject string, failures int, lockedUntil time.Time) AccountLockedEvent {
+	return AccountLockedEvent{
+		id:          uuid.NewString(),
+		scope:       scope,
+		subject:     subject,
+		failures:    failures,
+		lockedUntil: lockedUntil,
+		createdAt:   time.Now(),
+		occurredAt:  time.Now(),
+	}
+}
+
+type TwoFactorEnabledEvent struct {
+	id         string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e TwoFactorEnabledEvent) ID() string                         { return e.id }
+func (e TwoFactorEnabledEvent) Type() event.EventType              { return event.EventTypeTwoFactorEnabled }
+func (e TwoFactorEnabledEvent) AggregateID() string                { return e.userID }
+func (e TwoFactorEnabledEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e TwoFactorEnabledEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e TwoFactorEnabledEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e TwoFactorEnabledEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func newTwoFactorEnabledEvent(u *User) TwoFactorEnabledEvent {
+	return TwoFactorEnabledEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type TwoFactorDisabledEvent struct {
+	id         string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e TwoFactorDisabledEvent) ID() string                         { return e.id }
+func (e TwoFactorDisabledEvent) Type() event.EventType              { return event.EventTypeTwoFactorDisabled }
+func (e TwoFactorDisabledEvent) AggregateID() string                { return e.userID }
+func (e TwoFactorDisabledEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e TwoFactorDisabledEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e TwoFactorDisabledEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e TwoFactorDisabledEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func newTwoFactorDisabledEvent(u *User) TwoFactorDisabledEvent {
+	return TwoFactorDisabledEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+// TwoFactorClearedEvent is raised when an admin drops the pending enrollment of a user.
+type TwoFactorClearedEvent struct {
+	id         string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e TwoFactorClearedEvent) ID() string                         { return e.id }
+func (e TwoFactorClearedEvent) Type() event.EventType              { return event.EventTypeTwoFactorCleared }
+func (e TwoFactorClearedEvent) AggregateID() string                { return e.userID }
+func (e TwoFactorClearedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e TwoFactorClearedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e TwoFactorClearedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e TwoFactorClearedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func newTwoFactorClearedEvent(u *User) TwoFactorClearedEvent {
+	return TwoFactorClearedEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+// RecoveryCodeUsedEvent is raised when a recovery code replaces a TOTP code, which may mean the device was lost.
+type RecoveryCodeUsedEvent struct {
+	id         string
+	userID     string
+	remaining  int
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e RecoveryCodeUsedEvent) ID() string                         { return e.id }
+func (e RecoveryCodeUsedEvent) Type() event.EventType              { return event.EventTypeRecoveryCodeUsed }
+func (e RecoveryCodeUsedEvent) AggregateID() string                { return e.userID }
+func (e RecoveryCodeUsedEvent) AggregateType() event.AggregateType { return event.AggregateTypeUser }
+func (e RecoveryCodeUsedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e RecoveryCodeUsedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e RecoveryCodeUsedEvent) Remaining() int { return e.remaining }
+
+func (e RecoveryCodeUsedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"remaining": e.remaining,
+	}
+}
+
+func newRecoveryCodeUsedEvent(u *User) RecoveryCodeUsedEvent {
+	return RecoveryCodeUsedEvent{
+		id:         uuid.NewString(),
+		userID:     u.id,
+		remaining:  len(u.twoFactor.recoveryCodeHashes),
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type APIKeyCreatedEvent struct {
+	id         string
+	apiKeyID   string
+	userID     string
+	scopes     []APIKeyScope
+	expiresAt  time.Time
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e APIKeyCreatedEvent) ID() string                         { return e.id }
+func (e APIKeyCreatedEvent) Type() event.EventType              { return event.EventTypeAPIKeyCreated }
+func (e APIKeyCreatedEvent) AggregateID() string                { return e.apiKeyID }
+func (e APIKeyCreatedEvent) AggregateType() event.AggregateType { return event.AggregateTypeAPIKey }
+func (e APIKeyCreatedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e APIKeyCreatedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e APIKeyCreatedEvent) UserID() string        { return e.userID }
+func (e APIKeyCreatedEvent) Scopes() []APIKeyScope { return e.scopes }
+func (e APIKeyCreatedEvent) ExpiresAt() time.Time  { return e.expiresAt }
+
+func (e APIKeyCreatedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":    e.userID,
+		"scopes":     e.scopes,
+		"expires_at": e.expiresAt,
+	}
+}
+
+func newAPIKeyCreatedEvent(k *APIKey) APIKeyCreatedEvent {
+	return APIKeyCreatedEvent{
+		id:         uuid.NewString(),
+		apiKeyID:   k.id,
+		userID:     k.userID,
+		scopes:     k.scopes,
+		expiresAt:  k.expiresAt,
+		createdAt:  time.Now(),
+		occurredAt: time.Now(),
+	}
+}
+
+type APIKeyRevokedEvent struct {
+	id         string
+	apiKeyID   string
+	userID     string
+	createdAt  time.Time
+	occurredAt time.Time
+}
+
+func (e APIKeyRevokedEvent) ID() string                         { return e.id }
+func (e APIKeyRevokedEvent) Type() event.EventType              { return event.EventTypeAPIKeyRevoked }
+func (e APIKeyRevokedEvent) AggregateID() string                { return e.apiKeyID }
+func (e APIKeyRevokedEvent) AggregateType() event.AggregateType { return event.AggregateTypeAPIKey }
+func (e APIKeyRevokedEvent) CreatedAt() time.Time               { return e.createdAt }
+func (e APIKeyRevokedEvent) OccurredAt() time.Time              { return e.occurredAt }
+
+func (e APIKeyRevokedEvent) UserID() string { return e.userID }
+
+func (e APIKeyRevokedEvent) GetSerializedData() map[string]interface{} {
+	return map[string]interface{}{
+		"user_id": e.userID,
+	}
+}
+
+func newAPIKeyRevokedEvent(k *APIKey) APIKeyRevokedEvent {
+	return APIKeyRevokedEvent{
+		id:         uuid.NewString(),
+		apiKeyID:   k.id,
+		userID:     k.userID,
 		createdAt:  time.Now(),
 		occurredAt: time.Now(),
 	}
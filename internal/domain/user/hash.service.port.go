@@ -4,4 +4,6 @@ package user
 type HashService interface {
 	Hash(password string) (string, error)
 	Compare(hashedPassword, password string) error
+	// NeedsRehash tells whether the hash was made with an older algorithm or weaker parameters than the current ones.
+	NeedsRehash(hashedPassword string) bool
 }
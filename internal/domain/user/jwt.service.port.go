@@ -1,7 +1,16 @@
 package user
 
+// AccessClaims identifies the bearer of a valid access token.
+type AccessClaims struct {
+	UserID string
+	Roles  []UserRole
+	// TokenVersion is the version of the user when the token was issued,
+	// the token is revoked once it no longer matches.
+	TokenVersion int
+}
+
 //go:generate mockgen -destination=../../../tests/mocks/jwt_service_mock.go -package=mocks meye-core/internal/domain/user JWTService
 type JWTService interface {
 	GenerateSignedToken(user *User) (string, error)
-	ValidateToken(tokenString string) (string, error)
+	ValidateToken(tokenString string) (AccessClaims, error)
 }
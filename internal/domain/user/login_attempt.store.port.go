@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttempts is the record of the recent failed logins of a username or an IP address.
+type LoginAttempts struct {
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time // zero when never locked
+}
+
+//go:generate mockgen -destination=../../../tests/mocks/login_attempt_store_mock.go -package=mocks meye-core/internal/domain/user LoginAttemptStore
+type LoginAttemptStore interface {
+	// Find returns the attempts of key, zero when it has none.
+	Find(ctx context.Context, key string) (LoginAttempts, error)
+	// RecordFailure atomically counts a failed login of key at now and returns the updated attempts.
+	// The count starts over when the last failure is older than window.
+	RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (LoginAttempts, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	// Reset forgets the attempts of key.
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"meye-core/internal/domain/shared"
+	"time"
+)
+
+const maxLoginChallengeFailures = 5
+
+// LoginChallenge is the second step of a login, pending once the password matched.
+// The client answers it with a code of the second factor; only the hash of its token is kept.
+type LoginChallenge struct {
+	id        string
+	userID    string
+	tokenHash string
+	expiresAt time.Time
+	failures  int
+}
+
+// NewLoginChallenge opens a challenge for the user, the token is returned to be handed to the client once.
+func NewLoginChallenge(
+	userID string,
+	ttl time.Duration,
+	identificationService shared.IdentificationService,
+	tokenService TokenService,
+) (*LoginChallenge, string, error) {
+	token, err := tokenService.Generate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	c := &LoginChallenge{
+		id:        identificationService.GenerateID(),
+		userID:    userID,
+		tokenHash: tokenService.Hash(token),
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	return c, token, nil
+}
+
+func CreateLoginChallengeWithoutValidation(id, userID, tokenHash string, expiresAt time.Time, failures int) *LoginChallenge {
+	return &LoginChallenge{
+		id:        id,
+		userID:    userID,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+		failures:  failures,
+	}
+}
+
+func (c *LoginChallenge) ID() string           { return c.id }
+func (c *LoginChallenge) UserID() string       { return c.userID }
+func (c *LoginChallenge) TokenHash() string    { return c.tokenHash }
+func (c *LoginChallenge) ExpiresAt() time.Time { return c.expiresAt }
+func (c *LoginChallenge) Failures() int        { return c.failures }
+
+func (c *LoginChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
+
+// RecordFailure counts a wrong code and tells whether the challenge may still be answered.
+// Past a few failures the password has to be checked again.
+func (c *LoginChallenge) RecordFailure() bool {
+	c.failures++
+
+	return c.failures < maxLoginChallengeFailures
+}
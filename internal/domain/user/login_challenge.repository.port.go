@@ -0,0 +1,11 @@
+package user
+
+import "context"
+
+//go:generate mockgen -destination=../../../tests/mocks/login_challenge_repository_mock.go -package=mocks meye-core/internal/domain/user LoginChallengeRepository
+type LoginChallengeRepository interface {
+	Save(ctx context.Context, challenge *LoginChallenge) error
+	FindByHash(ctx context.Context, hash string) (*LoginChallenge, error)
+	// Delete removes the challenge once answered, or once it can't be answered anymore.
+	Delete(ctx context.Context, challenge *LoginChallenge) error
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"meye-core/internal/domain/event"
+	"time"
+)
+
+// LoginGuard applies the login throttles to the usernames and the IP addresses, counting their failures in the store.
+// Every step of a login that checks a credential goes through it.
+type LoginGuard struct {
+	store             LoginAttemptStore
+	usernameThrottle  LoginThrottle
+	ipAddressThrottle LoginThrottle
+}
+
+func NewLoginGuard(store LoginAttemptStore, usernameThrottle, ipAddressThrottle LoginThrottle) *LoginGuard {
+	return &LoginGuard{
+		store:             store,
+		usernameThrottle:  usernameThrottle,
+		ipAddressThrottle: ipAddressThrottle,
+	}
+}
+
+func usernameKey(username string) string { return "username:" + username }
+
+func ipAddressKey(ipAddress string) string { return "ip:" + ipAddress }
+
+// Check returns a LoginThrottledError while the username or the IP address has to wait before trying again.
+func (g *LoginGuard) Check(ctx context.Context, username, ipAddress string, now time.Time) error {
+	usernameAttempts, err := g.store.Find(ctx, usernameKey(username))
+	if err != nil {
+		return err
+	}
+
+	ipAddressAttempts, err := g.store.Find(ctx, ipAddressKey(ipAddress))
+	if err != nil {
+		return err
+	}
+
+	retryAfter := max(
+		g.usernameThrottle.RetryAfter(usernameAttempts, now),
+		g.ipAddressThrottle.RetryAfter(ipAddressAttempts, now),
+	)
+	if retryAfter > 0 {
+		return &LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed attempt against the username and the IP address, locking them out
+// once they failed too often, and returns the events to publish.
+func (g *LoginGuard) RecordFailure(ctx context.Context, username, ipAddress string, now time.Time) ([]event.DomainEvent, error) {
+	usernameAttempts, err := g.store.RecordFailure(ctx, usernameKey(username), now, g.usernameThrottle.Window())
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddressAttempts, err := g.store.RecordFailure(ctx, ipAddressKey(ipAddress), now, g.ipAddressThrottle.Window())
+	if err != nil {
+		return nil, err
+	}
+
+	events := []event.DomainEvent{
+		newLoginFailedEvent(username, ipAddress, usernameAttempts.Failures),
+	}
+
+	if until := g.usernameThrottle.Lock(usernameAttempts, now); !until.IsZero() {
+		if err := g.store.Lock(ctx, usernameKey(username), until); err != nil {
+			return nil, err
+		}
+
+		events = append(events, newAccountLockedEvent(LockScopeUsername, username, usernameAttempts.Failures, until))
+	}
+
+	if until := g.ipAddressThrottle.Lock(ipAddressAttempts, now); !until.IsZero() {
+		if err := g.store.Lock(ctx, ipAddressKey(ipAddress), until); err != nil {
+			return nil, err
+		}
+
+		events = append(events, newAccountLockedEvent(LockScopeIPAddress, ipAddress, ipAddressAttempts.Failures, until))
+	}
+
+	return events, nil
+}
+
+// Reset clears the failures of the username once a login succeeded.
+// The IP address is not reset, one valid account must not clear the failures on the others.
+func (g *LoginGuard) Reset(ctx context.Context, username string) error {
+	return g.store.Reset(ctx, usernameKey(username))
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoginThrottle is the policy slowing down the logins of a username or an IP address that keeps failing.
+// Each failure makes the next attempt wait twice as long as the previous one, from baseDelay up to maxDelay,
+// and maxFailures failures within window lock it out for lockout.
+type LoginThrottle struct {
+	maxFailures int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	lockout     time.Duration
+	window      time.Duration
+}
+
+func NewLoginThrottle(maxFailures int, baseDelay, maxDelay, lockout, window time.Duration) LoginThrottle {
+	return LoginThrottle{
+		maxFailures: maxFailures,
+		baseDelay:   baseDelay,
+		maxDelay:    maxDelay,
+		lockout:     lockout,
+		window:      window,
+	}
+}
+
+func (t LoginThrottle) Window() time.Duration { return t.window }
+
+// RetryAfter returns how long the next attempt has to wait, zero when it is allowed now.
+func (t LoginThrottle) RetryAfter(attempts LoginAttempts, now time.Time) time.Duration {
+	if attempts.LockedUntil.After(now) {
+		return attempts.LockedUntil.Sub(now)
+	}
+
+	if attempts.Failures == 0 || now.Sub(attempts.LastFailureAt) >= t.window {
+		return 0
+	}
+
+	delay := t.baseDelay
+	for i := 1; i < attempts.Failures && delay < t.maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.maxDelay)
+
+	return max(attempts.LastFailureAt.Add(delay).Sub(now), 0)
+}
+
+// Lock returns until when the attempts have to be locked out, zero when they don't.
+// Once the lockout is over, every new failure locks them out again until a successful login.
+func (t LoginThrottle) Lock(attempts LoginAttempts, now time.Time) time.Time {
+	if attempts.Failures < t.maxFailures || attempts.LockedUntil.After(now) {
+		return time.Time{}
+	}
+
+	return now.Add(t.lockout)
+}
+
+// LoginThrottledError is returned instead of checking the credentials of a throttled login.
+// It is the same whether the username exists or not.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrTooManyLoginAttempts, e.RetryAfter)
+}
+
+func (e *LoginThrottledError) Unwrap() error { return ErrTooManyLoginAttempts }
@@ -0,0 +1,55 @@
+package user_test
+
+import (
+	"meye-core/internal/domain/user"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginThrottle_RetryAfter(t *testing.T) {
+	throttle := user.NewLoginThrottle(5, time.Second, 8*time.Second, 15*time.Minute, 15*time.Minute)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		attempts user.LoginAttempts
+		want     time.Duration
+	}{
+		{name: "no failure", attempts: user.LoginAttempts{}, want: 0},
+		{name: "first failure waits the base delay", attempts: user.LoginAttempts{Failures: 1, LastFailureAt: now}, want: time.Second},
+		{name: "each failure doubles the delay", attempts: user.LoginAttempts{Failures: 3, LastFailureAt: now}, want: 4 * time.Second},
+		{name: "delay is capped", attempts: user.LoginAttempts{Failures: 40, LastFailureAt: now}, want: 8 * time.Second},
+		{name: "part of the delay elapsed", attempts: user.LoginAttempts{Failures: 3, LastFailureAt: now.Add(-3 * time.Second)}, want: time.Second},
+		{name: "delay elapsed", attempts: user.LoginAttempts{Failures: 3, LastFailureAt: now.Add(-time.Minute)}, want: 0},
+		{
+			name:     "locked out",
+			attempts: user.LoginAttempts{Failures: 5, LastFailureAt: now.Add(-time.Minute), LockedUntil: now.Add(10 * time.Minute)},
+			want:     10 * time.Minute,
+		},
+		{
+			name:     "lockout over",
+			attempts: user.LoginAttempts{Failures: 5, LastFailureAt: now.Add(-time.Hour), LockedUntil: now.Add(-time.Minute)},
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, throttle.RetryAfter(tt.attempts, now))
+		})
+	}
+}
+
+func TestLoginThrottle_Lock(t *testing.T) {
+	throttle := user.NewLoginThrottle(3, time.Second, 8*time.Second, 15*time.Minute, 15*time.Minute)
+	now := time.Now()
+
+	assert.True(t, throttle.Lock(user.LoginAttempts{Failures: 2, LastFailureAt: now}, now).IsZero())
+	assert.Equal(t, now.Add(15*time.Minute), throttle.Lock(user.LoginAttempts{Failures: 3, LastFailureAt: now}, now))
+	// Already locked, the lockout is not extended
+	assert.True(t, throttle.Lock(user.LoginAttempts{Failures: 4, LastFailureAt: now, LockedUntil: now.Add(time.Minute)}, now).IsZero())
+	// A failure after the lockout locks again
+	assert.Equal(t, now.Add(15*time.Minute), throttle.Lock(user.LoginAttempts{Failures: 4, LastFailureAt: now, LockedUntil: now.Add(-time.Minute)}, now))
+}
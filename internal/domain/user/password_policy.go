@@ -0,0 +1,70 @@
+package user
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxPasswordBytes is the longest input bcrypt takes into account
+const maxPasswordBytes = 72
+
+// PasswordPolicy is the set of rules a new password must follow.
+type PasswordPolicy struct {
+	minLength        int
+	requireMixedCase bool
+	requireDigit     bool
+	requireSymbol    bool
+}
+
+func NewPasswordPolicy(minLength int, requireMixedCase, requireDigit, requireSymbol bool) PasswordPolicy {
+	return PasswordPolicy{
+		minLength:        minLength,
+		requireMixedCase: requireMixedCase,
+		requireDigit:     requireDigit,
+		requireSymbol:    requireSymbol,
+	}
+}
+
+func (p PasswordPolicy) MinLength() int         { return p.minLength }
+func (p PasswordPolicy) RequireMixedCase() bool { return p.requireMixedCase }
+func (p PasswordPolicy) RequireDigit() bool     { return p.requireDigit }
+func (p PasswordPolicy) RequireSymbol() bool    { return p.requireSymbol }
+
+// Validate returns the first rule the password breaks, nil when it follows them all.
+func (p PasswordPolicy) Validate(password string) error {
+	if utf8.RuneCountInString(password) < p.minLength {
+		return ErrPasswordTooShort
+	}
+
+	if len(password) > maxPasswordBytes {
+		return ErrPasswordTooLong
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.requireMixedCase && (!hasUpper || !hasLower) {
+		return ErrPasswordNeedsMixedCase
+	}
+
+	if p.requireDigit && !hasDigit {
+		return ErrPasswordNeedsDigit
+	}
+
+	if p.requireSymbol && !hasSymbol {
+		return ErrPasswordNeedsSymbol
+	}
+
+	return nil
+}
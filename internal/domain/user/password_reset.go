@@ -0,0 +1,23 @@
+package user
+
+import "time"
+
+// PasswordReset is a pending one-time reset of the password, issued by an admin.
+type PasswordReset struct {
+	tokenHash string
+	expiresAt time.Time
+}
+
+func CreatePasswordResetWithoutValidation(tokenHash string, expiresAt time.Time) *PasswordReset {
+	return &PasswordReset{
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+	}
+}
+
+func (r *PasswordReset) TokenHash() string    { return r.tokenHash }
+func (r *PasswordReset) ExpiresAt() time.Time { return r.expiresAt }
+
+func (r *PasswordReset) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
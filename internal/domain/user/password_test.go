@@ -0,0 +1,177 @@
+package user_test
+
+import (
+	"errors"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	strict := user.NewPasswordPolicy(10, true, true, true)
+
+	tests := []struct {
+		name     string
+		policy   user.PasswordPolicy
+		password string
+		wantErr  error
+	}{
+		{name: "follows every rule", policy: strict, password: "Correct horse 9"},
+		{name: "too short", policy: strict, password: "Sh0rt!", wantErr: user.ErrPasswordTooShort},
+		{name: "too long for bcrypt", policy: strict, password: string(make([]byte, 73)), wantErr: user.ErrPasswordTooLong},
+		{name: "counts runes, not bytes", policy: user.NewPasswordPolicy(4, false, false, false), password: "ñañá"},
+		{name: "missing uppercase", policy: strict, password: "correct horse 9", wantErr: user.ErrPasswordNeedsMixedCase},
+		{name: "missing digit", policy: strict, password: "Correct horse!", wantErr: user.ErrPasswordNeedsDigit},
+		{name: "missing symbol", policy: strict, password: "CorrectHorse9", wantErr: user.ErrPasswordNeedsSymbol},
+		{name: "lenient policy", policy: user.NewPasswordPolicy(8, false, false, false), password: "password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.policy.Validate(tt.password))
+		})
+	}
+}
+
+func TestUser_ChangePassword(t *testing.T) {
+	policy := user.NewPasswordPolicy(8, false, false, false)
+
+	t.Run("replaces the password and revokes the tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hashService := mocks.NewMockHashService(ctrl)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, false, nil)
+
+		hashService.EXPECT().Compare(data.Password, data.HashedPassword).Return(nil)
+		hashService.EXPECT().Hash("new password").Return("hashed:new password", nil)
+
+		err := u.ChangePassword(data.Password, "new password", policy, hashService)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hashed:new password", u.HashedPassword())
+		assert.Equal(t, 3, u.TokenVersion())
+		if assert.Len(t, u.UncommittedEvents(), 1) {
+			assert.Equal(t, event.EventTypePasswordChanged, u.UncommittedEvents()[0].Type())
+		}
+	})
+
+	t.Run("rejects a wrong current password", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hashService := mocks.NewMockHashService(ctrl)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, false, nil)
+
+		hashService.EXPECT().Compare("wrong", data.HashedPassword).Return(errors.New("mismatch"))
+
+		err := u.ChangePassword("wrong", "new password", policy, hashService)
+
+		assert.Equal(t, user.ErrWrongPassword, err)
+		assert.Equal(t, 2, u.TokenVersion())
+		assert.Empty(t, u.UncommittedEvents())
+	})
+
+	t.Run("rejects the current password", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hashService := mocks.NewMockHashService(ctrl)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, false, nil)
+
+		hashService.EXPECT().Compare(data.Password, data.HashedPassword).Return(nil)
+
+		err := u.ChangePassword(data.Password, data.Password, policy, hashService)
+
+		assert.Equal(t, user.ErrPasswordUnchanged, err)
+	})
+}
+
+func TestUser_ResetPassword(t *testing.T) {
+	policy := user.NewPasswordPolicy(8, false, false, false)
+
+	t.Run("issued token resets the password once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hashService := mocks.NewMockHashService(ctrl)
+		tokenService := mocks.NewMockTokenService(ctrl)
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+
+		tokenService.EXPECT().Generate().Return("reset-token", nil)
+		tokenService.EXPECT().Hash("reset-token").Return("reset-token-hash").Times(2)
+		hashService.EXPECT().Hash("new password").Return("hashed:new password", nil)
+
+		token, expiresAt, err := u.IssuePasswordReset(time.Hour, tokenService)
+		assert.NoError(t, err)
+		assert.Equal(t, "reset-token", token)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+		assert.Equal(t, 1, u.TokenVersion())
+
+		err = u.ResetPassword(token, "new password", policy, hashService, tokenService)
+		assert.NoError(t, err)
+		assert.Equal(t, "hashed:new password", u.HashedPassword())
+		assert.Nil(t, u.PasswordReset())
+		assert.Equal(t, 2, u.TokenVersion())
+
+		err = u.ResetPassword(token, "other password", policy, hashService, tokenService)
+		assert.Equal(t, user.ErrInvalidPasswordResetToken, err)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		tokenService := mocks.NewMockTokenService(ctrl)
+		reset := user.CreatePasswordResetWithoutValidation("reset-token-hash", time.Now().Add(-time.Minute))
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, reset, false, nil)
+
+		tokenService.EXPECT().Hash("reset-token").Return("reset-token-hash")
+
+		err := u.ResetPassword("reset-token", "new password", policy, mocks.NewMockHashService(ctrl), tokenService)
+
+		assert.Equal(t, user.ErrInvalidPasswordResetToken, err)
+		assert.Equal(t, data.HashedPassword, u.HashedPassword())
+	})
+
+	t.Run("new password breaking the policy keeps the token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		tokenService := mocks.NewMockTokenService(ctrl)
+		reset := user.CreatePasswordResetWithoutValidation("reset-token-hash", time.Now().Add(time.Hour))
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, reset, false, nil)
+
+		tokenService.EXPECT().Hash("reset-token").Return("reset-token-hash")
+
+		err := u.ResetPassword("reset-token", "short", policy, mocks.NewMockHashService(ctrl), tokenService)
+
+		assert.Equal(t, user.ErrPasswordTooShort, err)
+		assert.NotNil(t, u.PasswordReset())
+	})
+}
+
+func TestUser_RehashPassword(t *testing.T) {
+	t.Run("replaces an outdated hash without revoking the tokens", func(t *testing.T) {
+		hashService := mocks.NewMockHashService(gomock.NewController(t))
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, false, nil)
+
+		hashService.EXPECT().NeedsRehash(data.HashedPassword).Return(true)
+		hashService.EXPECT().Hash(data.Password).Return("new-hash", nil)
+
+		rehashed, err := u.RehashPassword(data.Password, hashService)
+
+		assert.NoError(t, err)
+		assert.True(t, rehashed)
+		assert.Equal(t, "new-hash", u.HashedPassword())
+		assert.Equal(t, 2, u.TokenVersion())
+		assert.Empty(t, u.UncommittedEvents())
+	})
+
+	t.Run("keeps an up to date hash", func(t *testing.T) {
+		hashService := mocks.NewMockHashService(gomock.NewController(t))
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, false, nil)
+
+		hashService.EXPECT().NeedsRehash(data.HashedPassword).Return(false)
+
+		rehashed, err := u.RehashPassword(data.Password, hashService)
+
+		assert.NoError(t, err)
+		assert.False(t, rehashed)
+		assert.Equal(t, data.HashedPassword, u.HashedPassword())
+	})
+}
@@ -0,0 +1,134 @@
+package user
+
+import (
+	"meye-core/internal/domain/shared"
+	"time"
+)
+
+// RefreshToken is a long-lived credential exchanged for new access tokens.
+// Every refresh rotates it: the presented token is marked as rotated and a new one
+// of the same family is issued. Only the hash of the secret is kept.
+type RefreshToken struct {
+	id        string
+	userID    string
+	familyID  string // shared by every token descending from the same login
+	tokenHash string
+	userAgent string
+	ipAddress string
+	createdAt time.Time
+	expiresAt time.Time
+	rotatedAt *time.Time
+	revokedAt *time.Time
+}
+
+// NewRefreshToken starts a new family of refresh tokens for the user, on login.
+// The secret is returned to be handed to the client once.
+func NewRefreshToken(
+	userID, userAgent, ipAddress string,
+	ttl time.Duration,
+	identificationService shared.IdentificationService,
+	tokenService TokenService,
+) (*RefreshToken, string, error) {
+	id := identificationService.GenerateID()
+
+	return issueRefreshToken(id, id, userID, userAgent, ipAddress, ttl, tokenService)
+}
+
+func issueRefreshToken(
+	id, familyID, userID, userAgent, ipAddress string,
+	ttl time.Duration,
+	tokenService TokenService,
+) (*RefreshToken, string, error) {
+	secret, err := tokenService.Generate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+
+	t := &RefreshToken{
+		id:        id,
+		userID:    userID,
+		familyID:  familyID,
+		tokenHash: tokenService.Hash(secret),
+		userAgent: userAgent,
+		ipAddress: ipAddress,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}
+
+	return t, secret, nil
+}
+
+func CreateRefreshTokenWithoutValidation(
+	id, userID, familyID, tokenHash, userAgent, ipAddress string,
+	createdAt, expiresAt time.Time,
+	rotatedAt, revokedAt *time.Time,
+) *RefreshToken {
+	return &RefreshToken{
+		id:        id,
+		userID:    userID,
+		familyID:  familyID,
+		tokenHash: tokenHash,
+		userAgent: userAgent,
+		ipAddress: ipAddress,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		rotatedAt: rotatedAt,
+		revokedAt: revokedAt,
+	}
+}
+
+func (t *RefreshToken) ID() string            { return t.id }
+func (t *RefreshToken) UserID() string        { return t.userID }
+func (t *RefreshToken) FamilyID() string      { return t.familyID }
+func (t *RefreshToken) TokenHash() string     { return t.tokenHash }
+func (t *RefreshToken) UserAgent() string     { return t.userAgent }
+func (t *RefreshToken) IPAddress() string     { return t.ipAddress }
+func (t *RefreshToken) CreatedAt() time.Time  { return t.createdAt }
+func (t *RefreshToken) ExpiresAt() time.Time  { return t.expiresAt }
+func (t *RefreshToken) RotatedAt() *time.Time { return t.rotatedAt }
+func (t *RefreshToken) RevokedAt() *time.Time { return t.revokedAt }
+
+func (t *RefreshToken) IsRotated() bool { return t.rotatedAt != nil }
+func (t *RefreshToken) IsRevoked() bool { return t.revokedAt != nil }
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+// Rotate exchanges the token for the next one of its family.
+// A token that was already rotated has leaked or been replayed: ErrRefreshTokenReused is returned
+// and the caller is expected to revoke the whole family.
+func (t *RefreshToken) Rotate(
+	userAgent, ipAddress string,
+	ttl time.Duration,
+	identificationService shared.IdentificationService,
+	tokenService TokenService,
+) (*RefreshToken, string, error) {
+	if t.IsRotated() {
+		return nil, "", ErrRefreshTokenReused
+	}
+
+	if t.IsRevoked() || t.IsExpired(time.Now()) {
+		return nil, "", ErrInvalidRefreshToken
+	}
+
+	next, secret, err := issueRefreshToken(
+		identificationService.GenerateID(),
+		t.familyID,
+		t.userID,
+		userAgent,
+		ipAddress,
+		ttl,
+		tokenService,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rotatedAt := next.createdAt
+	t.rotatedAt = &rotatedAt
+
+	return next, secret, nil
+}
@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination=../../../tests/mocks/refresh_token_repository_mock.go -package=mocks meye-core/internal/domain/user RefreshTokenRepository
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, token *RefreshToken) error
+	// SaveRotation persists the rotation of a token and its successor atomically.
+	// It fails with ErrRefreshTokenReused when the token was rotated or revoked concurrently.
+	SaveRotation(ctx context.Context, rotated, next *RefreshToken) error
+	FindByHash(ctx context.Context, hash string) (*RefreshToken, error)
+	// RevokeFamily revokes every token of the family that is not revoked yet.
+	RevokeFamily(ctx context.Context, familyID string, at time.Time) error
+	// RevokeUserTokens revokes every refresh token of the user, whatever the family.
+	RevokeUserTokens(ctx context.Context, userID string, at time.Time) error
+}
@@ -0,0 +1,41 @@
+package user
+
+import "slices"
+
+type UserRole string
+
+const (
+	UserRoleAdmin  UserRole = "admin"
+	UserRoleMaster UserRole = "master"
+	UserRolePlayer UserRole = "player"
+)
+
+// roleOrder is the order roles are kept in, most privileged first.
+var roleOrder = []UserRole{UserRoleAdmin, UserRoleMaster, UserRolePlayer}
+
+func IsValidRole(role UserRole) bool {
+	return slices.Contains(roleOrder, role)
+}
+
+// NormalizeRoles validates a set of roles and returns it without duplicates, most privileged first,
+// so two sets holding the same roles are equal.
+func NormalizeRoles(roles []UserRole) ([]UserRole, error) {
+	if len(roles) == 0 {
+		return nil, ErrInvalidRole
+	}
+
+	for _, role := range roles {
+		if !IsValidRole(role) {
+			return nil, ErrInvalidRole
+		}
+	}
+
+	normalized := make([]UserRole, 0, len(roles))
+	for _, role := range roleOrder {
+		if slices.Contains(roles, role) {
+			normalized = append(normalized, role)
+		}
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,9 @@
+package user
+
+//go:generate mockgen -destination=../../../tests/mocks/token_service_mock.go -package=mocks meye-core/internal/domain/user TokenService
+type TokenService interface {
+	// Generate returns a new random secret, safe to put in an URL.
+	Generate() (string, error)
+	// Hash returns the deterministic digest stored in place of the secret, so it can be looked up.
+	Hash(token string) string
+}
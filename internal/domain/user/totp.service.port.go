@@ -0,0 +1,15 @@
+package user
+
+import "time"
+
+//go:generate mockgen -destination=../../../tests/mocks/totp_service_mock.go -package=mocks meye-core/internal/domain/user TOTPService
+type TOTPService interface {
+	// GenerateSecret returns a new random TOTP secret, base32 encoded.
+	GenerateSecret() (string, error)
+	// ProvisioningURI returns the otpauth:// URI of the secret, shown as a QR code to authenticator apps.
+	ProvisioningURI(secret, accountName string) string
+	// Verify returns the time step of code when it is valid around now, tolerating one step of clock drift.
+	Verify(secret, code string, now time.Time) (int64, bool)
+	// GenerateRecoveryCode returns a new random single-use code, short enough to be typed.
+	GenerateRecoveryCode() (string, error)
+}
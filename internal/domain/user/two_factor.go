@@ -0,0 +1,86 @@
+package user
+
+import (
+	"slices"
+	"strings"
+)
+
+const recoveryCodesCount = 10
+
+// TwoFactor is the TOTP second factor of a user. It is pending until a first code confirms the enrollment.
+// Only the hashes of the recovery codes are kept.
+type TwoFactor struct {
+	secret             string
+	enabled            bool
+	recoveryCodeHashes []string
+	lastUsedStep       int64 // time step of the last accepted code, so that it can't be replayed
+}
+
+func CreateTwoFactorWithoutValidation(secret string, enabled bool, recoveryCodeHashes []string, lastUsedStep int64) *TwoFactor {
+	return &TwoFactor{
+		secret:             secret,
+		enabled:            enabled,
+		recoveryCodeHashes: recoveryCodeHashes,
+		lastUsedStep:       lastUsedStep,
+	}
+}
+
+func (f *TwoFactor) Secret() string               { return f.secret }
+func (f *TwoFactor) IsEnabled() bool              { return f.enabled }
+func (f *TwoFactor) RecoveryCodeHashes() []string { return slices.Clone(f.recoveryCodeHashes) }
+func (f *TwoFactor) LastUsedStep() int64          { return f.lastUsedStep }
+
+// generateRecoveryCodes returns the codes to hand to the user once, along with their hashes.
+func generateRecoveryCodes(totpService TOTPService, tokenService TokenService) ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+
+	for range recoveryCodesCount {
+		code, err := totpService.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes = append(codes, code)
+		hashes = append(hashes, tokenService.Hash(normalizeRecoveryCode(code)))
+	}
+
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode tolerates the case and the blanks users may add when typing a code.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.Join(strings.Fields(code), ""))
+}
+
+// TwoFactorPolicy holds the roles whose users can't login without a second factor.
+type TwoFactorPolicy struct {
+	requiredRoles []UserRole // normalized, see NormalizeRoles
+}
+
+// NewTwoFactorPolicy requires a second factor from the users holding any of requiredRoles, which may be empty.
+func NewTwoFactorPolicy(requiredRoles []UserRole) (TwoFactorPolicy, error) {
+	if len(requiredRoles) == 0 {
+		return TwoFactorPolicy{}, nil
+	}
+
+	roles, err := NormalizeRoles(requiredRoles)
+	if err != nil {
+		return TwoFactorPolicy{}, err
+	}
+
+	return TwoFactorPolicy{requiredRoles: roles}, nil
+}
+
+func CreateTwoFactorPolicyWithoutValidation(requiredRoles []UserRole) TwoFactorPolicy {
+	return TwoFactorPolicy{requiredRoles: requiredRoles}
+}
+
+func (p TwoFactorPolicy) RequiredRoles() []UserRole { return slices.Clone(p.requiredRoles) }
+
+// Requires tells whether a user holding roles needs a second factor.
+func (p TwoFactorPolicy) Requires(roles []UserRole) bool {
+	return slices.ContainsFunc(roles, func(role UserRole) bool {
+		return slices.Contains(p.requiredRoles, role)
+	})
+}
@@ -0,0 +1,10 @@
+package user
+
+import "context"
+
+//go:generate mockgen -destination=../../../tests/mocks/two_factor_policy_repository_mock.go -package=mocks meye-core/internal/domain/user TwoFactorPolicyRepository
+type TwoFactorPolicyRepository interface {
+	// Get returns the current policy, which requires no second factor until an admin saves one.
+	Get(ctx context.Context) (TwoFactorPolicy, error)
+	Save(ctx context.Context, policy TwoFactorPolicy) error
+}
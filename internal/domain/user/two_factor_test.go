@@ -0,0 +1,195 @@
+package user_test
+
+import (
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const totpSecret = "totp-secret"
+
+func userWithTwoFactor(twoFactor *user.TwoFactor) *user.User {
+	return user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, twoFactor)
+}
+
+func TestUser_EnrollAndConfirmTwoFactor(t *testing.T) {
+	now := time.Now()
+
+	t.Run("confirming with a valid code enables the second factor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		totpService := mocks.NewMockTOTPService(ctrl)
+		tokenService := mocks.NewMockTokenService(ctrl)
+		u := userWithTwoFactor(nil)
+
+		totpService.EXPECT().GenerateSecret().Return(totpSecret, nil)
+		totpService.EXPECT().ProvisioningURI(totpSecret, data.Username).Return("otpauth://totp/uri")
+		totpService.EXPECT().Verify(totpSecret, "123456", now).Return(int64(42), true)
+		totpService.EXPECT().GenerateRecoveryCode().Return("AbCde-fGhij", nil).Times(10)
+		tokenService.EXPECT().Hash("abcde-fghij").Return("recovery-hash").Times(10)
+
+		secret, uri, err := u.EnrollTwoFactor(totpService)
+		assert.NoError(t, err)
+		assert.Equal(t, totpSecret, secret)
+		assert.Equal(t, "otpauth://totp/uri", uri)
+		assert.False(t, u.HasTwoFactor())
+
+		codes, err := u.ConfirmTwoFactor("123456", now, totpService, tokenService)
+
+		assert.NoError(t, err)
+		assert.Len(t, codes, 10)
+		assert.True(t, u.HasTwoFactor())
+		assert.Equal(t, int64(42), u.TwoFactor().LastUsedStep())
+		assert.Len(t, u.TwoFactor().RecoveryCodeHashes(), 10)
+		if assert.Len(t, u.UncommittedEvents(), 1) {
+			assert.Equal(t, event.EventTypeTwoFactorEnabled, u.UncommittedEvents()[0].Type())
+		}
+	})
+
+	t.Run("confirming with a wrong code keeps the enrollment pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		totpService := mocks.NewMockTOTPService(ctrl)
+		u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, false, nil, 0))
+
+		totpService.EXPECT().Verify(totpSecret, "000000", now).Return(int64(0), false)
+
+		_, err := u.ConfirmTwoFactor("000000", now, totpService, mocks.NewMockTokenService(ctrl))
+
+		assert.Equal(t, user.ErrInvalidTwoFactorCode, err)
+		assert.False(t, u.HasTwoFactor())
+	})
+
+	t.Run("enrolling again once enabled is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, true, nil, 0))
+
+		_, _, err := u.EnrollTwoFactor(mocks.NewMockTOTPService(ctrl))
+
+		assert.Equal(t, user.ErrTwoFactorAlreadyEnabled, err)
+	})
+
+	t.Run("confirming without enrolling is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		u := userWithTwoFactor(nil)
+
+		_, err := u.ConfirmTwoFactor("123456", now, mocks.NewMockTOTPService(ctrl), mocks.NewMockTokenService(ctrl))
+
+		assert.Equal(t, user.ErrTwoFactorNotEnrolled, err)
+	})
+}
+
+func TestUser_VerifyTwoFactor(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		code          string
+		step          int64
+		valid         bool
+		wantErr       error
+		wantLastStep  int64
+		wantRemaining int
+		wantEvent     bool
+	}{
+		{name: "accepts a new TOTP code", code: "123456", step: 11, valid: true, wantLastStep: 11, wantRemaining: 2},
+		{name: "rejects a replayed TOTP code", code: "123456", step: 10, valid: true, wantErr: user.ErrInvalidTwoFactorCode, wantLastStep: 10, wantRemaining: 2},
+		{name: "consumes a recovery code", code: "Recovery-One", wantLastStep: 10, wantRemaining: 1, wantEvent: true},
+		{name: "rejects a wrong code", code: "000000", wantErr: user.ErrInvalidTwoFactorCode, wantLastStep: 10, wantRemaining: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			totpService := mocks.NewMockTOTPService(ctrl)
+			tokenService := mocks.NewMockTokenService(ctrl)
+			u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, true, []string{"hash:recovery-one", "hash:recovery-two"}, 10))
+
+			totpService.EXPECT().Verify(totpSecret, tt.code, now).Return(tt.step, tt.valid)
+			tokenService.EXPECT().Hash(gomock.Any()).DoAndReturn(func(s string) string { return "hash:" + s }).AnyTimes()
+
+			err := u.VerifyTwoFactor(tt.code, now, totpService, tokenService)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantLastStep, u.TwoFactor().LastUsedStep())
+			assert.Len(t, u.TwoFactor().RecoveryCodeHashes(), tt.wantRemaining)
+			if tt.wantEvent && assert.Len(t, u.UncommittedEvents(), 1) {
+				assert.Equal(t, event.EventTypeRecoveryCodeUsed, u.UncommittedEvents()[0].Type())
+			}
+		})
+	}
+}
+
+func TestUser_DisableTwoFactor(t *testing.T) {
+	now := time.Now()
+
+	t.Run("removes the second factor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		totpService := mocks.NewMockTOTPService(ctrl)
+		u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, true, nil, 10))
+
+		totpService.EXPECT().Verify(totpSecret, "123456", now).Return(int64(11), true)
+
+		err := u.DisableTwoFactor("123456", now, user.TwoFactorPolicy{}, totpService, mocks.NewMockTokenService(ctrl))
+
+		assert.NoError(t, err)
+		assert.False(t, u.HasTwoFactor())
+		assert.Nil(t, u.TwoFactor())
+		if assert.Len(t, u.UncommittedEvents(), 1) {
+			assert.Equal(t, event.EventTypeTwoFactorDisabled, u.UncommittedEvents()[0].Type())
+		}
+	})
+
+	t.Run("is rejected when a role requires it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, true, nil, 10))
+		policy, err := user.NewTwoFactorPolicy(data.Roles)
+		assert.NoError(t, err)
+
+		err = u.DisableTwoFactor("123456", now, policy, mocks.NewMockTOTPService(ctrl), mocks.NewMockTokenService(ctrl))
+
+		assert.Equal(t, user.ErrTwoFactorRequired, err)
+		assert.True(t, u.HasTwoFactor())
+	})
+}
+
+func TestUser_ClearPendingTwoFactor(t *testing.T) {
+	t.Run("drops the pending second factor", func(t *testing.T) {
+		u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, false, nil, 0))
+
+		err := u.ClearPendingTwoFactor()
+
+		assert.NoError(t, err)
+		assert.Nil(t, u.TwoFactor())
+		if assert.Len(t, u.UncommittedEvents(), 1) {
+			assert.Equal(t, event.EventTypeTwoFactorCleared, u.UncommittedEvents()[0].Type())
+		}
+	})
+
+	t.Run("keeps an enabled second factor", func(t *testing.T) {
+		u := userWithTwoFactor(user.CreateTwoFactorWithoutValidation(totpSecret, true, nil, 10))
+
+		assert.Equal(t, user.ErrTwoFactorAlreadyEnabled, u.ClearPendingTwoFactor())
+		assert.True(t, u.HasTwoFactor())
+	})
+
+	t.Run("nothing to clear", func(t *testing.T) {
+		assert.Equal(t, user.ErrTwoFactorNotEnrolled, userWithTwoFactor(nil).ClearPendingTwoFactor())
+	})
+}
+
+func TestTwoFactorPolicy_Requires(t *testing.T) {
+	policy, err := user.NewTwoFactorPolicy([]user.UserRole{user.UserRoleAdmin})
+	assert.NoError(t, err)
+
+	assert.True(t, policy.Requires([]user.UserRole{user.UserRolePlayer, user.UserRoleAdmin}))
+	assert.False(t, policy.Requires([]user.UserRole{user.UserRolePlayer}))
+	assert.False(t, user.TwoFactorPolicy{}.Requires([]user.UserRole{user.UserRoleAdmin}))
+
+	_, err = user.NewTwoFactorPolicy([]user.UserRole{"unknown"})
+	assert.Error(t, err)
+}
@@ -3,25 +3,29 @@ package user
 import (
 	"meye-core/internal/domain/event"
 	"meye-core/internal/domain/shared"
-)
-
-type UserRole string
-
-const (
-	UserRoleAdmin  UserRole = "admin"
-	UserRoleMaster UserRole = "master"
-	UserRolePlayer UserRole = "player"
+	"slices"
+	"time"
 )
 
 type User struct {
 	id               string
 	username         string
 	hashedPassword   string
-	role             UserRole
+	roles            []UserRole // normalized, see NormalizeRoles
+	calendarToken    string     // hash of the secret of the calendar feed, empty when there is none
+	tokenVersion     int        // access tokens carrying another version are rejected
+	passwordReset    *PasswordReset
+	disabled         bool // disabled users can't login nor use their tokens
+	twoFactor        *TwoFactor
 	uncommitedEvents []event.DomainEvent
 }
 
-func NewUser(username, password string, role UserRole, identificationService shared.IdentificationService, hashService HashService) (*User, error) {
+func NewUser(username, password string, roles []UserRole, identificationService shared.IdentificationService, hashService HashService) (*User, error) {
+	roles, err := NormalizeRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+
 	id := identificationService.GenerateID()
 
 	hashedPassword, err := hashService.Hash(password)
@@ -32,7 +36,7 @@ func NewUser(username, password string, role UserRole, identificationService sha
 	u := &User{
 		id:             id,
 		username:       username,
-		role:           role,
+		roles:          roles,
 		hashedPassword: hashedPassword,
 	}
 
@@ -43,27 +47,326 @@ func NewUser(username, password string, role UserRole, identificationService sha
 
 func (u *User) ID() string                             { return u.id }
 func (u *User) Username() string                       { return u.username }
-func (u *User) Role() UserRole                         { return u.role }
+func (u *User) Roles() []UserRole                      { return slices.Clone(u.roles) }
 func (u *User) HashedPassword() string                 { return u.hashedPassword }
+func (u *User) CalendarTokenHash() string              { return u.calendarToken }
+func (u *User) TokenVersion() int                      { return u.tokenVersion }
+func (u *User) PasswordReset() *PasswordReset          { return u.passwordReset }
+func (u *User) IsDisabled() bool                       { return u.disabled }
+func (u *User) TwoFactor() *TwoFactor                  { return u.twoFactor }
 func (u *User) UncommittedEvents() []event.DomainEvent { return u.uncommitedEvents }
 
+func (u *User) HasRole(role UserRole) bool {
+	return slices.Contains(u.roles, role)
+}
+
+// HasTwoFactor tells whether the logins of the user need a second factor.
+func (u *User) HasTwoFactor() bool {
+	return u.twoFactor != nil && u.twoFactor.enabled
+}
+
 func (u *User) IsPlayer() bool {
-	return u.role == UserRolePlayer
+	return u.HasRole(UserRolePlayer)
 }
 
-func CreateUserWithoutValidation(id, username, password string, role UserRole) *User {
+func CreateUserWithoutValidation(
+	id, username, password string,
+	roles []UserRole,
+	calendarTokenHash string,
+	tokenVersion int,
+	passwordReset *PasswordReset,
+	disabled bool,
+	twoFactor *TwoFactor,
+) *User {
 	return &User{
 		id:             id,
 		username:       username,
-		role:           role,
+		roles:          roles,
 		hashedPassword: password,
+		calendarToken:  calendarTokenHash,
+		tokenVersion:   tokenVersion,
+		passwordReset:  passwordReset,
+		disabled:       disabled,
+		twoFactor:      twoFactor,
 	}
 }
 
 func (u *User) MustBePlayer() error {
-	if u.role != UserRolePlayer {
+	if !u.IsPlayer() {
 		return ErrUserNotPlayer
 	}
 
 	return nil
 }
+
+// RotateCalendarToken issues a new secret for the calendar feed, invalidating the previous one.
+// Only its hash is kept, the secret is returned to be shown once.
+func (u *User) RotateCalendarToken(tokenService TokenService) (string, error) {
+	token, err := tokenService.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	u.calendarToken = tokenService.Hash(token)
+
+	return token, nil
+}
+
+func (u *User) RevokeCalendarToken() {
+	u.calendarToken = ""
+}
+
+// RevokeAccessTokens invalidates every access token issued so far to the user,
+// even the ones that are not expired yet.
+func (u *User) RevokeAccessTokens() {
+	u.tokenVersion++
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newUserTokensRevokedEvent(u))
+}
+
+// ChangePassword replaces the password once the current one is confirmed.
+// Every access token issued so far is revoked.
+func (u *User) ChangePassword(currentPassword, newPassword string, policy PasswordPolicy, hashService HashService) error {
+	if err := hashService.Compare(currentPassword, u.hashedPassword); err != nil {
+		return ErrWrongPassword
+	}
+
+	if currentPassword == newPassword {
+		return ErrPasswordUnchanged
+	}
+
+	return u.setPassword(newPassword, false, policy, hashService)
+}
+
+// IssuePasswordReset generates a one-time token letting the user choose a new password without the current one,
+// replacing any pending reset. Every access token issued so far is revoked.
+// Only the hash of the token is kept, the token is returned to be handed to the user once.
+func (u *User) IssuePasswordReset(ttl time.Duration, tokenService TokenService) (string, time.Time, error) {
+	token, err := tokenService.Generate()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+
+	u.passwordReset = &PasswordReset{
+		tokenHash: tokenService.Hash(token),
+		expiresAt: expiresAt,
+	}
+	u.tokenVersion++
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newPasswordResetIssuedEvent(u))
+
+	return token, expiresAt, nil
+}
+
+// ResetPassword consumes the pending reset token to replace the password.
+func (u *User) ResetPassword(token, newPassword string, policy PasswordPolicy, hashService HashService, tokenService TokenService) error {
+	if u.passwordReset == nil ||
+		u.passwordReset.tokenHash != tokenService.Hash(token) ||
+		u.passwordReset.IsExpired(time.Now()) {
+		return ErrInvalidPasswordResetToken
+	}
+
+	if err := u.setPassword(newPassword, true, policy, hashService); err != nil {
+		return err
+	}
+
+	u.passwordReset = nil
+
+	return nil
+}
+
+// RehashPassword hashes again the password, already checked against the current hash, when the hash
+// uses an older algorithm or weaker parameters. The password itself doesn't change, so the tokens are kept.
+// It returns whether the hash was replaced.
+func (u *User) RehashPassword(password string, hashService HashService) (bool, error) {
+	if !hashService.NeedsRehash(u.hashedPassword) {
+		return false, nil
+	}
+
+	hashedPassword, err := hashService.Hash(password)
+	if err != nil {
+		return false, err
+	}
+
+	u.hashedPassword = hashedPassword
+
+	return true, nil
+}
+
+// EnrollTwoFactor starts the enrollment of a TOTP second factor with a new secret, replacing any pending one.
+// It returns the secret and its provisioning URI, for the user to add it to an authenticator app.
+func (u *User) EnrollTwoFactor(totpService TOTPService) (string, string, error) {
+	if u.HasTwoFactor() {
+		return "", "", ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totpService.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	u.twoFactor = &TwoFactor{secret: secret}
+
+	return secret, totpService.ProvisioningURI(secret, u.username), nil
+}
+
+// ConfirmTwoFactor enables the pending second factor once code proves the authenticator app holds its secret.
+// It returns the recovery codes, each usable once in place of a TOTP code, to be handed to the user once.
+func (u *User) ConfirmTwoFactor(code string, now time.Time, totpService TOTPService, tokenService TokenService) ([]string, error) {
+	if u.twoFactor == nil {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+
+	if u.twoFactor.enabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	step, ok := totpService.Verify(u.twoFactor.secret, code, now)
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes(totpService, tokenService)
+	if err != nil {
+		return nil, err
+	}
+
+	u.twoFactor.enabled = true
+	u.twoFactor.recoveryCodeHashes = hashes
+	u.twoFactor.lastUsedStep = step
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newTwoFactorEnabledEvent(u))
+
+	return codes, nil
+}
+
+// VerifyTwoFactor checks a code of the second factor: a TOTP code newer than the last accepted one,
+// or a recovery code, which is consumed.
+func (u *User) VerifyTwoFactor(code string, now time.Time, totpService TOTPService, tokenService TokenService) error {
+	if !u.HasTwoFactor() {
+		return ErrTwoFactorNotEnrolled
+	}
+
+	if step, ok := totpService.Verify(u.twoFactor.secret, code, now); ok && step > u.twoFactor.lastUsedStep {
+		u.twoFactor.lastUsedStep = step
+		return nil
+	}
+
+	hash := tokenService.Hash(normalizeRecoveryCode(code))
+	i := slices.Index(u.twoFactor.recoveryCodeHashes, hash)
+	if i < 0 {
+		return ErrInvalidTwoFactorCode
+	}
+
+	u.twoFactor.recoveryCodeHashes = slices.Delete(u.twoFactor.recoveryCodeHashes, i, i+1)
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newRecoveryCodeUsedEvent(u))
+
+	return nil
+}
+
+// DisableTwoFactor removes the second factor once a code confirms it, unless a role of the user requires one.
+func (u *User) DisableTwoFactor(code string, now time.Time, policy TwoFactorPolicy, totpService TOTPService, tokenService TokenService) error {
+	if !u.HasTwoFactor() {
+		return ErrTwoFactorNotEnrolled
+	}
+
+	if policy.Requires(u.roles) {
+		return ErrTwoFactorRequired
+	}
+
+	if err := u.VerifyTwoFactor(code, now, totpService, tokenService); err != nil {
+		return err
+	}
+
+	u.twoFactor = nil
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newTwoFactorDisabledEvent(u))
+
+	return nil
+}
+
+// ClearPendingTwoFactor drops a second factor that was never confirmed, so that the next login
+// enrolls a new one. It lets an admin unlock a user who lost the secret shown at enrollment.
+func (u *User) ClearPendingTwoFactor() error {
+	if u.twoFactor == nil {
+		return ErrTwoFactorNotEnrolled
+	}
+
+	if u.twoFactor.enabled {
+		return ErrTwoFactorAlreadyEnabled
+	}
+
+	u.twoFactor = nil
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newTwoFactorClearedEvent(u))
+
+	return nil
+}
+
+func (u *User) setPassword(password string, reset bool, policy PasswordPolicy, hashService HashService) error {
+	if err := policy.Validate(password); err != nil {
+		return err
+	}
+
+	hashedPassword, err := hashService.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	u.hashedPassword = hashedPassword
+	u.tokenVersion++
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newPasswordChangedEvent(u, reset))
+
+	return nil
+}
+
+// Disable locks the user out: it can't login anymore and its access tokens are revoked.
+func (u *User) Disable() {
+	if u.disabled {
+		return
+	}
+
+	u.disabled = true
+	u.tokenVersion++
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newUserDisabledEvent(u))
+}
+
+func (u *User) Enable() {
+	if !u.disabled {
+		return
+	}
+
+	u.disabled = false
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newUserEnabledEvent(u))
+}
+
+// ChangeRoles replaces the roles of the user. Access tokens carrying the previous roles are rejected
+// until the client refreshes them.
+func (u *User) ChangeRoles(roles []UserRole) error {
+	roles, err := NormalizeRoles(roles)
+	if err != nil {
+		return err
+	}
+
+	if slices.Equal(u.roles, roles) {
+		return nil
+	}
+
+	previous := u.roles
+	u.roles = roles
+
+	u.uncommitedEvents = append(u.uncommitedEvents, newUserRolesChangedEvent(u, previous))
+
+	return nil
+}
+
+// Delete records the deletion of the user, the repository removes it.
+func (u *User) Delete() {
+	u.uncommitedEvents = append(u.uncommitedEvents, newUserDeletedEvent(u))
+}
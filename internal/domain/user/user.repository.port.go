@@ -2,10 +2,32 @@ package user
 
 import "context"
 
+// UserFilter narrows the users listed by Repository.Find, zero values don't filter.
+// Role matches the users holding it, among others.
+type UserFilter struct {
+	Role           UserRole
+	UsernamePrefix string
+	Page           int
+	Size           int
+}
+
 //go:generate mockgen -destination=../../../tests/mocks/user_repository_mock.go -package=mocks -mock_names=Repository=MockUserRepository meye-core/internal/domain/user Repository
 type Repository interface {
 	Save(ctx context.Context, user *User) error
+	// SaveResetPassword saves the user once its reset token, still pending and not expired, is consumed in the
+	// same transaction. It returns ErrInvalidPasswordResetToken, saving nothing, when the token was used meanwhile.
+	SaveResetPassword(ctx context.Context, user *User, tokenHash string) error
+	// SaveTwoFactorUse saves the user once a code of its second factor was accepted, provided the second factor
+	// is still as previous was loaded. It returns ErrInvalidTwoFactorCode, saving nothing, when a concurrent login
+	// accepted a code meanwhile, so that no code is accepted twice.
+	SaveTwoFactorUse(ctx context.Context, user *User, previous *TwoFactor) error
 	FindByUsername(ctx context.Context, username string) (*User, error)
 	FindByID(ctx context.Context, id string) (*User, error)
+	FindByCalendarTokenHash(ctx context.Context, hash string) (*User, error)
+	FindByPasswordResetTokenHash(ctx context.Context, hash string) (*User, error)
+	// FindByRole returns the users holding role, among others.
 	FindByRole(ctx context.Context, role UserRole, page, size int) ([]*User, error)
+	Find(ctx context.Context, filter UserFilter) ([]*User, error)
+	// Delete removes the user along with every row referencing it.
+	Delete(ctx context.Context, user *User) error
 }
@@ -0,0 +1,98 @@
+package user_test
+
+import (
+	"meye-core/internal/domain/event"
+	"meye-core/internal/domain/user"
+	"meye-core/tests/data"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_Disable(t *testing.T) {
+	t.Run("locks the user out and revokes its access tokens", func(t *testing.T) {
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, false, nil)
+
+		u.Disable()
+
+		assert.True(t, u.IsDisabled())
+		assert.Equal(t, 3, u.TokenVersion())
+		assert.Len(t, u.UncommittedEvents(), 1)
+		assert.Equal(t, event.EventTypeUserDisabled, u.UncommittedEvents()[0].Type())
+	})
+
+	t.Run("does nothing when already disabled", func(t *testing.T) {
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, true, nil)
+
+		u.Disable()
+
+		assert.True(t, u.IsDisabled())
+		assert.Equal(t, 2, u.TokenVersion())
+		assert.Empty(t, u.UncommittedEvents())
+	})
+}
+
+func TestUser_Enable(t *testing.T) {
+	u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 2, nil, true, nil)
+
+	u.Enable()
+	u.Enable()
+
+	assert.False(t, u.IsDisabled())
+	assert.Equal(t, 2, u.TokenVersion())
+	assert.Len(t, u.UncommittedEvents(), 1)
+	assert.Equal(t, event.EventTypeUserEnabled, u.UncommittedEvents()[0].Type())
+}
+
+func TestUser_ChangeRoles(t *testing.T) {
+	player := []user.UserRole{user.UserRolePlayer}
+
+	tests := []struct {
+		name       string
+		roles      []user.UserRole
+		wantRoles  []user.UserRole
+		wantErr    error
+		wantEvents int
+	}{
+		{
+			name:       "normalizes the new roles",
+			roles:      []user.UserRole{user.UserRolePlayer, user.UserRoleMaster, user.UserRolePlayer},
+			wantRoles:  []user.UserRole{user.UserRoleMaster, user.UserRolePlayer},
+			wantEvents: 1,
+		},
+		{name: "same roles raise no event", roles: player, wantRoles: player},
+		{name: "no role", roles: []user.UserRole{}, wantRoles: player, wantErr: user.ErrInvalidRole},
+		{name: "unknown role", roles: []user.UserRole{user.UserRoleMaster, "superuser"}, wantRoles: player, wantErr: user.ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, player, "", 0, nil, false, nil)
+
+			err := u.ChangeRoles(tt.roles)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantRoles, u.Roles())
+			assert.Len(t, u.UncommittedEvents(), tt.wantEvents)
+		})
+	}
+
+	t.Run("event carries both role sets", func(t *testing.T) {
+		u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, player, "", 0, nil, false, nil)
+
+		assert.NoError(t, u.ChangeRoles([]user.UserRole{user.UserRoleAdmin, user.UserRolePlayer}))
+
+		evt, ok := u.UncommittedEvents()[0].(user.UserRolesChangedEvent)
+		assert.True(t, ok)
+		assert.Equal(t, player, evt.PreviousRoles())
+		assert.Equal(t, []user.UserRole{user.UserRoleAdmin, user.UserRolePlayer}, evt.Roles())
+	})
+}
+
+func TestUser_MustBePlayer(t *testing.T) {
+	masterAndPlayer := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, []user.UserRole{user.UserRoleMaster, user.UserRolePlayer}, "", 0, nil, false, nil)
+	master := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, []user.UserRole{user.UserRoleMaster}, "", 0, nil, false, nil)
+
+	assert.NoError(t, masterAndPlayer.MustBePlayer())
+	assert.Equal(t, user.ErrUserNotPlayer, master.MustBePlayer())
+}
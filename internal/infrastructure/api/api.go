@@ -1,6 +1,7 @@
 package api
 
 import (
+	"meye-core/internal/domain/user"
 	"meye-core/internal/infrastructure/api/handler"
 	customValidator "meye-core/internal/infrastructure/api/validator"
 	"net/http"
@@ -23,6 +24,9 @@ type Handlers struct {
 	UserHandler     *handler.UserHandler
 	AuthHandler     *handler.AuthHandler
 	CampaignHandler *handler.CampaignHandler
+	SessionHandler  *handler.SessionHandler
+	JWKSHandler     *handler.JWKSHandler
+	APIKeyHandler   *handler.APIKeyHandler
 }
 
 func init() {
@@ -31,8 +35,15 @@ func init() {
 	}
 }
 
-func NewRouter(handlers *Handlers, allowedOrigins []string) *Router {
-	engine := gin.Default()
+// NewRouter builds the routes. The client IP, used by the login throttling, is only read from the
+// forwarded headers of the trusted proxies, otherwise from the connection.
+func NewRouter(handlers *Handlers, allowedOrigins, trustedProxies []string) (*Router, error) {
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, err
+	}
+
+	engine.Use(gin.LoggerWithFormatter(logFormatter))
 	engine.Use(gin.Recovery())
 	engine.Use(cors.New(cors.Config{
 		AllowOrigins:     allowedOrigins,
@@ -50,7 +61,7 @@ func NewRouter(handlers *Handlers, allowedOrigins []string) *Router {
 
 	router.setupRoutes()
 
-	return router
+	return router, nil
 }
 
 func (r *Router) healthCheck(c *gin.Context) {
@@ -62,12 +73,17 @@ func (r *Router) healthCheck(c *gin.Context) {
 
 func (r *Router) setupRoutes() {
 	r.engine.GET("/health", r.healthCheck)
+	r.engine.GET("/.well-known/jwks.json", r.handlers.JWKSHandler.GetJWKS)
 
 	v1 := r.engine.Group("/api/v1")
 	r.setupUserRoutes(v1)
 	r.setupCampaignRoutes(v1)
 	r.setupPjRoutes(v1)
 	r.setupInvitationRoutes(v1)
+	r.setupRulesetRoutes(v1)
+	r.setupSessionRoutes(v1)
+	r.setupCalendarRoutes(v1)
+	r.setupAPIKeyRoutes(v1)
 }
 
 func (r *Router) setupUserRoutes(group *gin.RouterGroup) {
@@ -79,8 +95,18 @@ func (r *Router) setupUserRoutes(group *gin.RouterGroup) {
 			r.handlers.UserHandler.CreateUser,
 		)
 		users.POST("/login", r.handlers.UserHandler.Login)
-		users.GET("/players",
+		users.POST("/login/2fa", r.handlers.UserHandler.VerifyLogin)
+		users.POST("/token/refresh", r.handlers.UserHandler.RefreshToken)
+		users.POST("/logout",
 			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.Logout,
+		)
+		users.POST("/logout-all",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.LogoutEverywhere,
+		)
+		users.GET("/players",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeUsersRead),
 			r.handlers.AuthHandler.RequireMasterRole(),
 			r.handlers.UserHandler.GetPlayers,
 		)
@@ -88,34 +114,143 @@ func (r *Router) setupUserRoutes(group *gin.RouterGroup) {
 			r.handlers.AuthHandler.AuthMiddleware(),
 			r.handlers.UserHandler.GetUser,
 		)
+		users.POST("/self/calendar-token",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.RotateCalendarToken,
+		)
+		users.DELETE("/self/calendar-token",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.RevokeCalendarToken,
+		)
+		users.PUT("/self/password",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.ChangePassword,
+		)
+		users.POST("/self/2fa",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.EnrollTwoFactor,
+		)
+		users.POST("/self/2fa/confirm",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.ConfirmTwoFactor,
+		)
+		users.POST("/self/2fa/disable",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.UserHandler.DisableTwoFactor,
+		)
+		users.GET("/2fa/policy",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.GetTwoFactorPolicy,
+		)
+		users.PUT("/2fa/policy",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.UpdateTwoFactorPolicy,
+		)
+		users.POST("/password/reset", r.handlers.UserHandler.ResetPassword)
+		users.POST("/:userID/password-reset",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.IssuePasswordReset,
+		)
+		users.GET("",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeUsersRead),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.ListUsers,
+		)
+		users.POST("/:userID/disable",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.DisableUser,
+		)
+		users.DELETE("/:userID/2fa",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.ClearTwoFactor,
+		)
+		users.POST("/:userID/enable",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.EnableUser,
+		)
+		users.PUT("/:userID/role",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.ChangeUserRoles,
+		)
+		users.DELETE("/:userID",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireAdminRole(),
+			r.handlers.UserHandler.DeleteUser,
+		)
 	}
 }
 
+// setupCampaignRoutes authenticates each route on its own, as some of them accept API keys.
 func (r *Router) setupCampaignRoutes(group *gin.RouterGroup) {
 	campaigns := group.Group("/campaigns")
-	campaigns.Use(r.handlers.AuthHandler.AuthMiddleware())
 	{
 		campaigns.POST("",
+			r.handlers.AuthHandler.AuthMiddleware(),
 			r.handlers.AuthHandler.RequireMasterRole(),
 			r.handlers.CampaignHandler.CreateCampaign,
 		)
 		campaigns.POST("/:campaignID/invitations",
+			r.handlers.AuthHandler.AuthMiddleware(),
 			r.handlers.AuthHandler.RequireCampaignMaster(),
 			r.handlers.CampaignHandler.InviteUser,
 		)
 		campaigns.POST("/:campaignID/pjs",
+			r.handlers.AuthHandler.AuthMiddleware(),
 			r.handlers.AuthHandler.RequirePlayerRole(),
 			r.handlers.CampaignHandler.CreatePJ,
 		)
 		campaigns.POST("/:campaignID/sessions",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsWrite),
 			r.handlers.AuthHandler.RequireCampaignMaster(),
 			r.handlers.CampaignHandler.CreateSession,
 		)
+		campaigns.GET("/:campaignID/sessions",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsRead),
+			r.handlers.SessionHandler.ListSessions,
+		)
+		campaigns.GET("/:campaignID/search",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsRead),
+			r.handlers.SessionHandler.SearchSessions,
+		)
+		campaigns.PUT("/:campaignID/sessions/:sessionID",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsWrite),
+			r.handlers.AuthHandler.RequireCampaignMaster(),
+			r.handlers.SessionHandler.AmendSession,
+		)
+		campaigns.POST("/:campaignID/sessions/:sessionID/finalize",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsWrite),
+			r.handlers.AuthHandler.RequireCampaignMaster(),
+			r.handlers.SessionHandler.FinalizeSession,
+		)
+		campaigns.PUT("/:campaignID/sessions/:sessionID/schedule",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsWrite),
+			r.handlers.AuthHandler.RequireCampaignMaster(),
+			r.handlers.SessionHandler.RescheduleSession,
+		)
 		campaigns.GET("/:campaignID",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeCampaignsRead),
 			r.handlers.AuthHandler.RequireCampaignMaster(),
 			r.handlers.CampaignHandler.GetCampaign,
 		)
+		campaigns.GET("/:campaignID/house-rules",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireCampaignMaster(),
+			r.handlers.CampaignHandler.GetHouseRules,
+		)
+		campaigns.PUT("/:campaignID/house-rules",
+			r.handlers.AuthHandler.AuthMiddleware(),
+			r.handlers.AuthHandler.RequireCampaignMaster(),
+			r.handlers.CampaignHandler.UpdateHouseRules,
+		)
 		campaigns.GET("",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeCampaignsRead),
 			r.handlers.AuthHandler.RequireMasterRole(),
 			r.handlers.CampaignHandler.GetCampaignsBasicInfo,
 		)
@@ -130,6 +265,14 @@ func (r *Router) setupPjRoutes(group *gin.RouterGroup) {
 			r.handlers.AuthHandler.RequirePjUser(),
 			r.handlers.CampaignHandler.UpdatePJStats,
 		)
+		pjs.POST("/:pjID/skills",
+			r.handlers.AuthHandler.RequirePjUser(),
+			r.handlers.CampaignHandler.LearnSkill,
+		)
+		pjs.POST("/:pjID/skills/:skillID/transformations",
+			r.handlers.AuthHandler.RequirePjUser(),
+			r.handlers.CampaignHandler.UnlockTransformation,
+		)
 		pjs.GET("/:pjID",
 			r.handlers.CampaignHandler.GetPj,
 			r.handlers.AuthHandler.RequirePjUser(),
@@ -149,6 +292,56 @@ func (r *Router) setupInvitationRoutes(group *gin.RouterGroup) {
 
 }
 
+func (r *Router) setupRulesetRoutes(group *gin.RouterGroup) {
+	rulesets := group.Group("/rulesets")
+	rulesets.Use(r.handlers.AuthHandler.AuthMiddleware())
+	{
+		rulesets.GET("",
+			r.handlers.AuthHandler.RequireMasterRole(),
+			r.handlers.CampaignHandler.GetRulesets,
+		)
+	}
+}
+
+func (r *Router) setupSessionRoutes(group *gin.RouterGroup) {
+	sessions := group.Group("/sessions")
+	{
+		sessions.GET("/:sessionID",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsRead),
+			r.handlers.SessionHandler.GetSession,
+		)
+		sessions.GET("/:sessionID/recap",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsRead),
+			r.handlers.SessionHandler.GetSessionRecap,
+		)
+		sessions.PUT("/:sessionID/rsvp",
+			r.handlers.AuthHandler.AuthMiddleware(user.APIKeyScopeSessionsWrite),
+			r.handlers.SessionHandler.RSVPSession,
+		)
+	}
+}
+
+// setupCalendarRoutes exposes the feeds for calendar clients, which can't send a bearer token.
+// The secret token in the path authenticates the user instead, and is redacted from the logs.
+func (r *Router) setupCalendarRoutes(group *gin.RouterGroup) {
+	calendar := group.Group("/calendar")
+	{
+		calendar.GET("/:token/sessions.ics", r.handlers.SessionHandler.GetCalendarFeed)
+	}
+}
+
+// setupAPIKeyRoutes lets the admins manage the API keys, which can't be used to manage keys themselves.
+func (r *Router) setupAPIKeyRoutes(group *gin.RouterGroup) {
+	apiKeys := group.Group("/api-keys")
+	apiKeys.Use(r.handlers.AuthHandler.AuthMiddleware())
+	apiKeys.Use(r.handlers.AuthHandler.RequireAdminRole())
+	{
+		apiKeys.POST("", r.handlers.APIKeyHandler.CreateAPIKey)
+		apiKeys.GET("", r.handlers.APIKeyHandler.ListAPIKeys)
+		apiKeys.DELETE("/:apiKeyID", r.handlers.APIKeyHandler.RevokeAPIKey)
+	}
+}
+
 // Engine returns the Gin engine
 func (r *Router) Engine() *gin.Engine {
 	return r.engine
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"meye-core/internal/application/user"
+	dto "meye-core/internal/infrastructure/api/handler/dto/user"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler lets the admins manage the API keys of the service accounts.
+type APIKeyHandler struct {
+	createAPIKeyUseCase user.CreateAPIKeyUseCase
+	listAPIKeysUseCase  user.ListAPIKeysUseCase
+	revokeAPIKeyUseCase user.RevokeAPIKeyUseCase
+}
+
+func NewAPIKeyHandler(
+	createAPIKeyUseCase user.CreateAPIKeyUseCase,
+	listAPIKeysUseCase user.ListAPIKeysUseCase,
+	revokeAPIKeyUseCase user.RevokeAPIKeyUseCase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createAPIKeyUseCase: createAPIKeyUseCase,
+		listAPIKeysUseCase:  listAPIKeysUseCase,
+		revokeAPIKeyUseCase: revokeAPIKeyUseCase,
+	}
+}
+
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var reqBody dto.CreateAPIKeyInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.CreateAPIKeyInput{
+		Name:      reqBody.Name,
+		UserID:    reqBody.UserID,
+		Scopes:    reqBody.Scopes,
+		ExpiresAt: reqBody.ExpiresAt,
+	}
+
+	output, err := h.createAPIKeyUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.MapCreatedAPIKeyOutput(output))
+}
+
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	output, err := h.listAPIKeysUseCase.Execute(c.Request.Context())
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	data := make([]dto.APIKeyOutputBody, 0, len(output))
+	for _, k := range output {
+		data = append(data, dto.MapAPIKeyOutput(k))
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	var pathParams dto.APIKeyPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.revokeAPIKeyUseCase.Execute(c.Request.Context(), pathParams.APIKeyID); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
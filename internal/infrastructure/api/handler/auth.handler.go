@@ -6,16 +6,18 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
 )
 
 type AuthHandler struct {
-	apiKey             string
-	jwtService         user.JWTService
-	userRepository     user.Repository
-	campaignRepository campaign.Repository
-	pjRepository       campaign.PjRepository
+	jwtService             user.JWTService
+	authQueryService       user.AuthQueryService
+	membershipQueryService campaign.MembershipQueryService
+	apiKeyRepository       user.APIKeyRepository
+	tokenService           user.TokenService
 }
 
 type responseError struct {
@@ -28,69 +30,134 @@ var forbiddenError = responseError{Error: "Forbidden"}
 
 const AuthKey = "auth"
 
+// APIKeyHeader carries the API key of a service account, in place of a bearer token.
+const APIKeyHeader = "X-API-Key"
+
 func NewAuthHandler(
-	apiKey string,
 	jwtService user.JWTService,
-	userRepo user.Repository,
-	campaignRepo campaign.Repository,
-	pjRepo campaign.PjRepository,
+	authQueryServ user.AuthQueryService,
+	membershipQueryServ campaign.MembershipQueryService,
+	apiKeyRepo user.APIKeyRepository,
+	tokenServ user.TokenService,
 ) *AuthHandler {
 	return &AuthHandler{
-		apiKey:             apiKey,
-		jwtService:         jwtService,
-		userRepository:     userRepo,
-		campaignRepository: campaignRepo,
-		pjRepository:       pjRepo,
+		jwtService:             jwtService,
+		authQueryService:       authQueryServ,
+		membershipQueryService: membershipQueryServ,
+		apiKeyRepository:       apiKeyRepo,
+		tokenService:           tokenServ,
 	}
 }
 
-func (h *AuthHandler) InternalAPIKeyMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		apiKey := c.GetHeader("Api-Key")
+type AuthContext struct {
+	UserID string
+	Roles  []user.UserRole
+}
 
-		if apiKey != h.apiKey {
-			c.JSON(http.StatusUnauthorized, unauthorizedError)
-			c.Abort()
+// AuthMiddleware is a Gin middleware that authenticates the request and sets the AuthContext.
+// A JWT is always accepted. An API key is only accepted when the route lists scopes,
+// and the key must hold all of them; the request then acts as the service account of the key.
+func (h *AuthHandler) AuthMiddleware(scopes ...user.APIKeyScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" && len(scopes) > 0 {
+			h.authenticateAPIKey(c, apiKey, scopes)
 			return
 		}
 
-		c.Next()
+		h.authenticateJWT(c)
 	}
 }
 
-type AuthContext struct {
-	UserID string
+// authenticateJWT validates the bearer token and sets its claims in the context.
+func (h *AuthHandler) authenticateJWT(c *gin.Context) {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	tokenString := parts[1]
+	claims, err := h.jwtService.ValidateToken(tokenString)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	// Tokens issued before the last logout everywhere or roles change are stale,
+	// the client has to refresh them to get the current claims. Both role sets are normalized.
+	state, err := h.authQueryService.FindAuthState(c.Request.Context(), claims.UserID)
+	if err != nil || state == nil || state.Disabled || state.TokenVersion != claims.TokenVersion {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	// Tokens issued before the roles claim existed carry none, they keep working with the
+	// current roles of the user until they expire
+	roles := claims.Roles
+	if roles == nil {
+		roles = state.Roles
+	}
+
+	if !slices.Equal(state.Roles, roles) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth := AuthContext{
+		UserID: claims.UserID,
+		Roles:  roles,
+	}
+
+	c.Set(AuthKey, auth)
+	c.Next()
 }
 
-// AuthMiddleware is a Gin middleware that validates a JWT and sets the claims in the context.
-func (h *AuthHandler) AuthMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
-			return
-		}
+// authenticateAPIKey looks the key up by its hash and sets its service account in the context.
+// The roles are read from the account at each request, so the route checks still apply to the key.
+func (h *AuthHandler) authenticateAPIKey(c *gin.Context, apiKey string, scopes []user.APIKeyScope) {
+	ctx := c.Request.Context()
+	now := time.Now()
 
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
-			return
-		}
+	key, err := h.apiKeyRepository.FindByHash(ctx, h.tokenService.Hash(apiKey))
+	if err != nil || key == nil || !key.IsActive(now) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
 
-		tokenString := parts[1]
-		userID, err := h.jwtService.ValidateToken(tokenString)
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+	state, err := h.authQueryService.FindAuthState(ctx, key.UserID())
+	if err != nil || state == nil || state.Disabled {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	for _, scope := range scopes {
+		if !key.HasScope(scope) {
+			c.Abort()
+			respondMappedError(c, user.ErrMissingAPIKeyScope)
 			return
 		}
+	}
 
-		auth := AuthContext{
-			UserID: userID,
+	// The last use is informative, failing to record it doesn't fail the request
+	if key.RecordUse(now) {
+		if err := h.apiKeyRepository.TouchLastUsed(ctx, key.ID(), now); err != nil {
+			logrus.WithContext(ctx).Warn(err)
 		}
+	}
 
-		c.Set(AuthKey, auth)
-		c.Next()
+	auth := AuthContext{
+		UserID: key.UserID(),
+		Roles:  state.Roles,
 	}
+
+	c.Set(AuthKey, auth)
+	c.Next()
 }
 
 // RequireRole is a generic Gin middleware that checks if the authenticated user has any of the specified roles.
@@ -111,21 +178,10 @@ func (h *AuthHandler) RequireRole(allowedRoles ...user.UserRole) gin.HandlerFunc
 			return
 		}
 
-		// Fetch user from repository
-		usr, err := h.userRepository.FindByID(c.Request.Context(), auth.UserID)
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
-			return
-		}
-
-		if usr == nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
-			return
-		}
-
-		// Check if user has any of the allowed roles
-		userRole := usr.Role()
-		if slices.Contains(allowedRoles, userRole) {
+		// The roles come from the claims, AuthMiddleware already checked they are current
+		if slices.ContainsFunc(auth.Roles, func(role user.UserRole) bool {
+			return slices.Contains(allowedRoles, role)
+		}) {
 			c.Next()
 			return
 		}
@@ -175,20 +231,19 @@ func (h *AuthHandler) RequireCampaignMaster() gin.HandlerFunc {
 			return
 		}
 
-		// Fetch campaign from repository
-		cmp, err := h.campaignRepository.FindByID(c.Request.Context(), campaignID)
+		masterID, err := h.membershipQueryService.FindCampaignMasterID(c.Request.Context(), campaignID)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusInternalServerError, responseError{Error: "Failed to retrieve campaign", Code: "FAILED_TO_RETRIEVE_CAMPAIGN"})
 			return
 		}
 
-		if cmp == nil {
+		if masterID == "" {
 			c.AbortWithStatusJSON(http.StatusNotFound, responseError{Error: "Campaign not found", Code: "CAMPAIGN_NOT_FOUND"})
 			return
 		}
 
 		// Check if authenticated user is the campaign master
-		if cmp.MasterID() != auth.UserID {
+		if masterID != auth.UserID {
 			c.AbortWithStatusJSON(http.StatusForbidden, forbiddenError)
 			return
 		}
@@ -218,18 +273,18 @@ func (h *AuthHandler) RequirePjUser() gin.HandlerFunc {
 			return
 		}
 
-		pj, err := h.pjRepository.FindByID(c.Request.Context(), pjID)
+		ownerID, err := h.membershipQueryService.FindPjOwnerID(c.Request.Context(), pjID)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusInternalServerError, responseError{Error: "Failed to retrieve pj", Code: "FAILED_TO_RETRIEVE_CAMPAIGN"})
 			return
 		}
 
-		if pj == nil {
+		if ownerID == "" {
 			c.AbortWithStatusJSON(http.StatusNotFound, responseError{Error: "PJ not found", Code: "PJ_NOT_FOUND"})
 			return
 		}
 
-		if pj.UserID() != auth.UserID {
+		if ownerID != auth.UserID {
 			c.AbortWithStatusJSON(http.StatusForbidden, forbiddenError)
 			return
 		}
@@ -0,0 +1,49 @@
+package handler_test
+
+import (
+	"meye-core/internal/domain/user"
+	"meye-core/internal/infrastructure/api/handler"
+	"meye-core/internal/infrastructure/cache"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthHandler_AuthMiddleware_CachedState(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	jwtService := mocks.NewMockJWTService(ctrl)
+	next := mocks.NewMockAuthQueryService(ctrl)
+
+	// The state is loaded once, the second request is checked against the cached entry
+	next.EXPECT().FindAuthState(gomock.Any(), data.UserID).Return(&user.AuthState{Roles: data.Roles, TokenVersion: 2}, nil).Times(1)
+	jwtService.EXPECT().ValidateToken("current").Return(user.AccessClaims{UserID: data.UserID, Roles: data.Roles, TokenVersion: 2}, nil)
+	jwtService.EXPECT().ValidateToken("revoked").Return(user.AccessClaims{UserID: data.UserID, Roles: data.Roles, TokenVersion: 1}, nil)
+
+	authHandler := handler.NewAuthHandler(jwtService, cache.NewAuthQueryService(next, time.Minute), nil, nil, nil)
+
+	engine := gin.New()
+	engine.GET("/", authHandler.AuthMiddleware(), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	serve := func(token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve("current"))
+	assert.Equal(t, http.StatusUnauthorized, serve("revoked"))
+}
@@ -20,6 +20,11 @@ type CampaignHandler struct {
 	getCampaignsUseCase   campaign.GetCampaignsUseCase
 	getPjsUseCase         campaign.GetPjsUseCase
 	getInvitations        campaign.GetInvitationsUseCase
+	learnSkillUseCase     campaign.LearnSkillUseCase
+	unlockTransformation  campaign.UnlockTransformationUseCase
+	updateHouseRules      campaign.UpdateHouseRulesUseCase
+	getHouseRules         campaign.GetHouseRulesUseCase
+	getRulesets           campaign.GetRulesetsUseCase
 }
 
 func NewCampaignHandler(
@@ -33,6 +38,11 @@ func NewCampaignHandler(
 	getCampaignsUseCase campaign.GetCampaignsUseCase,
 	getPjsUseCase campaign.GetPjsUseCase,
 	getInvitations campaign.GetInvitationsUseCase,
+	learnSkillUseCase campaign.LearnSkillUseCase,
+	unlockTransformation campaign.UnlockTransformationUseCase,
+	updateHouseRules campaign.UpdateHouseRulesUseCase,
+	getHouseRules campaign.GetHouseRulesUseCase,
+	getRulesets campaign.GetRulesetsUseCase,
 ) *CampaignHandler {
 	return &CampaignHandler{
 		createCampaignUseCase: createCampaignUseCase,
@@ -45,6 +55,11 @@ func NewCampaignHandler(
 		getCampaignsUseCase:   getCampaignsUseCase,
 		getPjsUseCase:         getPjsUseCase,
 		getInvitations:        getInvitations,
+		learnSkillUseCase:     learnSkillUseCase,
+		unlockTransformation:  unlockTransformation,
+		updateHouseRules:      updateHouseRules,
+		getHouseRules:         getHouseRules,
+		getRulesets:           getRulesets,
 	}
 }
 
@@ -69,10 +84,7 @@ func (h *CampaignHandler) CreateCampaign(c *gin.Context) {
 		return
 	}
 
-	input := campaign.CreateCampaignInput{
-		Name:     reqBody.Name,
-		MasterID: auth.UserID,
-	}
+	input := dto.MapCreateCampaignInput(auth.UserID, reqBody)
 
 	output, err := h.createCampaignUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
@@ -161,6 +173,7 @@ func (h *CampaignHandler) CreatePJ(c *gin.Context) {
 			IsMentalSkillsTalented:   reqBody.IsMentalSkillsTalented,
 			IsEnergySkillsTalented:   reqBody.IsEnergySkillsTalented,
 			IsEnergyTalented:         reqBody.IsEnergyTalented,
+			InitialStats:             dto.MapInitialStatsInput(reqBody.InitialStats),
 		},
 	}
 
@@ -192,6 +205,7 @@ func (h *CampaignHandler) CreateSession(c *gin.Context) {
 	for _, xpA := range reqBody.XPAssignations {
 		xpAss = append(xpAss, session.XPAssignation{
 			PjID: xpA.PjID,
+			Kind: xpA.Kind,
 			Amounts: session.XPAmounts{
 				Basic:        xpA.Amounts.Basic,
 				Special:      xpA.Amounts.Special,
@@ -201,9 +215,18 @@ func (h *CampaignHandler) CreateSession(c *gin.Context) {
 		})
 	}
 
+	var schedule *session.Schedule
+	if reqBody.Schedule != nil {
+		sessionSchedule := dto.MapScheduleInput(*reqBody.Schedule)
+		schedule = &sessionSchedule
+	}
+
 	input := session.CreateSessionInput{
 		CampaignID:     pathParams.CampaignID,
 		Summary:        reqBody.Summary,
+		Draft:          reqBody.Draft,
+		Schedule:       schedule,
+		Attendees:      reqBody.Attendees,
 		XPAssignations: xpAss,
 	}
 
@@ -242,6 +265,58 @@ func (h *CampaignHandler) UpdatePJStats(c *gin.Context) {
 	c.JSON(http.StatusOK, dto.MapPJOutputBody(output))
 }
 
+func (h *CampaignHandler) LearnSkill(c *gin.Context) {
+	var pathParams dto.PJPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.LearnSkillInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := dto.MapLearnSkillInput(pathParams, reqBody)
+
+	output, err := h.learnSkillUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.MapPJOutputBody(output))
+}
+
+func (h *CampaignHandler) UnlockTransformation(c *gin.Context) {
+	var pathParams dto.SkillPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.UnlockTransformationInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := dto.MapUnlockTransformationInput(pathParams, reqBody)
+
+	output, err := h.unlockTransformation.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.MapPJOutputBody(output))
+}
+
 func (h *CampaignHandler) GetCampaign(c *gin.Context) {
 	var pathParams dto.CampaignPathParams
 
@@ -259,6 +334,47 @@ func (h *CampaignHandler) GetCampaign(c *gin.Context) {
 	c.JSON(http.StatusOK, dto.MapCampaignOutputBody(output))
 }
 
+func (h *CampaignHandler) UpdateHouseRules(c *gin.Context) {
+	var pathParams dto.CampaignPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.HouseRulesBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.updateHouseRules.Execute(c.Request.Context(), dto.MapUpdateHouseRulesInput(pathParams, reqBody))
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapHouseRulesBody(output))
+}
+
+func (h *CampaignHandler) GetHouseRules(c *gin.Context) {
+	var pathParams dto.CampaignPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.getHouseRules.Execute(c.Request.Context(), pathParams.CampaignID)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapHouseRulesBody(output))
+}
+
 func (h *CampaignHandler) GetPj(c *gin.Context) {
 	var pathParams dto.PJPathParams
 
@@ -303,6 +419,21 @@ func (h *CampaignHandler) GetCampaignsBasicInfo(c *gin.Context) {
 	c.JSON(http.StatusOK, outputBody)
 }
 
+func (h *CampaignHandler) GetRulesets(c *gin.Context) {
+	output, err := h.getRulesets.Execute(c.Request.Context())
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	outputBody := make([]dto.RulesetBody, 0, len(output))
+	for _, o := range output {
+		outputBody = append(outputBody, dto.MapRulesetBody(o))
+	}
+
+	c.JSON(http.StatusOK, outputBody)
+}
+
 func (h *CampaignHandler) GetPjs(c *gin.Context) {
 	authValue, exists := c.Get(AuthKey)
 	if !exists {
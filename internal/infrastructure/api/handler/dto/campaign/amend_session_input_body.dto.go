@@ -0,0 +1,39 @@
+package campaign
+
+import "meye-core/internal/application/session"
+
+type CampaignSessionPathParams struct {
+	CampaignID string `uri:"campaignID" binding:"required"`
+	SessionID  string `uri:"sessionID" binding:"required"`
+}
+
+// AmendSessionInputBody replaces the whole session content, XP assignations included.
+type AmendSessionInputBody struct {
+	Summary        string          `json:"summary"`
+	Attendees      []string        `json:"attendees" binding:"omitempty,dive,uuid"`
+	XPAssignations []XPAssignation `json:"xp_assignations" binding:"omitempty,dive"`
+}
+
+func MapAmendSessionInput(pathParams CampaignSessionPathParams, body AmendSessionInputBody) session.AmendSessionInput {
+	xpAssignations := make([]session.XPAssignation, 0, len(body.XPAssignations))
+	for _, xpA := range body.XPAssignations {
+		xpAssignations = append(xpAssignations, session.XPAssignation{
+			PjID: xpA.PjID,
+			Kind: xpA.Kind,
+			Amounts: session.XPAmounts{
+				Basic:        xpA.Amounts.Basic,
+				Special:      xpA.Amounts.Special,
+				SuperNatural: xpA.Amounts.SuperNatural,
+			},
+			Reason: xpA.Reason,
+		})
+	}
+
+	return session.AmendSessionInput{
+		CampaignID:     pathParams.CampaignID,
+		SessionID:      pathParams.SessionID,
+		Summary:        body.Summary,
+		Attendees:      body.Attendees,
+		XPAssignations: xpAssignations,
+	}
+}
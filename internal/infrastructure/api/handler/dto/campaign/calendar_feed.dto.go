@@ -0,0 +1,34 @@
+package campaign
+
+import (
+	"meye-core/internal/application/session"
+	"meye-core/internal/infrastructure/ical"
+)
+
+const calendarProdID = "-//meye-core//sessions//EN"
+
+type CalendarPathParams struct {
+	Token string `uri:"token" binding:"required"`
+}
+
+// MapCalendarFeed builds the iCalendar of the feed. The UID derives from the session ID so
+// clients update the event in place instead of duplicating it.
+func MapCalendarFeed(output session.CalendarFeedOutput) ical.Calendar {
+	events := make([]ical.Event, 0, len(output.Events))
+	for _, e := range output.Events {
+		events = append(events, ical.Event{
+			UID:      e.SessionID + "@meye-core",
+			Sequence: e.Sequence,
+			Start:    e.StartsAt,
+			End:      e.EndsAt,
+			Summary:  e.CampaignName,
+			Location: e.Location,
+		})
+	}
+
+	return ical.Calendar{
+		ProdID: calendarProdID,
+		Name:   "Sessions of " + output.Username,
+		Events: events,
+	}
+}
@@ -6,6 +6,9 @@ type CampaignOutputBody struct {
 	ID          string                 `json:"id"`
 	Name        string                 `json:"name"`
 	MasterID    string                 `json:"master_id"`
+	StartingXP  XPBody                 `json:"starting_xp"`
+	HouseRules  HouseRulesBody         `json:"house_rules"`
+	Ruleset     RulesetBody            `json:"ruleset"`
 	Invitations []InvitationOutputBody `json:"invitations"`
 	PJs         []PJOutputBody         `json:"pjs"`
 	Sessions    []SessionOutput        `json:"sessions"`
@@ -31,6 +34,9 @@ func MapCampaignOutputBody(c campaign.CampaignOutput) CampaignOutputBody {
 		ID:          c.ID,
 		Name:        c.Name,
 		MasterID:    c.MasterID,
+		StartingXP:  MapXPBody(c.StartingXP),
+		HouseRules:  MapHouseRulesBody(c.HouseRules),
+		Ruleset:     MapRulesetBody(c.Ruleset),
 		Invitations: invitations,
 		PJs:         pjs,
 		Sessions:    sessions,
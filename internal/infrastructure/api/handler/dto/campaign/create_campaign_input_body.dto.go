@@ -1,5 +1,33 @@
 package campaign
 
+import "meye-core/internal/application/campaign"
+
 type CreateCampaignInputBody struct {
-	Name string `json:"name" binding:"required"`
+	Name       string       `json:"name" binding:"required"`
+	StartingXP *XPInputBody `json:"starting_xp"`
+	Ruleset    string       `json:"ruleset"`
+}
+
+type XPInputBody struct {
+	Basic        uint `json:"basic"`
+	Special      uint `json:"special"`
+	Supernatural uint `json:"supernatural"`
+}
+
+func MapCreateCampaignInput(masterID string, body CreateCampaignInputBody) campaign.CreateCampaignInput {
+	input := campaign.CreateCampaignInput{
+		Name:     body.Name,
+		MasterID: masterID,
+		Ruleset:  body.Ruleset,
+	}
+
+	if body.StartingXP != nil {
+		input.StartingXP = campaign.XP{
+			Basic:        body.StartingXP.Basic,
+			Special:      body.StartingXP.Special,
+			Supernatural: body.StartingXP.Supernatural,
+		}
+	}
+
+	return input
 }
@@ -7,12 +7,17 @@ type XPAmounts struct {
 }
 
 type XPAssignation struct {
-	PjID    string    `json:"pj_id" binding:"required,uuid"`
+	PjID string `json:"pj_id" binding:"required,uuid"`
+	// Lines sent as attendance are ignored, the session generates them from the attendees
+	Kind    string    `json:"kind" binding:"omitempty,oneof=attendance bonus"`
 	Amounts XPAmounts `json:"amounts" binding:"required"`
 	Reason  string    `json:"reason"`
 }
 
 type CreateSessionInputBody struct {
 	Summary        string          `json:"summary"`
+	Draft          bool            `json:"draft"`
+	Schedule       *ScheduleBody   `json:"schedule"`
+	Attendees      []string        `json:"attendees" binding:"omitempty,dive,uuid"`
 	XPAssignations []XPAssignation `json:"xp_assignations" binding:"omitempty,dive"`
 }
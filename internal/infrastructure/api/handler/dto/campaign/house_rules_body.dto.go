@@ -0,0 +1,51 @@
+package campaign
+
+import "meye-core/internal/application/campaign"
+
+// HouseRulesBody is used both as request and response body of the house rules endpoints.
+type HouseRulesBody struct {
+	MaxTalents       uint            `json:"max_talents"`
+	ExclusiveTalents [][]string      `json:"exclusive_talents"`
+	StatCaps         map[string]uint `json:"stat_caps"`
+	AttendanceXP     XPInputBody     `json:"attendance_xp"`  // base XP every session attendee earns
+	SessionXPCap     XPInputBody     `json:"session_xp_cap"` // maximum XP a PJ earns in a session
+}
+
+func MapHouseRulesBody(hr campaign.HouseRules) HouseRulesBody {
+	return HouseRulesBody{
+		MaxTalents:       hr.MaxTalents,
+		ExclusiveTalents: hr.ExclusiveTalents,
+		StatCaps:         hr.StatCaps,
+		AttendanceXP: XPInputBody{
+			Basic:        hr.AttendanceXP.Basic,
+			Special:      hr.AttendanceXP.Special,
+			Supernatural: hr.AttendanceXP.Supernatural,
+		},
+		SessionXPCap: XPInputBody{
+			Basic:        hr.SessionXPCap.Basic,
+			Special:      hr.SessionXPCap.Special,
+			Supernatural: hr.SessionXPCap.Supernatural,
+		},
+	}
+}
+
+func MapUpdateHouseRulesInput(pathParams CampaignPathParams, body HouseRulesBody) campaign.UpdateHouseRulesInput {
+	return campaign.UpdateHouseRulesInput{
+		CampaignID: pathParams.CampaignID,
+		HouseRules: campaign.HouseRules{
+			MaxTalents:       body.MaxTalents,
+			ExclusiveTalents: body.ExclusiveTalents,
+			StatCaps:         body.StatCaps,
+			AttendanceXP: campaign.XP{
+				Basic:        body.AttendanceXP.Basic,
+				Special:      body.AttendanceXP.Special,
+				Supernatural: body.AttendanceXP.Supernatural,
+			},
+			SessionXPCap: campaign.XP{
+				Basic:        body.SessionXPCap.Basic,
+				Special:      body.SessionXPCap.Special,
+				Supernatural: body.SessionXPCap.Supernatural,
+			},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package campaign
+
+import "meye-core/internal/application/campaign"
+
+type LearnSkillInputBody struct {
+	Name                      string `json:"name" binding:"required,max=100"`
+	Description               string `json:"description" binding:"max=1000"`
+	TransformationName        string `json:"transformation_name" binding:"max=100"`
+	TransformationDescription string `json:"transformation_description" binding:"max=1000"`
+}
+
+func MapLearnSkillInput(pathParams PJPathParams, body LearnSkillInputBody) campaign.LearnSkillInput {
+	return campaign.LearnSkillInput{
+		PjID:                      pathParams.PJID,
+		Name:                      body.Name,
+		Description:               body.Description,
+		TransformationName:        body.TransformationName,
+		TransformationDescription: body.TransformationDescription,
+	}
+}
@@ -0,0 +1,58 @@
+package campaign
+
+import (
+	"meye-core/internal/application/session"
+	"time"
+)
+
+const defaultSessionsLimit = 20
+
+type ListSessionsQuery struct {
+	Cursor string    `form:"cursor"`
+	Limit  int       `form:"limit" binding:"omitempty,min=1,max=100"`
+	From   time.Time `form:"from" time_format:"2006-01-02"`
+	To     time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+// MapListSessionsInput maps the query, where both dates are inclusive.
+func MapListSessionsInput(pathParams CampaignPathParams, userID string, query ListSessionsQuery) session.ListSessionsInput {
+	input := session.ListSessionsInput{
+		CampaignID: pathParams.CampaignID,
+		UserID:     userID,
+		Cursor:     query.Cursor,
+		Limit:      query.Limit,
+	}
+
+	if input.Limit == 0 {
+		input.Limit = defaultSessionsLimit
+	}
+
+	if !query.From.IsZero() {
+		from := query.From
+		input.From = &from
+	}
+
+	if !query.To.IsZero() {
+		to := query.To.AddDate(0, 0, 1)
+		input.To = &to
+	}
+
+	return input
+}
+
+type SessionsPageOutputBody struct {
+	Data       []SessionOutput `json:"data"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+func MapSessionsPageOutputBody(output session.SessionsPageOutput) SessionsPageOutputBody {
+	data := make([]SessionOutput, 0, len(output.Sessions))
+	for _, s := range output.Sessions {
+		data = append(data, MapSessionOutput(s))
+	}
+
+	return SessionsPageOutputBody{
+		Data:       data,
+		NextCursor: output.NextCursor,
+	}
+}
@@ -90,7 +90,19 @@ type SupernaturalStatsBody struct {
 }
 
 type SkillBody struct {
-	Transformations []uint `json:"transformations"`
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description"`
+	UnlockCost      uint                 `json:"unlock_cost"`
+	Transformations []TransformationBody `json:"transformations"`
+}
+
+type TransformationBody struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Level       uint   `json:"level"`
+	UnlockCost  uint   `json:"unlock_cost"`
 }
 
 func MapPJOutputBody(output campaign.PJOutput) PJOutputBody {
@@ -152,16 +164,8 @@ func MapPJOutputBody(output campaign.PJOutput) PJOutputBody {
 			EnergyTank:       output.SpecialStats.EnergyTank,
 			IsEnergyTalented: output.SpecialStats.IsEnergyTalented,
 		},
-		XP: XPBody{
-			Basic:        output.XP.Basic,
-			Special:      output.XP.Special,
-			Supernatural: output.XP.Supernatural,
-		},
-		SpentXP: XPBody{
-			Basic:        output.SpentXP.Basic,
-			Special:      output.SpentXP.Special,
-			Supernatural: output.SpentXP.Supernatural,
-		},
+		XP:      MapXPBody(output.XP),
+		SpentXP: MapXPBody(output.SpentXP),
 	}
 
 	// SupernaturalStats (optional)
@@ -170,8 +174,23 @@ func MapPJOutputBody(output campaign.PJOutput) PJOutputBody {
 			Skills: make([]SkillBody, len(output.SupernaturalStats.Skills)),
 		}
 		for i, skill := range output.SupernaturalStats.Skills {
+			transformations := make([]TransformationBody, len(skill.Transformations))
+			for j, t := range skill.Transformations {
+				transformations[j] = TransformationBody{
+					ID:          t.ID,
+					Name:        t.Name,
+					Description: t.Description,
+					Level:       t.Level,
+					UnlockCost:  t.UnlockCost,
+				}
+			}
+
 			supernaturalStats.Skills[i] = SkillBody{
-				Transformations: skill.Transformations,
+				ID:              skill.ID,
+				Name:            skill.Name,
+				Description:     skill.Description,
+				UnlockCost:      skill.UnlockCost,
+				Transformations: transformations,
 			}
 		}
 		body.SupernaturalStats = supernaturalStats
@@ -179,3 +198,11 @@ func MapPJOutputBody(output campaign.PJOutput) PJOutputBody {
 
 	return body
 }
+
+func MapXPBody(xp campaign.XP) XPBody {
+	return XPBody{
+		Basic:        xp.Basic,
+		Special:      xp.Special,
+		Supernatural: xp.Supernatural,
+	}
+}
@@ -0,0 +1,35 @@
+package campaign
+
+import "meye-core/internal/application/campaign"
+
+type RulesetBody struct {
+	Name                          string `json:"name"`
+	BasicLevelStep                uint   `json:"basic_level_step"`
+	BasicFirstLevelCost           uint   `json:"basic_first_level_cost"`
+	BasicTalentedFirstLevelCost   uint   `json:"basic_talented_first_level_cost"`
+	LifeCost                      uint   `json:"life_cost"`
+	SpecialLevelStep              uint   `json:"special_level_step"`
+	SpecialFirstLevelCost         uint   `json:"special_first_level_cost"`
+	SpecialTalentedFirstLevelCost uint   `json:"special_talented_first_level_cost"`
+	EnergyTankCost                uint   `json:"energy_tank_cost"`
+	EnergyTankTalentedCost        uint   `json:"energy_tank_talented_cost"`
+	SupernaturalLevelStep         uint   `json:"supernatural_level_step"`
+	SupernaturalFirstLevelCost    uint   `json:"supernatural_first_level_cost"`
+}
+
+func MapRulesetBody(r campaign.Ruleset) RulesetBody {
+	return RulesetBody{
+		Name:                          r.Name,
+		BasicLevelStep:                r.BasicLevelStep,
+		BasicFirstLevelCost:           r.BasicFirstLevelCost,
+		BasicTalentedFirstLevelCost:   r.BasicTalentedFirstLevelCost,
+		LifeCost:                      r.LifeCost,
+		SpecialLevelStep:              r.SpecialLevelStep,
+		SpecialFirstLevelCost:         r.SpecialFirstLevelCost,
+		SpecialTalentedFirstLevelCost: r.SpecialTalentedFirstLevelCost,
+		EnergyTankCost:                r.EnergyTankCost,
+		EnergyTankTalentedCost:        r.EnergyTankTalentedCost,
+		SupernaturalLevelStep:         r.SupernaturalLevelStep,
+		SupernaturalFirstLevelCost:    r.SupernaturalFirstLevelCost,
+	}
+}
@@ -0,0 +1,70 @@
+package campaign
+
+import (
+	"meye-core/internal/application/session"
+	"time"
+)
+
+const defaultSearchLimit = 20
+
+type SearchSessionsQuery struct {
+	Q     string `form:"q" binding:"required,max=200"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+func MapSearchSessionsInput(pathParams CampaignPathParams, userID string, query SearchSessionsQuery) session.SearchSessionsInput {
+	input := session.SearchSessionsInput{
+		CampaignID: pathParams.CampaignID,
+		UserID:     userID,
+		Query:      query.Q,
+		Limit:      query.Limit,
+	}
+
+	if input.Limit == 0 {
+		input.Limit = defaultSearchLimit
+	}
+
+	return input
+}
+
+type ReasonMatchOutput struct {
+	PjID    string `json:"pj_id"`
+	Snippet string `json:"snippet"`
+}
+
+type SearchResultOutput struct {
+	SessionID      string              `json:"session_id"`
+	Status         string              `json:"status"`
+	CreatedAt      time.Time           `json:"created_at"`
+	Rank           float64             `json:"rank"`
+	SummarySnippet string              `json:"summary_snippet,omitempty"`
+	ReasonMatches  []ReasonMatchOutput `json:"reason_matches"`
+}
+
+type SearchSessionsOutputBody struct {
+	Data []SearchResultOutput `json:"data"`
+}
+
+func MapSearchSessionsOutputBody(output session.SearchSessionsOutput) SearchSessionsOutputBody {
+	data := make([]SearchResultOutput, 0, len(output.Results))
+	for _, result := range output.Results {
+		matches := make([]ReasonMatchOutput, 0, len(result.ReasonMatches))
+		for _, m := range result.ReasonMatches {
+			matches = append(matches, ReasonMatchOutput{
+				PjID:    m.PjID,
+				Snippet: m.Snippet,
+			})
+		}
+
+		data = append(data, SearchResultOutput{
+			SessionID:      result.SessionID,
+			Status:         result.Status,
+			CreatedAt:      result.CreatedAt,
+			Rank:           result.Rank,
+			SummarySnippet: result.SummarySnippet,
+			ReasonMatches:  matches,
+		})
+	}
+
+	return SearchSessionsOutputBody{Data: data}
+}
@@ -9,7 +9,11 @@ type SessionOutput struct {
 	ID             string          `json:"id"`
 	CampaignID     string          `json:"campaign_id"`
 	Summary        string          `json:"summary"`
+	Status         string          `json:"status"`
+	Attendees      []string        `json:"attendees"`
 	XPAssignations []XPAssignation `json:"xp_assignations"`
+	Schedule       *ScheduleBody   `json:"schedule,omitempty"`
+	RSVPs          []RSVPOutput    `json:"rsvps"`
 	CreatedAt      time.Time       `json:"created_at"`
 }
 
@@ -18,6 +22,7 @@ func MapSessionOutput(output session.SessionOutput) SessionOutput {
 	for _, xpA := range output.XPAssignations {
 		xpAss = append(xpAss, XPAssignation{
 			PjID: xpA.PjID,
+			Kind: xpA.Kind,
 			Amounts: XPAmounts{
 				Basic:        xpA.Amounts.Basic,
 				Special:      xpA.Amounts.Special,
@@ -27,11 +32,28 @@ func MapSessionOutput(output session.SessionOutput) SessionOutput {
 		})
 	}
 
+	rsvps := make([]RSVPOutput, 0, len(output.RSVPs))
+	for _, rsvp := range output.RSVPs {
+		rsvps = append(rsvps, RSVPOutput{
+			UserID:   rsvp.UserID,
+			Response: rsvp.Response,
+		})
+	}
+
+	attendees := output.Attendees
+	if attendees == nil {
+		attendees = []string{}
+	}
+
 	return SessionOutput{
 		ID:             output.ID,
 		CampaignID:     output.CampaignID,
 		Summary:        output.Summary,
+		Status:         output.Status,
+		Attendees:      attendees,
 		XPAssignations: xpAss,
+		Schedule:       MapScheduleBody(output.Schedule),
+		RSVPs:          rsvps,
 		CreatedAt:      output.CreatedAt,
 	}
 }
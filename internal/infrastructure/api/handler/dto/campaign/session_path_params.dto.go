@@ -0,0 +1,5 @@
+package campaign
+
+type SessionPathParams struct {
+	SessionID string `uri:"sessionID" binding:"required"`
+}
@@ -0,0 +1,73 @@
+package campaign
+
+import (
+	"meye-core/internal/application/session"
+	"meye-core/internal/infrastructure/recap"
+)
+
+type SessionRecapQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=md html"`
+}
+
+// RecapFormat defaults to Markdown.
+func (q SessionRecapQuery) RecapFormat() recap.Format {
+	if q.Format == "" {
+		return recap.FormatMarkdown
+	}
+
+	return recap.Format(q.Format)
+}
+
+func mapRecapXPAmounts(amounts session.XPAmounts) recap.XPAmounts {
+	return recap.XPAmounts{
+		Basic:        amounts.Basic,
+		Special:      amounts.Special,
+		Supernatural: amounts.SuperNatural,
+	}
+}
+
+func MapSessionRecap(output session.SessionRecapOutput) recap.Recap {
+	attendees := make([]string, 0, len(output.Attendees))
+	for _, pj := range output.Attendees {
+		attendees = append(attendees, pj.Name)
+	}
+
+	xpAssignations := make([]recap.XPAssignation, 0, len(output.XPAssignations))
+	for _, xpA := range output.XPAssignations {
+		xpAssignations = append(xpAssignations, recap.XPAssignation{
+			PJ:      xpA.PJ.Name,
+			Kind:    xpA.Kind,
+			Amounts: mapRecapXPAmounts(xpA.Amounts),
+			Reason:  xpA.Reason,
+		})
+	}
+
+	statsUpgrades := make([]recap.StatsUpgrade, 0, len(output.StatsUpgrades))
+	for _, upgrade := range output.StatsUpgrades {
+		changes := make([]recap.StatChange, 0, len(upgrade.Changes))
+		for _, change := range upgrade.Changes {
+			changes = append(changes, recap.StatChange{
+				Stat:     change.Stat,
+				Previous: change.Previous,
+				Current:  change.Current,
+			})
+		}
+
+		statsUpgrades = append(statsUpgrades, recap.StatsUpgrade{
+			PJ:         upgrade.PJ.Name,
+			SpentXP:    mapRecapXPAmounts(upgrade.SpentXP),
+			Changes:    changes,
+			OccurredAt: upgrade.OccurredAt,
+		})
+	}
+
+	return recap.Recap{
+		CampaignName:   output.CampaignName,
+		Summary:        output.Summary,
+		Status:         output.Status,
+		PlayedAt:       output.PlayedAt,
+		Attendees:      attendees,
+		XPAssignations: xpAssignations,
+		StatsUpgrades:  statsUpgrades,
+	}
+}
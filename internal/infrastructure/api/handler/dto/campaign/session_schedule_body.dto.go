@@ -0,0 +1,42 @@
+package campaign
+
+import (
+	"meye-core/internal/application/session"
+	"time"
+)
+
+// ScheduleBody is used both as request and response body of planned sessions.
+type ScheduleBody struct {
+	StartsAt        time.Time `json:"starts_at" binding:"required"`
+	DurationMinutes uint      `json:"duration_minutes" binding:"required,min=1"`
+	Location        string    `json:"location"` // place or link of the game
+}
+
+func MapScheduleInput(body ScheduleBody) session.Schedule {
+	return session.Schedule{
+		StartsAt:        body.StartsAt,
+		DurationMinutes: body.DurationMinutes,
+		Location:        body.Location,
+	}
+}
+
+func MapScheduleBody(schedule *session.Schedule) *ScheduleBody {
+	if schedule == nil {
+		return nil
+	}
+
+	return &ScheduleBody{
+		StartsAt:        schedule.StartsAt,
+		DurationMinutes: schedule.DurationMinutes,
+		Location:        schedule.Location,
+	}
+}
+
+type RSVPInputBody struct {
+	Response string `json:"response" binding:"required,oneof=yes no maybe"`
+}
+
+type RSVPOutput struct {
+	UserID   string `json:"user_id"`
+	Response string `json:"response"`
+}
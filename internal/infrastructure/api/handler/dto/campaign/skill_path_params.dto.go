@@ -0,0 +1,6 @@
+package campaign
+
+type SkillPathParams struct {
+	PJID    string `uri:"pjID" binding:"required"`
+	SkillID string `uri:"skillID" binding:"required"`
+}
@@ -0,0 +1,17 @@
+package campaign
+
+import "meye-core/internal/application/campaign"
+
+type UnlockTransformationInputBody struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=1000"`
+}
+
+func MapUnlockTransformationInput(pathParams SkillPathParams, body UnlockTransformationInputBody) campaign.UnlockTransformationInput {
+	return campaign.UnlockTransformationInput{
+		PjID:        pathParams.PJID,
+		SkillID:     pathParams.SkillID,
+		Name:        body.Name,
+		Description: body.Description,
+	}
+}
@@ -59,60 +59,49 @@ type EnergySkillsInputBody struct {
 }
 
 type SupernaturalStatsInputBody struct {
-	Skills []SkillInputBody `json:"skills" binding:"required"`
+	Skills []SkillInputBody `json:"skills" binding:"required,dive"`
 }
 
 type SkillInputBody struct {
-	Transformations []uint `json:"transformations" binding:"required"`
+	ID              string                    `json:"id" binding:"required"`
+	Name            string                    `json:"name" binding:"max=100"`
+	Description     string                    `json:"description" binding:"max=1000"`
+	Transformations []TransformationInputBody `json:"transformations" binding:"required,dive"`
+}
+
+type TransformationInputBody struct {
+	ID          string `json:"id" binding:"required"`
+	Name        string `json:"name" binding:"max=100"`
+	Description string `json:"description" binding:"max=1000"`
+	Level       uint   `json:"level"`
 }
 
 func MapUpdatePJStatsInput(pathParams PJPathParams, body UpdatePJStatsInputBody) campaign.UpdatePjStatsInput {
 	input := campaign.UpdatePjStatsInput{
-		PjID: pathParams.PJID, // Only PJID needed
-		Basic: campaign.BasicStats{
-			Physical: campaign.Physical{
-				Strength:   body.BasicStats.Physical.Strength,
-				Agility:    body.BasicStats.Physical.Agility,
-				Speed:      body.BasicStats.Physical.Speed,
-				Resistance: body.BasicStats.Physical.Resistance,
-			},
-			Mental: campaign.Mental{
-				Inteligence:   body.BasicStats.Mental.Intelligence,
-				Wisdom:        body.BasicStats.Mental.Wisdom,
-				Concentration: body.BasicStats.Mental.Concentration,
-				Will:          body.BasicStats.Mental.Will,
-			},
-			Coordination: campaign.Coordination{
-				Precision:   body.BasicStats.Coordination.Precision,
-				Calculation: body.BasicStats.Coordination.Calculation,
-				Range:       body.BasicStats.Coordination.Range,
-				Reflexes:    body.BasicStats.Coordination.Reflexes,
-			},
-			Life: body.BasicStats.Life,
-		},
-		Special: campaign.SpecialStats{
-			Physical: campaign.PhysicalSkills{
-				Empowerment:  body.SpecialStats.Physical.Empowerment,
-				VitalControl: body.SpecialStats.Physical.VitalControl,
-			},
-			Mental: campaign.MentalSkills{
-				Ilusion:       body.SpecialStats.Mental.Illusion,
-				MentalControl: body.SpecialStats.Mental.MentalControl,
-			},
-			Energy: campaign.EnergySkills{
-				ObjectHandling: body.SpecialStats.Energy.ObjectHandling,
-				EnergyHandling: body.SpecialStats.Energy.EnergyHandling,
-			},
-			EnergyTank: body.SpecialStats.EnergyTank,
-		},
+		PjID:    pathParams.PJID, // Only PJID needed
+		Basic:   mapBasicStatsInput(body.BasicStats),
+		Special: mapSpecialStatsInput(body.SpecialStats),
 	}
 
 	// Map supernatural stats if provided
 	if body.SupernaturalStats != nil {
 		skills := make([]campaign.Skill, len(body.SupernaturalStats.Skills))
 		for i, skill := range body.SupernaturalStats.Skills {
+			transformations := make([]campaign.Transformation, len(skill.Transformations))
+			for j, t := range skill.Transformations {
+				transformations[j] = campaign.Transformation{
+					ID:          t.ID,
+					Name:        t.Name,
+					Description: t.Description,
+					Level:       t.Level,
+				}
+			}
+
 			skills[i] = campaign.Skill{
-				Transformations: skill.Transformations,
+				ID:              skill.ID,
+				Name:            skill.Name,
+				Description:     skill.Description,
+				Transformations: transformations,
 			}
 		}
 		input.Supernatural = &campaign.SupernaturalStats{
@@ -122,3 +111,45 @@ func MapUpdatePJStatsInput(pathParams PJPathParams, body UpdatePJStatsInputBody)
 
 	return input
 }
+
+func mapBasicStatsInput(body BasicStatsInputBody) campaign.BasicStats {
+	return campaign.BasicStats{
+		Physical: campaign.Physical{
+			Strength:   body.Physical.Strength,
+			Agility:    body.Physical.Agility,
+			Speed:      body.Physical.Speed,
+			Resistance: body.Physical.Resistance,
+		},
+		Mental: campaign.Mental{
+			Inteligence:   body.Mental.Intelligence,
+			Wisdom:        body.Mental.Wisdom,
+			Concentration: body.Mental.Concentration,
+			Will:          body.Mental.Will,
+		},
+		Coordination: campaign.Coordination{
+			Precision:   body.Coordination.Precision,
+			Calculation: body.Coordination.Calculation,
+			Range:       body.Coordination.Range,
+			Reflexes:    body.Coordination.Reflexes,
+		},
+		Life: body.Life,
+	}
+}
+
+func mapSpecialStatsInput(body SpecialStatsInputBody) campaign.SpecialStats {
+	return campaign.SpecialStats{
+		Physical: campaign.PhysicalSkills{
+			Empowerment:  body.Physical.Empowerment,
+			VitalControl: body.Physical.VitalControl,
+		},
+		Mental: campaign.MentalSkills{
+			Ilusion:       body.Mental.Illusion,
+			MentalControl: body.Mental.MentalControl,
+		},
+		Energy: campaign.EnergySkills{
+			ObjectHandling: body.Energy.ObjectHandling,
+			EnergyHandling: body.Energy.EnergyHandling,
+		},
+		EnergyTank: body.EnergyTank,
+	}
+}
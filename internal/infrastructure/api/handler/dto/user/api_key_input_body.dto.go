@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"meye-core/internal/domain/user"
+	"time"
+)
+
+type CreateAPIKeyInputBody struct {
+	Name string `json:"name" binding:"required,max=255"`
+	// The service account the key acts as, its roles still apply to the requests
+	UserID    string             `json:"user_id" binding:"required"`
+	Scopes    []user.APIKeyScope `json:"scopes" binding:"required,min=1,dive,apikeyscope"`
+	ExpiresAt time.Time          `json:"expires_at" binding:"required"`
+}
+
+type APIKeyPathParams struct {
+	APIKeyID string `uri:"apiKeyID" binding:"required"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+type APIKeyOutputBody struct {
+	ID         string                   `json:"id"`
+	Name       string                   `json:"name"`
+	UserID     string                   `json:"user_id"`
+	Prefix     string                   `json:"prefix"`
+	Scopes     []domainuser.APIKeyScope `json:"scopes"`
+	CreatedAt  time.Time                `json:"created_at"`
+	ExpiresAt  time.Time                `json:"expires_at"`
+	LastUsedAt *time.Time               `json:"last_used_at"`
+	RevokedAt  *time.Time               `json:"revoked_at"`
+}
+
+// CreatedAPIKeyOutputBody is the only response holding the key itself.
+type CreatedAPIKeyOutputBody struct {
+	APIKeyOutputBody
+	Key string `json:"key"`
+}
+
+func MapAPIKeyOutput(output applicationuser.APIKeyOutput) APIKeyOutputBody {
+	return APIKeyOutputBody{
+		ID:         output.ID,
+		Name:       output.Name,
+		UserID:     output.UserID,
+		Prefix:     output.Prefix,
+		Scopes:     output.Scopes,
+		CreatedAt:  output.CreatedAt,
+		ExpiresAt:  output.ExpiresAt,
+		LastUsedAt: output.LastUsedAt,
+		RevokedAt:  output.RevokedAt,
+	}
+}
+
+func MapCreatedAPIKeyOutput(output applicationuser.CreatedAPIKeyOutput) CreatedAPIKeyOutputBody {
+	return CreatedAPIKeyOutputBody{
+		APIKeyOutputBody: MapAPIKeyOutput(output.APIKey),
+		Key:              output.Secret,
+	}
+}
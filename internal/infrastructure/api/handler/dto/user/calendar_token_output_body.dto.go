@@ -0,0 +1,15 @@
+package dto
+
+import "fmt"
+
+type CalendarTokenOutputBody struct {
+	Token   string `json:"token"`
+	FeedURL string `json:"feed_url"` // relative to the API host
+}
+
+func MapCalendarTokenOutput(token string) CalendarTokenOutputBody {
+	return CalendarTokenOutputBody{
+		Token:   token,
+		FeedURL: fmt.Sprintf("/api/v1/calendar/%s/sessions.ics", token),
+	}
+}
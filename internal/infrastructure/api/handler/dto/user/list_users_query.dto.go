@@ -0,0 +1,9 @@
+package dto
+
+import "meye-core/internal/domain/user"
+
+type ListUsersQuery struct {
+	Pagination
+	Role           user.UserRole `form:"role" binding:"omitempty,userrole"`
+	UsernamePrefix string        `form:"username" binding:"omitempty,max=100"`
+}
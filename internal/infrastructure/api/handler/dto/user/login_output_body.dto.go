@@ -1,11 +1,15 @@
 package dto
 
+import "meye-core/internal/application/user"
+
 type LoginOutputBody struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
-func MapLoginOutput(token string) LoginOutputBody {
+func MapLoginOutput(output user.TokensOutput) LoginOutputBody {
 	return LoginOutputBody{
-		Token: token,
+		Token:        output.AccessToken,
+		RefreshToken: output.RefreshToken,
 	}
 }
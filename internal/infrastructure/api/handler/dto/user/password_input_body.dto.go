@@ -0,0 +1,13 @@
+package dto
+
+// The password policy is enforced by the domain, only bcrypt's limit is checked here.
+
+type ChangePasswordInputBody struct {
+	CurrentPassword string `json:"current_password" binding:"required,max=72"`
+	NewPassword     string `json:"new_password" binding:"required,max=72"`
+}
+
+type ResetPasswordInputBody struct {
+	Token       string `json:"token" binding:"required,max=255"`
+	NewPassword string `json:"new_password" binding:"required,max=72"`
+}
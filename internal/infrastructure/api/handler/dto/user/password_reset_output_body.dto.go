@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"meye-core/internal/application/user"
+	"time"
+)
+
+type PasswordResetOutputBody struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func MapPasswordResetOutput(output user.PasswordResetOutput) PasswordResetOutputBody {
+	return PasswordResetOutputBody{
+		Token:     output.Token,
+		ExpiresAt: output.ExpiresAt,
+	}
+}
@@ -0,0 +1,6 @@
+package dto
+
+// RefreshTokenInputBody carries the refresh token both to refresh and to logout.
+type RefreshTokenInputBody struct {
+	RefreshToken string `json:"refresh_token" binding:"required,max=255"`
+}
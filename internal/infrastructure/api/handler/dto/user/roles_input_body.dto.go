@@ -0,0 +1,18 @@
+package dto
+
+import "meye-core/internal/domain/user"
+
+// RolesInputBody accepts either a set of roles or the single role sent before users could hold several.
+type RolesInputBody struct {
+	Role  user.UserRole   `json:"role" binding:"required_without=Roles,omitempty,userrole"`
+	Roles []user.UserRole `json:"roles" binding:"required_without=Role,omitempty,min=1,dive,userrole"`
+}
+
+// RequestedRoles returns the roles of the body, roles taking precedence over role.
+func (b RolesInputBody) RequestedRoles() []user.UserRole {
+	if len(b.Roles) > 0 {
+		return b.Roles
+	}
+
+	return []user.UserRole{b.Role}
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "meye-core/internal/domain/user"
+
+type VerifyLoginInputBody struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	// A TOTP code or a recovery code
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+type TwoFactorCodeInputBody struct {
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+// TwoFactorPolicyInputBody replaces the roles requiring a second factor, an empty list requires none.
+type TwoFactorPolicyInputBody struct {
+	RequiredRoles []user.UserRole `json:"required_roles" binding:"required,dive,userrole"`
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	applicationuser "meye-core/internal/application/user"
+	domainuser "meye-core/internal/domain/user"
+	"time"
+)
+
+// LoginChallengeOutputBody answers a login needing a second factor, the challenge token is sent back with the code.
+type LoginChallengeOutputBody struct {
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	ChallengeToken    string    `json:"challenge_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+	// Only set when a role requires a second factor the user didn't enroll yet
+	Enrollment *TwoFactorEnrollmentOutputBody `json:"enrollment,omitempty"`
+}
+
+type TwoFactorEnrollmentOutputBody struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type VerifyLoginOutputBody struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	// Only set when the login confirmed the enrollment of the second factor
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+type RecoveryCodesOutputBody struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TwoFactorPolicyOutputBody struct {
+	RequiredRoles []domainuser.UserRole `json:"required_roles"`
+}
+
+func MapLoginChallengeOutput(output applicationuser.LoginChallengeOutput) LoginChallengeOutputBody {
+	body := LoginChallengeOutputBody{
+		TwoFactorRequired: true,
+		ChallengeToken:    output.Token,
+		ExpiresAt:         output.ExpiresAt,
+	}
+
+	if output.Enrollment != nil {
+		enrollment := MapTwoFactorEnrollmentOutput(*output.Enrollment)
+		body.Enrollment = &enrollment
+	}
+
+	return body
+}
+
+func MapTwoFactorEnrollmentOutput(output applicationuser.TwoFactorEnrollmentOutput) TwoFactorEnrollmentOutputBody {
+	return TwoFactorEnrollmentOutputBody{
+		Secret:          output.Secret,
+		ProvisioningURI: output.ProvisioningURI,
+	}
+}
+
+func MapVerifyLoginOutput(output applicationuser.VerifyLoginOutput) VerifyLoginOutputBody {
+	return VerifyLoginOutputBody{
+		Token:         output.Tokens.AccessToken,
+		RefreshToken:  output.Tokens.RefreshToken,
+		RecoveryCodes: output.RecoveryCodes,
+	}
+}
+
+func MapTwoFactorPolicyOutput(output applicationuser.TwoFactorPolicyOutput) TwoFactorPolicyOutputBody {
+	requiredRoles := output.RequiredRoles
+	if requiredRoles == nil {
+		requiredRoles = []domainuser.UserRole{}
+	}
+
+	return TwoFactorPolicyOutputBody{RequiredRoles: requiredRoles}
+}
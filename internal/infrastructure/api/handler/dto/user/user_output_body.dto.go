@@ -6,15 +6,28 @@ import (
 )
 
 type UserOutputBody struct {
-	ID       string              `json:"id"`
-	Username string              `json:"username"`
-	Role     domainuser.UserRole `json:"role"`
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	// Role is the most privileged of the roles, kept for the clients reading a single one
+	Role     domainuser.UserRole   `json:"role"`
+	Roles    []domainuser.UserRole `json:"roles"`
+	Disabled bool                  `json:"disabled"`
+	// TwoFactorEnabled tells whether the logins of the user need a second factor
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
 }
 
 func MapUserOutput(u applicationuser.UserOutput) UserOutputBody {
-	return UserOutputBody{
-		ID:       u.ID,
-		Username: u.Username,
-		Role:     u.Role,
+	output := UserOutputBody{
+		ID:               u.ID,
+		Username:         u.Username,
+		Roles:            u.Roles,
+		Disabled:         u.Disabled,
+		TwoFactorEnabled: u.TwoFactorEnabled,
 	}
+
+	if len(u.Roles) > 0 {
+		output.Role = u.Roles[0]
+	}
+
+	return output
 }
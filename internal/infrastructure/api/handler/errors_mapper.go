@@ -2,11 +2,15 @@ package handler
 
 import (
 	"errors"
+	"math"
 	applicationcampaign "meye-core/internal/application/campaign"
+	applicationsession "meye-core/internal/application/session"
 	applicationuser "meye-core/internal/application/user"
 	domaincampaign "meye-core/internal/domain/campaign"
+	domainsession "meye-core/internal/domain/session"
 	domainuser "meye-core/internal/domain/user"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -18,6 +22,16 @@ type ErrorResponse struct {
 }
 
 func respondMappedError(c *gin.Context, err error) {
+	var throttledErr *domainuser.LoginThrottledError
+	if errors.As(err, &throttledErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttledErr.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, ErrorResponse{
+			Error: "Too many failed logins, retry later",
+			Code:  domainuser.ErrTooManyLoginAttempts.Error(),
+		})
+		return
+	}
+
 	switch {
 	case errors.Is(err, applicationuser.ErrUsernameAlreadyExists):
 		c.JSON(http.StatusConflict, ErrorResponse{
@@ -39,6 +53,121 @@ func respondMappedError(c *gin.Context, err error) {
 			Error: "Campaign not found",
 			Code:  applicationcampaign.ErrCampaignNotFound.Error(),
 		})
+	case errors.Is(err, domainuser.ErrInvalidRefreshToken):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Invalid refresh token",
+			Code:  domainuser.ErrInvalidRefreshToken.Error(),
+		})
+	case errors.Is(err, domainuser.ErrRefreshTokenReused):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Refresh token already used, every session issued from it has been revoked",
+			Code:  domainuser.ErrRefreshTokenReused.Error(),
+		})
+	case errors.Is(err, domainuser.ErrWrongPassword):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Current password is wrong",
+			Code:  domainuser.ErrWrongPassword.Error(),
+		})
+	case errors.Is(err, domainuser.ErrPasswordUnchanged):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "New password should differ from the current one",
+			Code:  domainuser.ErrPasswordUnchanged.Error(),
+		})
+	case errors.Is(err, domainuser.ErrPasswordTooShort):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Password is too short",
+			Code:  domainuser.ErrPasswordTooShort.Error(),
+		})
+	case errors.Is(err, domainuser.ErrPasswordTooLong):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Password is too long",
+			Code:  domainuser.ErrPasswordTooLong.Error(),
+		})
+	case errors.Is(err, domainuser.ErrPasswordNeedsMixedCase):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Password should mix uppercase and lowercase letters",
+			Code:  domainuser.ErrPasswordNeedsMixedCase.Error(),
+		})
+	case errors.Is(err, domainuser.ErrPasswordNeedsDigit):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Password should contain a digit",
+			Code:  domainuser.ErrPasswordNeedsDigit.Error(),
+		})
+	case errors.Is(err, domainuser.ErrPasswordNeedsSymbol):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Password should contain a symbol",
+			Code:  domainuser.ErrPasswordNeedsSymbol.Error(),
+		})
+	case errors.Is(err, domainuser.ErrInvalidPasswordResetToken):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Invalid or expired password reset token",
+			Code:  domainuser.ErrInvalidPasswordResetToken.Error(),
+		})
+	case errors.Is(err, domainuser.ErrUserDisabled):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "User is disabled",
+			Code:  domainuser.ErrUserDisabled.Error(),
+		})
+	case errors.Is(err, domainuser.ErrInvalidLoginChallenge):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Invalid or expired login challenge, login again",
+			Code:  domainuser.ErrInvalidLoginChallenge.Error(),
+		})
+	case errors.Is(err, domainuser.ErrInvalidTwoFactorCode):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Invalid two-factor code",
+			Code:  domainuser.ErrInvalidTwoFactorCode.Error(),
+		})
+	case errors.Is(err, domainuser.ErrTwoFactorAlreadyEnabled):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Two-factor authentication is already enabled",
+			Code:  domainuser.ErrTwoFactorAlreadyEnabled.Error(),
+		})
+	case errors.Is(err, domainuser.ErrTwoFactorNotEnrolled):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Two-factor authentication is not enrolled",
+			Code:  domainuser.ErrTwoFactorNotEnrolled.Error(),
+		})
+	case errors.Is(err, domainuser.ErrTwoFactorRequired):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "A role of the user requires two-factor authentication",
+			Code:  domainuser.ErrTwoFactorRequired.Error(),
+		})
+	case errors.Is(err, domainuser.ErrInvalidRole):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid role",
+			Code:  domainuser.ErrInvalidRole.Error(),
+		})
+	case errors.Is(err, domainuser.ErrInvalidAPIKeyScope):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid API key scope",
+			Code:  domainuser.ErrInvalidAPIKeyScope.Error(),
+		})
+	case errors.Is(err, domainuser.ErrInvalidAPIKeyExpiry):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "API key expiry should be in the future",
+			Code:  domainuser.ErrInvalidAPIKeyExpiry.Error(),
+		})
+	case errors.Is(err, domainuser.ErrMissingAPIKeyScope):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "API key lacks a required scope",
+			Code:  domainuser.ErrMissingAPIKeyScope.Error(),
+		})
+	case errors.Is(err, applicationuser.ErrAPIKeyNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "API key not found",
+			Code:  applicationuser.ErrAPIKeyNotFound.Error(),
+		})
+	case errors.Is(err, applicationuser.ErrCannotManageSelf):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "Admins can't disable, delete or change the role of their own account",
+			Code:  applicationuser.ErrCannotManageSelf.Error(),
+		})
+	case errors.Is(err, applicationuser.ErrUserHasCampaignData):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "User masters campaigns or owns PJs, disable it instead",
+			Code:  applicationuser.ErrUserHasCampaignData.Error(),
+		})
 	case errors.Is(err, domainuser.ErrUserNotPlayer):
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error: "User is not a player",
@@ -54,6 +183,26 @@ func respondMappedError(c *gin.Context, err error) {
 			Error: "All PJs should belong to the campaign",
 			Code:  domaincampaign.ErrPJsNotInCampaign.Error(),
 		})
+	case errors.Is(err, domaincampaign.ErrSupernaturalXPForHuman):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "Human PJs can't earn supernatural XP",
+			Code:  domaincampaign.ErrSupernaturalXPForHuman.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrSessionXPCapExceeded):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "A PJ would earn more XP than the session cap",
+			Code:  domaincampaign.ErrSessionXPCapExceeded.Error(),
+		})
+	case errors.Is(err, domainsession.ErrEmptyXPAssignation):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "XP assignations should assign some XP",
+			Code:  domainsession.ErrEmptyXPAssignation.Error(),
+		})
+	case errors.Is(err, domainsession.ErrDuplicatedXPAssignation):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "A PJ can only have one XP assignation per session",
+			Code:  domainsession.ErrDuplicatedXPAssignation.Error(),
+		})
 	case errors.Is(err, domaincampaign.ErrInsufficientXP):
 		c.JSON(http.StatusNotAcceptable, ErrorResponse{
 			Error: "There is not enough XP to perform the action",
@@ -64,6 +213,136 @@ func respondMappedError(c *gin.Context, err error) {
 			Error: "PJ stats can't be reduced",
 			Code:  domaincampaign.ErrCannotReduceStats.Error(),
 		})
+	case errors.Is(err, domaincampaign.ErrSkillNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Skill not found",
+			Code:  domaincampaign.ErrSkillNotFound.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrTransformationNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Transformation not found",
+			Code:  domaincampaign.ErrTransformationNotFound.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrSkillRemoved):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "Skills can't be removed",
+			Code:  domaincampaign.ErrSkillRemoved.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrDuplicateSkill):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "A skill is listed more than once",
+			Code:  domaincampaign.ErrDuplicateSkill.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrDuplicateTransformation):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "A transformation is listed more than once",
+			Code:  domaincampaign.ErrDuplicateTransformation.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrTransformationRemoved):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "Transformations can't be removed",
+			Code:  domaincampaign.ErrTransformationRemoved.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrSkillLimitReached):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The PJ can't learn more skills",
+			Code:  domaincampaign.ErrSkillLimitReached.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrCannotUpdateSupernaturalStats):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "Only supernatural PJs have supernatural stats",
+			Code:  domaincampaign.ErrCannotUpdateSupernaturalStats.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrInvalidHouseRules):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "House rules reference unknown talents or stats",
+			Code:  domaincampaign.ErrInvalidHouseRules.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrTooManyTalents):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The campaign doesn't allow that many talents",
+			Code:  domaincampaign.ErrTooManyTalents.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrExclusiveTalents):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The campaign doesn't allow combining those talents",
+			Code:  domaincampaign.ErrExclusiveTalents.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrStatCapExceeded):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "A stat exceeds the campaign cap",
+			Code:  domaincampaign.ErrStatCapExceeded.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrLifeCapExceeded):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "Life exceeds the campaign cap",
+			Code:  domaincampaign.ErrLifeCapExceeded.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrEnergyTankCapExceeded):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "Energy tank exceeds the campaign cap",
+			Code:  domaincampaign.ErrEnergyTankCapExceeded.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrXPAlreadySpent):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The PJ has already spent the XP the amendment takes back",
+			Code:  domaincampaign.ErrXPAlreadySpent.Error(),
+		})
+	case errors.Is(err, domaincampaign.ErrRulesetNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Ruleset not found",
+			Code:  domaincampaign.ErrRulesetNotFound.Error(),
+		})
+	case errors.Is(err, applicationsession.ErrSessionNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Session not found",
+			Code:  applicationsession.ErrSessionNotFound.Error(),
+		})
+	case errors.Is(err, applicationsession.ErrCalendarNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Calendar not found",
+			Code:  applicationsession.ErrCalendarNotFound.Error(),
+		})
+	case errors.Is(err, applicationsession.ErrNotCampaignMember):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "Only the campaign master and players can see its sessions",
+			Code:  applicationsession.ErrNotCampaignMember.Error(),
+		})
+	case errors.Is(err, domainsession.ErrInvalidCursor):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid pagination cursor",
+			Code:  domainsession.ErrInvalidCursor.Error(),
+		})
+	case errors.Is(err, domainsession.ErrSessionFinalized):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The session is already finalized",
+			Code:  domainsession.ErrSessionFinalized.Error(),
+		})
+	case errors.Is(err, domainsession.ErrSessionDraft):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The session is still a draft",
+			Code:  domainsession.ErrSessionDraft.Error(),
+		})
+	case errors.Is(err, domainsession.ErrSessionPlanned):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The session hasn't started yet",
+			Code:  domainsession.ErrSessionPlanned.Error(),
+		})
+	case errors.Is(err, domainsession.ErrSessionNotPlanned):
+		c.JSON(http.StatusNotAcceptable, ErrorResponse{
+			Error: "The session has already started",
+			Code:  domainsession.ErrSessionNotPlanned.Error(),
+		})
+	case errors.Is(err, domainsession.ErrInvalidSchedule):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "A planned session needs a start time and a positive duration",
+			Code:  domainsession.ErrInvalidSchedule.Error(),
+		})
+	case errors.Is(err, domainsession.ErrInvalidRSVP):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "RSVP response should be yes, no or maybe",
+			Code:  domainsession.ErrInvalidRSVP.Error(),
+		})
 	default:
 		logrus.WithContext(c.Request.Context()).Error(err)
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"meye-core/internal/infrastructure/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type jwksProvider interface {
+	JWKS() jwt.JSONWebKeySet
+}
+
+// JWKSHandler publishes the public keys verifying the access tokens, for other services.
+type JWKSHandler struct {
+	jwksProvider jwksProvider
+}
+
+func NewJWKSHandler(provider jwksProvider) *JWKSHandler {
+	return &JWKSHandler{
+		jwksProvider: provider,
+	}
+}
+
+func (h *JWKSHandler) GetJWKS(c *gin.Context) {
+	// Short enough for verifiers to pick up a rotated key before it signs many tokens
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.jwksProvider.JWKS())
+}
@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"bytes"
+	"meye-core/internal/application/session"
+	dto "meye-core/internal/infrastructure/api/handler/dto/campaign"
+	"meye-core/internal/infrastructure/recap"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SessionHandler struct {
+	listSessionsUseCase      session.ListSessionsUseCase
+	getSessionUseCase        session.GetSessionUseCase
+	amendSessionUseCase      session.AmendSessionUseCase
+	finalizeSessionUseCase   session.FinalizeSessionUseCase
+	rescheduleSessionUseCase session.RescheduleSessionUseCase
+	rsvpSessionUseCase       session.RSVPSessionUseCase
+	getCalendarFeedUseCase   session.GetCalendarFeedUseCase
+	getSessionRecapUseCase   session.GetSessionRecapUseCase
+	searchSessionsUseCase    session.SearchSessionsUseCase
+	recapRenderer            *recap.Renderer
+}
+
+func NewSessionHandler(
+	listSessionsUseCase session.ListSessionsUseCase,
+	getSessionUseCase session.GetSessionUseCase,
+	amendSessionUseCase session.AmendSessionUseCase,
+	finalizeSessionUseCase session.FinalizeSessionUseCase,
+	rescheduleSessionUseCase session.RescheduleSessionUseCase,
+	rsvpSessionUseCase session.RSVPSessionUseCase,
+	getCalendarFeedUseCase session.GetCalendarFeedUseCase,
+	getSessionRecapUseCase session.GetSessionRecapUseCase,
+	searchSessionsUseCase session.SearchSessionsUseCase,
+	recapRenderer *recap.Renderer,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUseCase:      listSessionsUseCase,
+		getSessionUseCase:        getSessionUseCase,
+		amendSessionUseCase:      amendSessionUseCase,
+		finalizeSessionUseCase:   finalizeSessionUseCase,
+		rescheduleSessionUseCase: rescheduleSessionUseCase,
+		rsvpSessionUseCase:       rsvpSessionUseCase,
+		getCalendarFeedUseCase:   getCalendarFeedUseCase,
+		getSessionRecapUseCase:   getSessionRecapUseCase,
+		searchSessionsUseCase:    searchSessionsUseCase,
+		recapRenderer:            recapRenderer,
+	}
+}
+
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.CampaignPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var queryParams dto.ListSessionsQuery
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.listSessionsUseCase.Execute(c.Request.Context(), dto.MapListSessionsInput(pathParams, auth.UserID, queryParams))
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSessionsPageOutputBody(output))
+}
+
+func (h *SessionHandler) GetSession(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.SessionPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.getSessionUseCase.Execute(c.Request.Context(), session.GetSessionInput{
+		SessionID: pathParams.SessionID,
+		UserID:    auth.UserID,
+	})
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSessionOutput(output))
+}
+
+func (h *SessionHandler) AmendSession(c *gin.Context) {
+	var pathParams dto.CampaignSessionPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.AmendSessionInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.amendSessionUseCase.Execute(c.Request.Context(), dto.MapAmendSessionInput(pathParams, reqBody))
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSessionOutput(output))
+}
+
+func (h *SessionHandler) FinalizeSession(c *gin.Context) {
+	var pathParams dto.CampaignSessionPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.finalizeSessionUseCase.Execute(c.Request.Context(), session.FinalizeSessionInput{
+		CampaignID: pathParams.CampaignID,
+		SessionID:  pathParams.SessionID,
+	})
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSessionOutput(output))
+}
+
+func (h *SessionHandler) RescheduleSession(c *gin.Context) {
+	var pathParams dto.CampaignSessionPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.ScheduleBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.rescheduleSessionUseCase.Execute(c.Request.Context(), session.RescheduleSessionInput{
+		CampaignID: pathParams.CampaignID,
+		SessionID:  pathParams.SessionID,
+		Schedule:   dto.MapScheduleInput(reqBody),
+	})
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSessionOutput(output))
+}
+
+func (h *SessionHandler) RSVPSession(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.SessionPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.RSVPInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.rsvpSessionUseCase.Execute(c.Request.Context(), session.RSVPSessionInput{
+		SessionID: pathParams.SessionID,
+		UserID:    auth.UserID,
+		Response:  reqBody.Response,
+	})
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSessionOutput(output))
+}
+
+func (h *SessionHandler) GetCalendarFeed(c *gin.Context) {
+	var pathParams dto.CalendarPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.getCalendarFeedUseCase.Execute(c.Request.Context(), session.GetCalendarFeedInput{Token: pathParams.Token})
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-cache")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", dto.MapCalendarFeed(output).Encode(time.Now()))
+}
+
+func (h *SessionHandler) GetSessionRecap(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.SessionPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var queryParams dto.SessionRecapQuery
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.getSessionRecapUseCase.Execute(c.Request.Context(), session.GetSessionRecapInput{
+		SessionID: pathParams.SessionID,
+		UserID:    auth.UserID,
+	})
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	// Rendered into a buffer first, so a failing template doesn't leave a half written 200
+	format := queryParams.RecapFormat()
+	var buf bytes.Buffer
+	if err := h.recapRenderer.Render(&buf, format, dto.MapSessionRecap(output)); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, recap.ContentType(format), buf.Bytes())
+}
+
+func (h *SessionHandler) SearchSessions(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.CampaignPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var queryParams dto.SearchSessionsQuery
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.searchSessionsUseCase.Execute(c.Request.Context(), dto.MapSearchSessionsInput(pathParams, auth.UserID, queryParams))
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapSearchSessionsOutputBody(output))
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"meye-core/internal/application/user"
 	dto "meye-core/internal/infrastructure/api/handler/dto/user"
 	"net/http"
@@ -13,19 +14,89 @@ type UserHandler struct {
 	loginUseCase      user.LoginUseCase
 	getPlayersUseCase user.GetPlayersUseCase
 	getUserUseCase    user.GetUserUseCase
+
+	refreshTokenUseCase     user.RefreshTokenUseCase
+	logoutUseCase           user.LogoutUseCase
+	logoutEverywhereUseCase user.LogoutEverywhereUseCase
+
+	changePasswordUseCase     user.ChangePasswordUseCase
+	issuePasswordResetUseCase user.IssuePasswordResetUseCase
+	resetPasswordUseCase      user.ResetPasswordUseCase
+
+	rotateCalendarTokenUseCase user.RotateCalendarTokenUseCase
+	revokeCalendarTokenUseCase user.RevokeCalendarTokenUseCase
+
+	listUsersUseCase       user.ListUsersUseCase
+	disableUserUseCase     user.DisableUserUseCase
+	enableUserUseCase      user.EnableUserUseCase
+	changeUserRolesUseCase user.ChangeUserRolesUseCase
+	deleteUserUseCase      user.DeleteUserUseCase
+
+	verifyLoginUseCase           user.VerifyLoginUseCase
+	enrollTwoFactorUseCase       user.EnrollTwoFactorUseCase
+	confirmTwoFactorUseCase      user.ConfirmTwoFactorUseCase
+	disableTwoFactorUseCase      user.DisableTwoFactorUseCase
+	clearTwoFactorUseCase        user.ClearTwoFactorUseCase
+	getTwoFactorPolicyUseCase    user.GetTwoFactorPolicyUseCase
+	updateTwoFactorPolicyUseCase user.UpdateTwoFactorPolicyUseCase
 }
 
 func NewUserHandler(
 	createUserUC user.CreateUserUseCase,
 	loginUseCase user.LoginUseCase,
+	refreshTokenUseCase user.RefreshTokenUseCase,
+	logoutUseCase user.LogoutUseCase,
+	logoutEverywhereUseCase user.LogoutEverywhereUseCase,
+	changePasswordUseCase user.ChangePasswordUseCase,
+	issuePasswordResetUseCase user.IssuePasswordResetUseCase,
+	resetPasswordUseCase user.ResetPasswordUseCase,
 	getPlayersUseCase user.GetPlayersUseCase,
 	getUserUseCase user.GetUserUseCase,
+	rotateCalendarTokenUseCase user.RotateCalendarTokenUseCase,
+	revokeCalendarTokenUseCase user.RevokeCalendarTokenUseCase,
+	listUsersUseCase user.ListUsersUseCase,
+	disableUserUseCase user.DisableUserUseCase,
+	enableUserUseCase user.EnableUserUseCase,
+	changeUserRolesUseCase user.ChangeUserRolesUseCase,
+	deleteUserUseCase user.DeleteUserUseCase,
+	verifyLoginUseCase user.VerifyLoginUseCase,
+	enrollTwoFactorUseCase user.EnrollTwoFactorUseCase,
+	confirmTwoFactorUseCase user.ConfirmTwoFactorUseCase,
+	disableTwoFactorUseCase user.DisableTwoFactorUseCase,
+	clearTwoFactorUseCase user.ClearTwoFactorUseCase,
+	getTwoFactorPolicyUseCase user.GetTwoFactorPolicyUseCase,
+	updateTwoFactorPolicyUseCase user.UpdateTwoFactorPolicyUseCase,
 ) *UserHandler {
 	return &UserHandler{
 		createUserUseCase: createUserUC,
 		loginUseCase:      loginUseCase,
 		getPlayersUseCase: getPlayersUseCase,
 		getUserUseCase:    getUserUseCase,
+
+		refreshTokenUseCase:     refreshTokenUseCase,
+		logoutUseCase:           logoutUseCase,
+		logoutEverywhereUseCase: logoutEverywhereUseCase,
+
+		changePasswordUseCase:     changePasswordUseCase,
+		issuePasswordResetUseCase: issuePasswordResetUseCase,
+		resetPasswordUseCase:      resetPasswordUseCase,
+
+		rotateCalendarTokenUseCase: rotateCalendarTokenUseCase,
+		revokeCalendarTokenUseCase: revokeCalendarTokenUseCase,
+
+		listUsersUseCase:       listUsersUseCase,
+		disableUserUseCase:     disableUserUseCase,
+		enableUserUseCase:      enableUserUseCase,
+		changeUserRolesUseCase: changeUserRolesUseCase,
+		deleteUserUseCase:      deleteUserUseCase,
+
+		verifyLoginUseCase:           verifyLoginUseCase,
+		enrollTwoFactorUseCase:       enrollTwoFactorUseCase,
+		confirmTwoFactorUseCase:      confirmTwoFactorUseCase,
+		disableTwoFactorUseCase:      disableTwoFactorUseCase,
+		clearTwoFactorUseCase:        clearTwoFactorUseCase,
+		getTwoFactorPolicyUseCase:    getTwoFactorPolicyUseCase,
+		updateTwoFactorPolicyUseCase: updateTwoFactorPolicyUseCase,
 	}
 }
 
@@ -40,7 +111,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	input := user.CreateUserInput{
 		Username: reqBody.Username,
 		Password: reqBody.Password,
-		Role:     reqBody.Role,
+		Roles:    reqBody.RequestedRoles(),
 	}
 
 	user, err := h.createUserUseCase.Execute(c.Request.Context(), input)
@@ -61,19 +132,126 @@ func (h *UserHandler) Login(c *gin.Context) {
 	}
 
 	input := user.LoginInput{
-		Username: reqBody.Username,
-		Password: reqBody.Password,
+		Username:  reqBody.Username,
+		Password:  reqBody.Password,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
 	}
 
-	token, err := h.loginUseCase.Execute(c.Request.Context(), input)
+	output, err := h.loginUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
 		respondMappedError(c, err)
 		return
 	}
 
-	output := dto.MapLoginOutput(token)
+	// The tokens are only issued once the second factor is checked, see VerifyLogin
+	if output.Challenge != nil {
+		c.JSON(http.StatusOK, dto.MapLoginChallengeOutput(*output.Challenge))
+		return
+	}
 
-	c.JSON(http.StatusOK, output)
+	c.JSON(http.StatusOK, dto.MapLoginOutput(*output.Tokens))
+}
+
+func (h *UserHandler) VerifyLogin(c *gin.Context) {
+	var reqBody dto.VerifyLoginInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.VerifyLoginInput{
+		ChallengeToken: reqBody.ChallengeToken,
+		Code:           reqBody.Code,
+		UserAgent:      c.Request.UserAgent(),
+		IPAddress:      c.ClientIP(),
+	}
+
+	output, err := h.verifyLoginUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapVerifyLoginOutput(output))
+}
+
+func (h *UserHandler) RefreshToken(c *gin.Context) {
+	var reqBody dto.RefreshTokenInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.RefreshTokenInput{
+		RefreshToken: reqBody.RefreshToken,
+		UserAgent:    c.Request.UserAgent(),
+		IPAddress:    c.ClientIP(),
+	}
+
+	tokens, err := h.refreshTokenUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapLoginOutput(tokens))
+}
+
+func (h *UserHandler) Logout(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var reqBody dto.RefreshTokenInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.LogoutInput{
+		UserID:       auth.UserID,
+		RefreshToken: reqBody.RefreshToken,
+	}
+
+	if err := h.logoutUseCase.Execute(c.Request.Context(), input); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) LogoutEverywhere(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	if err := h.logoutEverywhereUseCase.Execute(c.Request.Context(), auth.UserID); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
 }
 
 func (h *UserHandler) GetPlayers(c *gin.Context) {
@@ -128,3 +306,360 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.MapUserOutput(output))
 }
+
+func (h *UserHandler) RotateCalendarToken(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	token, err := h.rotateCalendarTokenUseCase.Execute(c.Request.Context(), auth.UserID)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.MapCalendarTokenOutput(token))
+}
+
+func (h *UserHandler) RevokeCalendarToken(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	if err := h.revokeCalendarTokenUseCase.Execute(c.Request.Context(), auth.UserID); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var reqBody dto.ChangePasswordInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.ChangePasswordInput{
+		UserID:          auth.UserID,
+		CurrentPassword: reqBody.CurrentPassword,
+		NewPassword:     reqBody.NewPassword,
+	}
+
+	if err := h.changePasswordUseCase.Execute(c.Request.Context(), input); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) IssuePasswordReset(c *gin.Context) {
+	var pathParams dto.UserPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.issuePasswordResetUseCase.Execute(c.Request.Context(), pathParams.UserID)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.MapPasswordResetOutput(output))
+}
+
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var reqBody dto.ResetPasswordInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.ResetPasswordInput{
+		Token:       reqBody.Token,
+		NewPassword: reqBody.NewPassword,
+	}
+
+	if err := h.resetPasswordUseCase.Execute(c.Request.Context(), input); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	var queryParams dto.ListUsersQuery
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.ListUsersInput{
+		Role:           queryParams.Role,
+		UsernamePrefix: queryParams.UsernamePrefix,
+		Page:           queryParams.Page(),
+		Size:           queryParams.Size(),
+	}
+
+	output, err := h.listUsersUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	data := make([]dto.UserOutputBody, 0, len(output))
+	for _, u := range output {
+		data = append(data, dto.MapUserOutput(u))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginationOutputBody{
+		Page: queryParams.Page(),
+		Size: queryParams.Size(),
+		Data: data,
+	})
+}
+
+func (h *UserHandler) DisableUser(c *gin.Context) {
+	h.manageUser(c, h.disableUserUseCase.Execute)
+}
+
+func (h *UserHandler) EnableUser(c *gin.Context) {
+	h.manageUser(c, h.enableUserUseCase.Execute)
+}
+
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	h.manageUser(c, h.deleteUserUseCase.Execute)
+}
+
+func (h *UserHandler) ChangeUserRoles(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.UserPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reqBody dto.RolesInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.ChangeUserRolesInput{
+		ActorID: auth.UserID,
+		UserID:  pathParams.UserID,
+		Roles:   reqBody.RequestedRoles(),
+	}
+
+	output, err := h.changeUserRolesUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapUserOutput(output))
+}
+
+// manageUser runs an admin action on the user of the path, answering 204 once done.
+func (h *UserHandler) manageUser(c *gin.Context, execute func(context.Context, user.ManageUserInput) error) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	var pathParams dto.UserPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.ManageUserInput{
+		ActorID: auth.UserID,
+		UserID:  pathParams.UserID,
+	}
+
+	if err := execute(c.Request.Context(), input); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) EnrollTwoFactor(c *gin.Context) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return
+	}
+
+	output, err := h.enrollTwoFactorUseCase.Execute(c.Request.Context(), auth.UserID)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.MapTwoFactorEnrollmentOutput(output))
+}
+
+func (h *UserHandler) ConfirmTwoFactor(c *gin.Context) {
+	input, ok := bindTwoFactorCodeInput(c)
+	if !ok {
+		return
+	}
+
+	recoveryCodes, err := h.confirmTwoFactorUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RecoveryCodesOutputBody{RecoveryCodes: recoveryCodes})
+}
+
+func (h *UserHandler) DisableTwoFactor(c *gin.Context) {
+	input, ok := bindTwoFactorCodeInput(c)
+	if !ok {
+		return
+	}
+
+	if err := h.disableTwoFactorUseCase.Execute(c.Request.Context(), input); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) ClearTwoFactor(c *gin.Context) {
+	var pathParams dto.UserPathParams
+
+	if err := c.ShouldBindUri(&pathParams); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.clearTwoFactorUseCase.Execute(c.Request.Context(), pathParams.UserID); err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) GetTwoFactorPolicy(c *gin.Context) {
+	output, err := h.getTwoFactorPolicyUseCase.Execute(c.Request.Context())
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapTwoFactorPolicyOutput(output))
+}
+
+func (h *UserHandler) UpdateTwoFactorPolicy(c *gin.Context) {
+	var reqBody dto.TwoFactorPolicyInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.updateTwoFactorPolicyUseCase.Execute(c.Request.Context(), reqBody.RequiredRoles)
+	if err != nil {
+		respondMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MapTwoFactorPolicyOutput(output))
+}
+
+// bindTwoFactorCodeInput reads the code sent by the authenticated user, answering the request when it can't.
+func bindTwoFactorCodeInput(c *gin.Context) (user.TwoFactorCodeInput, bool) {
+	authValue, exists := c.Get(AuthKey)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return user.TwoFactorCodeInput{}, false
+	}
+
+	auth, ok := authValue.(AuthContext)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, unauthorizedError)
+		return user.TwoFactorCodeInput{}, false
+	}
+
+	var reqBody dto.TwoFactorCodeInputBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return user.TwoFactorCodeInput{}, false
+	}
+
+	return user.TwoFactorCodeInput{
+		UserID: auth.UserID,
+		Code:   reqBody.Code,
+	}, true
+}
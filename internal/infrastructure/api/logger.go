@@ -0,0 +1,50 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const calendarPathPrefix = "/api/v1/calendar/"
+
+// logFormatter is the default gin log format, without the secret tokens of the calendar feeds,
+// which travel in the path as calendar clients can't send headers.
+func logFormatter(param gin.LogFormatterParams) string {
+	var statusColor, methodColor, resetColor string
+	if param.IsOutputColor() {
+		statusColor = param.StatusCodeColor()
+		methodColor = param.MethodColor()
+		resetColor = param.ResetColor()
+	}
+
+	if param.Latency > time.Minute {
+		param.Latency = param.Latency.Truncate(time.Second)
+	}
+
+	return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+		statusColor, param.StatusCode, resetColor,
+		param.Latency,
+		param.ClientIP,
+		methodColor, param.Method, resetColor,
+		redactCalendarToken(param.Path),
+		param.ErrorMessage,
+	)
+}
+
+func redactCalendarToken(path string) string {
+	token, found := strings.CutPrefix(path, calendarPathPrefix)
+	if !found || token == "" {
+		return path
+	}
+
+	rest := ""
+	if i := strings.IndexAny(token, "/?"); i >= 0 {
+		rest = token[i:]
+	}
+
+	return calendarPathPrefix + "REDACTED" + rest
+}
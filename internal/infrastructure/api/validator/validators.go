@@ -9,7 +9,14 @@ import (
 
 func validateUserRoleEnum(fl validator.FieldLevel) bool {
 	if val, ok := fl.Field().Interface().(user.UserRole); ok {
-		return val == user.UserRoleAdmin || val == user.UserRoleMaster || val == user.UserRolePlayer
+		return user.IsValidRole(val)
+	}
+	return false
+}
+
+func validateAPIKeyScopeEnum(fl validator.FieldLevel) bool {
+	if val, ok := fl.Field().Interface().(user.APIKeyScope); ok {
+		return user.IsValidAPIKeyScope(val)
 	}
 	return false
 }
@@ -24,4 +31,5 @@ func validatePJTypeEnum(fl validator.FieldLevel) bool {
 func RegisterCustomValidators(v *validator.Validate) {
 	v.RegisterValidation("userrole", validateUserRoleEnum)
 	v.RegisterValidation("pjtype", validatePJTypeEnum)
+	v.RegisterValidation("apikeyscope", validateAPIKeyScopeEnum)
 }
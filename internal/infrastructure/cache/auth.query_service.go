@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"meye-core/internal/domain/user"
+	"time"
+)
+
+var _ user.AuthQueryService = (*AuthQueryService)(nil)
+
+// AuthQueryService caches the auth state of the users checked on every request.
+// Entries are dropped by InvalidatingPublisher when the user changes in this instance,
+// the TTL bounds how long a change made by another instance goes unnoticed.
+type AuthQueryService struct {
+	next    user.AuthQueryService
+	entries *ttlCache[string, user.AuthState]
+}
+
+func NewAuthQueryService(next user.AuthQueryService, ttl time.Duration) *AuthQueryService {
+	return &AuthQueryService{
+		next:    next,
+		entries: newTTLCache[string, user.AuthState](ttl),
+	}
+}
+
+func (qs *AuthQueryService) FindAuthState(ctx context.Context, userID string) (*user.AuthState, error) {
+	if state, ok := qs.entries.get(userID); ok {
+		return &state, nil
+	}
+
+	state, err := qs.next.FindAuthState(ctx, userID)
+	if err != nil || state == nil {
+		return state, err
+	}
+
+	qs.entries.set(userID, *state)
+
+	return state, nil
+}
+
+func (qs *AuthQueryService) Invalidate(userID string) {
+	qs.entries.delete(userID)
+}
@@ -0,0 +1,151 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"meye-core/internal/domain/user"
+	"meye-core/internal/infrastructure/cache"
+	"meye-core/tests/data"
+	"meye-core/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const campaignID = "campaign-id"
+
+func TestAuthQueryService_FindAuthState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("loads the state once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := mocks.NewMockAuthQueryService(ctrl)
+
+		state := &user.AuthState{Roles: data.Roles, TokenVersion: 1}
+		next.EXPECT().FindAuthState(ctx, data.UserID).Return(state, nil).Times(1)
+
+		qs := cache.NewAuthQueryService(next, time.Minute)
+
+		for range 2 {
+			got, err := qs.FindAuthState(ctx, data.UserID)
+			require.NoError(t, err)
+			assert.Equal(t, state, got)
+		}
+	})
+
+	t.Run("missing users and errors are not cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := mocks.NewMockAuthQueryService(ctrl)
+
+		errTest := errors.New("mock_err")
+		gomock.InOrder(
+			next.EXPECT().FindAuthState(ctx, data.UserID).Return(nil, nil),
+			next.EXPECT().FindAuthState(ctx, data.UserID).Return(nil, errTest),
+			next.EXPECT().FindAuthState(ctx, data.UserID).Return(&user.AuthState{}, nil),
+		)
+
+		qs := cache.NewAuthQueryService(next, time.Minute)
+
+		got, err := qs.FindAuthState(ctx, data.UserID)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+
+		_, err = qs.FindAuthState(ctx, data.UserID)
+		assert.Equal(t, errTest, err)
+
+		got, err = qs.FindAuthState(ctx, data.UserID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+}
+
+func TestInvalidatingPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	next := mocks.NewMockAuthQueryService(ctrl)
+	publisher := mocks.NewMockPublisher(ctrl)
+
+	auth := cache.NewAuthQueryService(next, time.Minute)
+	invalidating := cache.NewInvalidatingPublisher(publisher, auth)
+
+	u := user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+	u.Disable()
+
+	errTest := errors.New("mock_err")
+	gomock.InOrder(
+		next.EXPECT().FindAuthState(ctx, data.UserID).Return(&user.AuthState{Roles: data.Roles}, nil),
+		publisher.EXPECT().Publish(ctx, u.UncommittedEvents()).Return(errTest),
+		next.EXPECT().FindAuthState(ctx, data.UserID).Return(&user.AuthState{Roles: data.Roles, Disabled: true}, nil),
+	)
+
+	_, err := auth.FindAuthState(ctx, data.UserID)
+	require.NoError(t, err)
+
+	// The cached state is dropped even when the broker fails
+	assert.Equal(t, errTest, invalidating.Publish(ctx, u.UncommittedEvents()))
+
+	state, err := auth.FindAuthState(ctx, data.UserID)
+	require.NoError(t, err)
+	assert.True(t, state.Disabled)
+}
+
+func TestMembershipQueryService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches the campaign master", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := mocks.NewMockMembershipQueryService(ctrl)
+
+		gomock.InOrder(
+			next.EXPECT().FindCampaignMasterID(ctx, campaignID).Return("", nil),
+			next.EXPECT().FindCampaignMasterID(ctx, campaignID).Return(data.UserID, nil),
+		)
+
+		qs := cache.NewMembershipQueryService(next, time.Minute)
+
+		// Missing campaigns are looked up again
+		masterID, err := qs.FindCampaignMasterID(ctx, campaignID)
+		require.NoError(t, err)
+		assert.Empty(t, masterID)
+
+		for range 2 {
+			masterID, err = qs.FindCampaignMasterID(ctx, campaignID)
+			require.NoError(t, err)
+			assert.Equal(t, data.UserID, masterID)
+		}
+	})
+
+	t.Run("caches the pj owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := mocks.NewMockMembershipQueryService(ctrl)
+
+		next.EXPECT().FindPjOwnerID(ctx, data.PjID).Return(data.UserID, nil).Times(1)
+
+		qs := cache.NewMembershipQueryService(next, time.Minute)
+
+		for range 2 {
+			ownerID, err := qs.FindPjOwnerID(ctx, data.PjID)
+			require.NoError(t, err)
+			assert.Equal(t, data.UserID, ownerID)
+		}
+	})
+
+	t.Run("does not cache the pjs of a user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := mocks.NewMockMembershipQueryService(ctrl)
+
+		next.EXPECT().FindUserPjIDs(ctx, campaignID, data.UserID).Return([]string{data.PjID}, nil).Times(2)
+
+		qs := cache.NewMembershipQueryService(next, time.Minute)
+
+		for range 2 {
+			pjIDs, err := qs.FindUserPjIDs(ctx, campaignID, data.UserID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{data.PjID}, pjIDs)
+		}
+	})
+}
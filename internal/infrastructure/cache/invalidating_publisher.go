@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"meye-core/internal/domain/event"
+)
+
+var _ event.Publisher = (*InvalidatingPublisher)(nil)
+
+// InvalidatingPublisher drops the cached auth state of the users whose events go through it,
+// so role changes and token revocations take effect on the next request.
+//
+// The invalidation is local: only the cache of the instance publishing the event is dropped.
+// The other API instances keep serving their cached entry until AUTH_CACHE_TTL expires it, so
+// a revoked role or token may still be accepted there for up to that long.
+type InvalidatingPublisher struct {
+	next event.Publisher
+	auth *AuthQueryService
+}
+
+func NewInvalidatingPublisher(next event.Publisher, auth *AuthQueryService) *InvalidatingPublisher {
+	return &InvalidatingPublisher{
+		next: next,
+		auth: auth,
+	}
+}
+
+func (p *InvalidatingPublisher) Publish(ctx context.Context, events []event.DomainEvent) error {
+	// The change is already saved, invalidate even if the broker is down
+	for _, evt := range events {
+		if evt.AggregateType() == event.AggregateTypeUser {
+			p.auth.Invalidate(evt.AggregateID())
+		}
+	}
+
+	return p.next.Publish(ctx, events)
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"meye-core/internal/domain/campaign"
+	"time"
+)
+
+var _ campaign.MembershipQueryService = (*MembershipQueryService)(nil)
+
+// MembershipQueryService caches campaign masters and PJ owners, which never change once set.
+// Missing campaigns and PJs are not cached, so they are found as soon as they are created. The PJs
+// of a user are not cached either, as users join campaigns at any time.
+type MembershipQueryService struct {
+	next          campaign.MembershipQueryService
+	campaignOwner *ttlCache[string, string]
+	pjOwner       *ttlCache[string, string]
+}
+
+func NewMembershipQueryService(next campaign.MembershipQueryService, ttl time.Duration) *MembershipQueryService {
+	return &MembershipQueryService{
+		next:          next,
+		campaignOwner: newTTLCache[string, string](ttl),
+		pjOwner:       newTTLCache[string, string](ttl),
+	}
+}
+
+func (qs *MembershipQueryService) FindCampaignMasterID(ctx context.Context, campaignID string) (string, error) {
+	return cached(qs.campaignOwner, campaignID, func() (string, error) {
+		return qs.next.FindCampaignMasterID(ctx, campaignID)
+	})
+}
+
+func (qs *MembershipQueryService) FindPjOwnerID(ctx context.Context, pjID string) (string, error) {
+	return cached(qs.pjOwner, pjID, func() (string, error) {
+		return qs.next.FindPjOwnerID(ctx, pjID)
+	})
+}
+
+func (qs *MembershipQueryService) FindUserPjIDs(ctx context.Context, campaignID, userID string) ([]string, error) {
+	return qs.next.FindUserPjIDs(ctx, campaignID, userID)
+}
+
+func cached(c *ttlCache[string, string], key string, load func() (string, error)) (string, error) {
+	if ownerID, ok := c.get(key); ok {
+		return ownerID, nil
+	}
+
+	ownerID, err := load()
+	if err != nil || ownerID == "" {
+		return ownerID, err
+	}
+
+	c.set(key, ownerID)
+
+	return ownerID, nil
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLCache(t *testing.T) {
+	t.Run("returns the stored value until it expires", func(t *testing.T) {
+		c := newTTLCache[string, int](20 * time.Millisecond)
+		c.set("a", 1)
+
+		value, ok := c.get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, value)
+
+		time.Sleep(30 * time.Millisecond)
+
+		_, ok = c.get("a")
+		assert.False(t, ok)
+		assert.Empty(t, c.entries, "the expired entry is dropped on read")
+	})
+
+	t.Run("set replaces the value", func(t *testing.T) {
+		c := newTTLCache[string, int](time.Minute)
+		c.set("a", 1)
+		c.set("a", 2)
+
+		value, _ := c.get("a")
+		assert.Equal(t, 2, value)
+		assert.Equal(t, 1, c.order.Len())
+	})
+
+	t.Run("delete drops the entry", func(t *testing.T) {
+		c := newTTLCache[string, int](time.Minute)
+		c.set("a", 1)
+		c.delete("a")
+		c.delete("missing")
+
+		_, ok := c.get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts the least recently used entry beyond the bound", func(t *testing.T) {
+		c := newBoundedTTLCache[string, int](time.Minute, 2)
+		c.set("a", 1)
+		c.set("b", 2)
+		c.get("a")
+		c.set("c", 3)
+
+		_, ok := c.get("b")
+		assert.False(t, ok)
+
+		for _, key := range []string{"a", "c"} {
+			_, ok := c.get(key)
+			assert.True(t, ok, key)
+		}
+	})
+
+	t.Run("never grows beyond the bound", func(t *testing.T) {
+		c := newBoundedTTLCache[string, int](time.Minute, 100)
+		for i := range 1000 {
+			c.set(strconv.Itoa(i), i)
+		}
+
+		assert.Equal(t, 100, c.order.Len())
+		assert.Len(t, c.entries, 100)
+	})
+}
@@ -0,0 +1,77 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// KeySize is the length of the AES-256 keys
+const KeySize = 32
+
+// prefix marks the encrypted values, the values without it were stored before encryption
+const prefix = "enc:v1:"
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// Service encrypts the secrets stored in the database with AES-256-GCM.
+type Service struct {
+	aead cipher.AEAD
+}
+
+// New creates a service encrypting with a 32 bytes key.
+func New(key []byte) (*Service, error) {
+	if len(key) != KeySize {
+		return nil, errors.New("encryption key must be 32 bytes")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{aead: aead}, nil
+}
+
+// Encrypt returns the prefixed base64 of a random nonce followed by the sealed plaintext.
+func (s *Service) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := s.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return prefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value returned by Encrypt. A value without the prefix was stored in clear
+// before encryption was introduced, it is returned as is and encrypted on its next save.
+func (s *Service) Decrypt(value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,80 @@
+package encryption_test
+
+import (
+	"bytes"
+	"meye-core/internal/infrastructure/encryption"
+	"meye-core/internal/infrastructure/totp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newService(t *testing.T, b byte) *encryption.Service {
+	service, err := encryption.New(bytes.Repeat([]byte{b}, encryption.KeySize))
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestService(t *testing.T) {
+	service := newService(t, 1)
+
+	t.Run("round-trips a secret", func(t *testing.T) {
+		encrypted, err := service.Encrypt("JBSWY3DPEHPK3PXP")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encrypted, "enc:v1:"))
+		assert.NotContains(t, encrypted, "JBSWY3DPEHPK3PXP")
+
+		decrypted, err := service.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "JBSWY3DPEHPK3PXP", decrypted)
+	})
+
+	t.Run("uses a new nonce for every value", func(t *testing.T) {
+		first, err := service.Encrypt("JBSWY3DPEHPK3PXP")
+		require.NoError(t, err)
+		second, err := service.Encrypt("JBSWY3DPEHPK3PXP")
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("returns the values stored in clear as is", func(t *testing.T) {
+		decrypted, err := service.Decrypt("JBSWY3DPEHPK3PXP")
+		require.NoError(t, err)
+		assert.Equal(t, "JBSWY3DPEHPK3PXP", decrypted)
+	})
+
+	t.Run("rejects a value encrypted with another key", func(t *testing.T) {
+		encrypted, err := newService(t, 2).Encrypt("JBSWY3DPEHPK3PXP")
+		require.NoError(t, err)
+
+		_, err = service.Decrypt(encrypted)
+		assert.Equal(t, encryption.ErrInvalidCiphertext, err)
+	})
+
+	t.Run("rejects a malformed value", func(t *testing.T) {
+		for _, value := range []string{"enc:v1:!!", "enc:v1:", "enc:v1:AAAA"} {
+			_, err := service.Decrypt(value)
+			assert.Equal(t, encryption.ErrInvalidCiphertext, err, value)
+		}
+	})
+}
+
+// twoFactorSecretSize is the size of the users.two_factor_secret column, see migration 025.
+const twoFactorSecretSize = 128
+
+func TestService_EncryptedTOTPSecretFitsColumn(t *testing.T) {
+	secret, err := totp.New("meye").GenerateSecret()
+	require.NoError(t, err)
+
+	encrypted, err := newService(t, 1).Encrypt(secret)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(encrypted), twoFactorSecretSize)
+}
+
+func TestNew_KeySize(t *testing.T) {
+	_, err := encryption.New([]byte("short"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package hash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix  = "$" + AlgorithmArgon2id + "$"
+	argon2idSaltLen = 16
+	argon2idKeyLen  = 32
+)
+
+var (
+	ErrInvalidHash               = errors.New("invalid password hash")
+	ErrMismatchedHashAndPassword = errors.New("hashedPassword is not the hash of the given password")
+)
+
+// Argon2idParams are the cost parameters of argon2id, see RFC 9106.
+type Argon2idParams struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+}
+
+type argon2idHash struct {
+	params Argon2idParams
+	salt   []byte
+	key    []byte
+}
+
+// hashArgon2id returns the PHC string of secret: $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+func hashArgon2id(secret string, params Argon2idParams) (string, error) {
+	salt := make([]byte, argon2idSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(secret), salt, params.Iterations, params.Memory, params.Parallelism, argon2idKeyLen)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func compareArgon2id(secret, hash string) error {
+	decoded, err := decodeArgon2id(hash)
+	if err != nil {
+		return err
+	}
+
+	p := decoded.params
+	key := argon2.IDKey([]byte(secret), decoded.salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(decoded.key)))
+
+	if subtle.ConstantTimeCompare(key, decoded.key) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+
+	return nil
+}
+
+func argon2idNeedsRehash(hash string, params Argon2idParams) bool {
+	decoded, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	p := decoded.params
+
+	return p.Memory < params.Memory ||
+		p.Iterations < params.Iterations ||
+		p.Parallelism < params.Parallelism ||
+		len(decoded.key) < argon2idKeyLen
+}
+
+func decodeArgon2id(hash string) (argon2idHash, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return argon2idHash{}, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2idHash{}, ErrInvalidHash
+	}
+
+	var decoded argon2idHash
+	p := &decoded.params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return argon2idHash{}, ErrInvalidHash
+	}
+
+	if p.Iterations == 0 || p.Parallelism == 0 {
+		return argon2idHash{}, ErrInvalidHash
+	}
+
+	var err error
+	if decoded.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return argon2idHash{}, ErrInvalidHash
+	}
+
+	if decoded.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(decoded.key) == 0 {
+		return argon2idHash{}, ErrInvalidHash
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,92 @@
+package hash
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testParams = Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestHashArgon2id(t *testing.T) {
+	hash, err := hashArgon2id("password123", testParams)
+	require.NoError(t, err)
+
+	t.Run("writes a PHC string", func(t *testing.T) {
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"))
+
+		decoded, err := decodeArgon2id(hash)
+		require.NoError(t, err)
+		assert.Equal(t, testParams, decoded.params)
+		assert.Len(t, decoded.salt, argon2idSaltLen)
+		assert.Len(t, decoded.key, argon2idKeyLen)
+	})
+
+	t.Run("round-trips through compare", func(t *testing.T) {
+		assert.NoError(t, compareArgon2id("password123", hash))
+		assert.Equal(t, ErrMismatchedHashAndPassword, compareArgon2id("password124", hash))
+	})
+
+	t.Run("salts every hash", func(t *testing.T) {
+		other, err := hashArgon2id("password123", testParams)
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, other)
+	})
+}
+
+func TestDecodeArgon2id_Malformed(t *testing.T) {
+	salt := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	key := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "empty", hash: ""},
+		{name: "missing key", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt},
+		{name: "extra part", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$" + key + "$x"},
+		{name: "other version", hash: "$argon2id$v=16$m=64,t=1,p=1$" + salt + "$" + key},
+		{name: "unreadable version", hash: "$argon2id$version$m=64,t=1,p=1$" + salt + "$" + key},
+		{name: "unreadable parameters", hash: "$argon2id$v=19$m=64;t=1;p=1$" + salt + "$" + key},
+		{name: "zero iterations", hash: "$argon2id$v=19$m=64,t=0,p=1$" + salt + "$" + key},
+		{name: "zero parallelism", hash: "$argon2id$v=19$m=64,t=1,p=0$" + salt + "$" + key},
+		{name: "salt not base64", hash: "$argon2id$v=19$m=64,t=1,p=1$!!$" + key},
+		{name: "key not base64", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$!!"},
+		{name: "empty key", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeArgon2id(tt.hash)
+			assert.Equal(t, ErrInvalidHash, err)
+			assert.Equal(t, ErrInvalidHash, compareArgon2id("password123", tt.hash))
+			assert.True(t, argon2idNeedsRehash(tt.hash, testParams))
+		})
+	}
+}
+
+func TestArgon2idNeedsRehash(t *testing.T) {
+	hash, err := hashArgon2id("password123", testParams)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params Argon2idParams
+		want   bool
+	}{
+		{name: "same parameters", params: testParams},
+		{name: "weaker parameters", params: Argon2idParams{Memory: 32, Iterations: 1, Parallelism: 1}},
+		{name: "more memory", params: Argon2idParams{Memory: 128, Iterations: 1, Parallelism: 1}, want: true},
+		{name: "more iterations", params: Argon2idParams{Memory: 64, Iterations: 2, Parallelism: 1}, want: true},
+		{name: "more parallelism", params: Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 2}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, argon2idNeedsRehash(hash, tt.params))
+		})
+	}
+}
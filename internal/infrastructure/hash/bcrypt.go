@@ -0,0 +1,19 @@
+package hash
+
+import "golang.org/x/crypto/bcrypt"
+
+func hashBcrypt(secret string, cost int) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(secret), cost)
+
+	return string(bytes), err
+}
+
+func compareBcrypt(secret, hash string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret))
+}
+
+func bcryptNeedsRehash(hash string, cost int) bool {
+	hashCost, err := bcrypt.Cost([]byte(hash))
+
+	return err != nil || hashCost < cost
+}
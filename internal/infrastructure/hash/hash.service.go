@@ -2,28 +2,67 @@ package hash
 
 import (
 	"meye-core/internal/domain/user"
-
-	"golang.org/x/crypto/bcrypt"
+	"strings"
 )
 
-const cost = 12
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
 
 var _ user.HashService = (*Service)(nil)
 
-type Service struct{}
+// Service hashes the passwords with its configured algorithm, and verifies the hashes of both algorithms.
+// Hashes are self-describing: argon2id ones use the PHC string format, bcrypt ones the modular crypt format.
+type Service struct {
+	algorithm  string
+	bcryptCost int
+	argon2id   Argon2idParams
+}
+
+// NewArgon2id returns a service hashing with argon2id, hashes with weaker parameters or bcrypt ones needing a rehash.
+func NewArgon2id(params Argon2idParams) *Service {
+	return &Service{
+		algorithm: AlgorithmArgon2id,
+		argon2id:  params,
+	}
+}
 
-func New() *Service {
-	return &Service{}
+// NewBcrypt returns a service hashing with bcrypt, hashes with a lower cost or argon2id ones needing a rehash.
+func NewBcrypt(cost int) *Service {
+	return &Service{
+		algorithm:  AlgorithmBcrypt,
+		bcryptCost: cost,
+	}
 }
 
 // Hash recieves a string and returns an encrypted hash.
 func (s *Service) Hash(str string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(str), cost)
+	if s.algorithm == AlgorithmArgon2id {
+		return hashArgon2id(str, s.argon2id)
+	}
 
-	return string(bytes), err
+	return hashBcrypt(str, s.bcryptCost)
 }
 
 // Compare compares a hash with a secret string.
 func (s *Service) Compare(secret, hash string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret))
+	if isArgon2id(hash) {
+		return compareArgon2id(secret, hash)
+	}
+
+	return compareBcrypt(secret, hash)
+}
+
+// NeedsRehash tells whether hash was made with another algorithm or weaker parameters than the configured ones.
+func (s *Service) NeedsRehash(hash string) bool {
+	if isArgon2id(hash) {
+		return s.algorithm != AlgorithmArgon2id || argon2idNeedsRehash(hash, s.argon2id)
+	}
+
+	return s.algorithm != AlgorithmBcrypt || bcryptNeedsRehash(hash, s.bcryptCost)
+}
+
+func isArgon2id(hash string) bool {
+	return strings.HasPrefix(hash, argon2idPrefix)
 }
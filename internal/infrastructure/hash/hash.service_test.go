@@ -0,0 +1,90 @@
+package hash_test
+
+import (
+	"meye-core/internal/infrastructure/hash"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var argon2idParams = hash.Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestService_HashAndCompare(t *testing.T) {
+	services := map[string]*hash.Service{
+		hash.AlgorithmArgon2id: hash.NewArgon2id(argon2idParams),
+		hash.AlgorithmBcrypt:   hash.NewBcrypt(bcrypt.MinCost),
+	}
+
+	for algorithm, service := range services {
+		t.Run(algorithm, func(t *testing.T) {
+			hashed, err := service.Hash("password123")
+			require.NoError(t, err)
+
+			assert.NoError(t, service.Compare("password123", hashed))
+			assert.Error(t, service.Compare("password124", hashed))
+			assert.False(t, service.NeedsRehash(hashed))
+		})
+	}
+}
+
+func TestService_Compare(t *testing.T) {
+	service := hash.NewArgon2id(argon2idParams)
+
+	t.Run("verifies a bcrypt hash", func(t *testing.T) {
+		bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		assert.NoError(t, service.Compare("password123", string(bcryptHash)))
+		assert.Error(t, service.Compare("password124", string(bcryptHash)))
+	})
+
+	t.Run("rejects a malformed hash", func(t *testing.T) {
+		assert.Equal(t, hash.ErrInvalidHash, service.Compare("password123", "$argon2id$v=19$broken"))
+		assert.Error(t, service.Compare("password123", "not-a-hash"))
+	})
+}
+
+func TestService_NeedsRehash(t *testing.T) {
+	argon2idHash, err := hash.NewArgon2id(argon2idParams).Hash("password123")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(argon2idHash, "$argon2id$"))
+
+	bcryptHash, err := hash.NewBcrypt(bcrypt.MinCost).Hash("password123")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		service *hash.Service
+		hash    string
+		want    bool
+	}{
+		{name: "argon2id with the same parameters", service: hash.NewArgon2id(argon2idParams), hash: argon2idHash},
+		{
+			name:    "argon2id with more memory",
+			service: hash.NewArgon2id(hash.Argon2idParams{Memory: 128, Iterations: 1, Parallelism: 1}),
+			hash:    argon2idHash,
+			want:    true,
+		},
+		{
+			name:    "argon2id with more iterations",
+			service: hash.NewArgon2id(hash.Argon2idParams{Memory: 64, Iterations: 3, Parallelism: 1}),
+			hash:    argon2idHash,
+			want:    true,
+		},
+		{name: "bcrypt when hashing with argon2id", service: hash.NewArgon2id(argon2idParams), hash: bcryptHash, want: true},
+		{name: "bcrypt with the same cost", service: hash.NewBcrypt(bcrypt.MinCost), hash: bcryptHash},
+		{name: "bcrypt with a higher cost", service: hash.NewBcrypt(bcrypt.MinCost + 1), hash: bcryptHash, want: true},
+		{name: "argon2id when hashing with bcrypt", service: hash.NewBcrypt(bcrypt.MinCost), hash: argon2idHash, want: true},
+		{name: "malformed argon2id", service: hash.NewArgon2id(argon2idParams), hash: "$argon2id$v=19$broken", want: true},
+		{name: "malformed bcrypt", service: hash.NewBcrypt(bcrypt.MinCost), hash: "not-a-hash", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.service.NeedsRehash(tt.hash))
+		})
+	}
+}
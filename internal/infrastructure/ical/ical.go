@@ -0,0 +1,101 @@
+// Package ical writes iCalendar (RFC 5545) feeds.
+package ical
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	crlf          = "\r\n"
+	maxLineOctets = 75
+	dateTimeUTC   = "20060102T150405Z"
+)
+
+type Event struct {
+	UID      string // stable across updates, clients match the versions of an event by it
+	Sequence uint   // revision of the event, clients keep the highest
+	Start    time.Time
+	End      time.Time
+	Summary  string
+	Location string
+}
+
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+// Encode renders the calendar, stamping every event with now.
+func (c Calendar) Encode(now time.Time) []byte {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+c.ProdID)
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		writeLine(&buf, "BEGIN:VEVENT")
+		writeLine(&buf, "UID:"+e.UID)
+		writeLine(&buf, "SEQUENCE:"+strconv.FormatUint(uint64(e.Sequence), 10))
+		writeLine(&buf, "DTSTAMP:"+formatDateTime(now))
+		writeLine(&buf, "DTSTART:"+formatDateTime(e.Start))
+		writeLine(&buf, "DTEND:"+formatDateTime(e.End))
+		writeLine(&buf, "SUMMARY:"+escapeText(e.Summary))
+		if e.Location != "" {
+			writeLine(&buf, "LOCATION:"+escapeText(e.Location))
+		}
+		writeLine(&buf, "END:VEVENT")
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeUTC)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// writeLine folds the content line every 75 octets, continuation lines start with a space.
+// Multi-byte characters are never split.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString(crlf + " ")
+		line = line[cut:]
+		// The leading space counts towards the length of the continuation line
+		limit = maxLineOctets - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString(crlf)
+}
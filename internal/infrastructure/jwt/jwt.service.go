@@ -9,30 +9,55 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	MethodHS256 = "HS256"
+	MethodRS256 = "RS256"
+	MethodEdDSA = "EdDSA"
+)
+
 var _ user.JWTService = (*Service)(nil)
 
 type Service struct {
-	secret         string
+	method         jwt.SigningMethod
+	secret         string   // HS256 only
+	keyring        *Keyring // RS256 and EdDSA only
 	issuer         string
 	expirationTime time.Duration
 }
 
 type Claims struct {
-	UserID string `json:"userId"`
+	UserID       string          `json:"userId"`
+	Roles        []user.UserRole `json:"roles"`
+	TokenVersion int             `json:"ver"`
 	jwt.RegisteredClaims
 }
 
+// New creates a service signing tokens with HS256 and a shared secret.
 func New(secret, issuer string, expirationTime time.Duration) *Service {
 	return &Service{
+		method:         jwt.SigningMethodHS256,
 		secret:         secret,
 		issuer:         issuer,
 		expirationTime: expirationTime,
 	}
 }
 
+// NewWithKeyring creates a service signing tokens with the asymmetric keys of the keyring,
+// so other services can verify them from the JWKS without holding any secret.
+func NewWithKeyring(keyring *Keyring, issuer string, expirationTime time.Duration) *Service {
+	return &Service{
+		method:         jwt.GetSigningMethod(keyring.method),
+		keyring:        keyring,
+		issuer:         issuer,
+		expirationTime: expirationTime,
+	}
+}
+
 func (s *Service) GenerateSignedToken(user *user.User) (string, error) {
 	claims := Claims{
-		UserID: user.ID(),
+		UserID:       user.ID(),
+		Roles:        user.Roles(),
+		TokenVersion: user.TokenVersion(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.expirationTime)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -42,9 +67,20 @@ func (s *Service) GenerateSignedToken(user *user.User) (string, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token := jwt.NewWithClaims(s.method, claims)
+
+	var key interface{} = []byte(s.secret)
+	if s.keyring != nil {
+		kid, privateKey, err := s.keyring.SigningKey(time.Now())
+		if err != nil {
+			return "", err
+		}
+
+		token.Header["kid"] = kid
+		key = privateKey
+	}
 
-	tokenString, err := token.SignedString([]byte(s.secret))
+	tokenString, err := token.SignedString(key)
 	if err != nil {
 		return "", err
 	}
@@ -53,24 +89,44 @@ func (s *Service) GenerateSignedToken(user *user.User) (string, error) {
 }
 
 // ValidateToken parses a token string and validates it, returning the identification claims.
-func (s *Service) ValidateToken(tokenString string) (string, error) {
+// Only the configured signing method is accepted.
+func (s *Service) ValidateToken(tokenString string) (user.AccessClaims, error) {
 	claims := Claims{}
 
 	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if token.Method.Alg() != s.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 
-		return []byte(s.secret), nil
-	})
+		if s.keyring == nil {
+			return []byte(s.secret), nil
+		}
+
+		kid, _ := token.Header["kid"].(string)
+
+		return s.keyring.VerificationKey(kid, time.Now())
+	}, jwt.WithValidMethods([]string{s.method.Alg()}))
 
 	if err != nil {
-		return "", err
+		return user.AccessClaims{}, err
 	}
 
 	if !token.Valid {
-		return "", errors.New("token is not valid")
+		return user.AccessClaims{}, errors.New("token is not valid")
+	}
+
+	return user.AccessClaims{
+		UserID:       claims.UserID,
+		Roles:        claims.Roles,
+		TokenVersion: claims.TokenVersion,
+	}, nil
+}
+
+// JWKS returns the public keys verifying the tokens, empty with HS256 as the secret can't be published.
+func (s *Service) JWKS() JSONWebKeySet {
+	if s.keyring == nil {
+		return JSONWebKeySet{Keys: []JSONWebKey{}}
 	}
 
-	return claims.UserID, nil
+	return s.keyring.JWKS(time.Now())
 }
@@ -0,0 +1,360 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	keyFileExtension = ".pem"
+	rsaKeyBits       = 2048
+	// kidTimeLayout prefixes the kid of the generated keys with their creation time
+	kidTimeLayout = "20060102T150405Z"
+	// reloadInterval limits how often the directory is read again. A new key only signs once it
+	// is that old, so every instance sharing the directory can verify its tokens by then.
+	reloadInterval = time.Minute
+)
+
+var (
+	ErrUnknownKey   = errors.New("unknown signing key")
+	ErrNoSigningKey = errors.New("no signing key")
+)
+
+type signingKey struct {
+	id         string
+	createdAt  time.Time
+	privateKey crypto.Signer
+}
+
+// Keyring holds the asymmetric keys of a directory, one PKCS#8 PEM file per key named <kid>.pem.
+// The creation time of a key is the timestamp its kid starts with, keys named otherwise count as
+// older than every generated one. The newest key signs the tokens, and a key replaced by a newer
+// one keeps verifying tokens for tokenTTL, until every token it signed expired.
+//
+// The API instances only read the directory, new keys are written by RotateIfDue, which the
+// worker runs on a schedule.
+type Keyring struct {
+	mu       sync.RWMutex
+	dir      string
+	method   string
+	tokenTTL time.Duration
+	keys     []signingKey // oldest first
+	loadedAt time.Time
+}
+
+// NewKeyring loads the keys of dir for the RS256 or EdDSA method. dir may have no key yet, until
+// the first rotation.
+func NewKeyring(dir, method string, tokenTTL time.Duration) (*Keyring, error) {
+	if method != MethodRS256 && method != MethodEdDSA {
+		return nil, fmt.Errorf("signing method %s does not use keys", method)
+	}
+
+	k := &Keyring{
+		dir:      dir,
+		method:   method,
+		tokenTTL: tokenTTL,
+	}
+
+	if err := k.reload(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// HasKeys reports whether the directory held a key when it was last read.
+func (k *Keyring) HasKeys() bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return len(k.keys) > 0
+}
+
+// SigningKey returns the newest key old enough to sign, reading the directory again when the
+// keys loaded are stale, to pick up the keys rotated by the worker.
+func (k *Keyring) SigningKey(now time.Time) (string, crypto.Signer, error) {
+	k.mu.RLock()
+	stale := len(k.keys) == 0 || now.Sub(k.loadedAt) >= reloadInterval
+	k.mu.RUnlock()
+
+	if stale {
+		k.mu.Lock()
+		if err := k.reload(now); err != nil {
+			logrus.Errorf("Failed to reload JWT keys: %v", err)
+		}
+		k.mu.Unlock()
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return "", nil, ErrNoSigningKey
+	}
+
+	key := k.keys[k.signing(now)]
+
+	return key.id, key.privateKey, nil
+}
+
+// RotateIfDue generates a new key once the newest one is older than interval, or when the
+// directory has no key yet, a zero interval only writing the first key. The directory is read
+// again first, in case another worker rotated.
+func (k *Keyring) RotateIfDue(now time.Time, interval time.Duration) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if err := k.reload(now); err != nil {
+		return err
+	}
+
+	if len(k.keys) > 0 && (interval <= 0 || now.Sub(k.newest().createdAt) < interval) {
+		return nil
+	}
+
+	return k.rotate(now)
+}
+
+// VerificationKey returns the public key of kid, as long as it may still have signed unexpired tokens.
+// An unknown kid makes the directory be read again, to pick up the keys added by other instances.
+func (k *Keyring) VerificationKey(kid string, now time.Time) (crypto.PublicKey, error) {
+	k.mu.RLock()
+	key, found := k.find(kid, now)
+	stale := now.Sub(k.loadedAt) >= reloadInterval
+	k.mu.RUnlock()
+
+	if !found && stale {
+		k.mu.Lock()
+		if err := k.reload(now); err != nil {
+			logrus.Errorf("Failed to reload JWT keys: %v", err)
+		}
+		key, found = k.find(kid, now)
+		k.mu.Unlock()
+	}
+
+	if !found {
+		return nil, ErrUnknownKey
+	}
+
+	return key.privateKey.Public(), nil
+}
+
+// JWKS returns the public keys still valid for verification.
+func (k *Keyring) JWKS(now time.Time) JSONWebKeySet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for i, key := range k.keys {
+		if !k.verifiable(i, now) {
+			continue
+		}
+
+		set.Keys = append(set.Keys, newJSONWebKey(key.id, k.method, key.privateKey.Public()))
+	}
+
+	return set
+}
+
+func (k *Keyring) newest() signingKey {
+	if len(k.keys) == 0 {
+		return signingKey{}
+	}
+
+	return k.keys[len(k.keys)-1]
+}
+
+// signing returns the index of the newest key old enough to sign, the oldest key when none is.
+func (k *Keyring) signing(now time.Time) int {
+	for i := len(k.keys) - 1; i > 0; i-- {
+		if !now.Before(activatedAt(k.keys[i])) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func activatedAt(key signingKey) time.Time {
+	return key.createdAt.Add(reloadInterval)
+}
+
+// verifiable tells whether the i-th key signs or was replaced less than a token lifetime ago.
+// Keys newer than the signing one verify too, other instances may already sign with them.
+func (k *Keyring) verifiable(i int, now time.Time) bool {
+	if i >= k.signing(now) {
+		return true
+	}
+
+	retiredAt := activatedAt(k.keys[i+1])
+
+	return now.Before(retiredAt.Add(k.tokenTTL))
+}
+
+func (k *Keyring) find(kid string, now time.Time) (signingKey, bool) {
+	for i, key := range k.keys {
+		if key.id == kid {
+			return key, k.verifiable(i, now)
+		}
+	}
+
+	return signingKey{}, false
+}
+
+func (k *Keyring) reload(now time.Time) error {
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]signingKey, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), keyFileExtension) {
+			continue
+		}
+
+		key, err := k.loadKey(entry)
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].createdAt.Equal(keys[j].createdAt) {
+			return keys[i].id < keys[j].id
+		}
+
+		return keys[i].createdAt.Before(keys[j].createdAt)
+	})
+
+	k.keys = keys
+	k.loadedAt = now
+
+	return nil
+}
+
+func (k *Keyring) loadKey(entry os.DirEntry) (signingKey, error) {
+	content, err := os.ReadFile(filepath.Join(k.dir, entry.Name()))
+	if err != nil {
+		return signingKey{}, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return signingKey{}, errors.New("no PEM block found")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return signingKey{}, err
+	}
+
+	var privateKey crypto.Signer
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.method != MethodRS256 {
+			return signingKey{}, fmt.Errorf("RSA key can't be used with %s", k.method)
+		}
+		privateKey = key
+	case ed25519.PrivateKey:
+		if k.method != MethodEdDSA {
+			return signingKey{}, fmt.Errorf("Ed25519 key can't be used with %s", k.method)
+		}
+		privateKey = key
+	default:
+		return signingKey{}, fmt.Errorf("unsupported key type %T", parsed)
+	}
+
+	kid := strings.TrimSuffix(entry.Name(), keyFileExtension)
+
+	return signingKey{
+		id:         kid,
+		createdAt:  kidCreatedAt(kid),
+		privateKey: privateKey,
+	}, nil
+}
+
+// kidCreatedAt reads the creation time of the generated keys from their kid, the zero time for
+// the keys named otherwise.
+func kidCreatedAt(kid string) time.Time {
+	prefix, _, _ := strings.Cut(kid, "-")
+
+	createdAt, err := time.Parse(kidTimeLayout, prefix)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return createdAt
+}
+
+// rotate generates a new key and writes it to the directory, where it becomes the signing key.
+func (k *Keyring) rotate(now time.Time) error {
+	var privateKey crypto.Signer
+	var err error
+
+	switch k.method {
+	case MethodRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case MethodEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		return err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+
+	kid := fmt.Sprintf("%s-%s", now.UTC().Format(kidTimeLayout), hex.EncodeToString(suffix))
+
+	// Written aside then renamed, so other instances never read a partial key
+	tmp, err := os.CreateTemp(k.dir, ".key-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := pem.Encode(tmp, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(k.dir, kid+keyFileExtension)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	k.keys = append(k.keys, signingKey{id: kid, createdAt: kidCreatedAt(kid), privateKey: privateKey})
+
+	logrus.WithField("kid", kid).Info("JWT signing key rotated")
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package jwt_test
+
+import (
+	"meye-core/internal/domain/user"
+	"meye-core/internal/infrastructure/jwt"
+	"meye-core/tests/data"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenTTL = time.Hour
+
+func newUser() *user.User {
+	return user.CreateUserWithoutValidation(data.UserID, data.Username, data.HashedPassword, data.Roles, "", 0, nil, false, nil)
+}
+
+func newKeyring(t *testing.T, dir, method string) *jwt.Keyring {
+	keyring, err := jwt.NewKeyring(dir, method, tokenTTL)
+	require.NoError(t, err)
+
+	return keyring
+}
+
+func jwksKids(keyring *jwt.Keyring, now time.Time) []string {
+	kids := []string{}
+	for _, key := range keyring.JWKS(now).Keys {
+		kids = append(kids, key.Kid)
+	}
+
+	return kids
+}
+
+func TestKeyring_RotateIfDue(t *testing.T) {
+	now := time.Now()
+
+	t.Run("writes the first key named after its creation time", func(t *testing.T) {
+		dir := t.TempDir()
+		keyring := newKeyring(t, dir, jwt.MethodEdDSA)
+		assert.False(t, keyring.HasKeys())
+
+		_, _, err := keyring.SigningKey(now)
+		assert.Equal(t, jwt.ErrNoSigningKey, err)
+
+		require.NoError(t, keyring.RotateIfDue(now, 0))
+
+		kid, _, err := keyring.SigningKey(now)
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^\d{8}T\d{6}Z-[0-9a-f]{8}$`), kid)
+		assert.FileExists(t, filepath.Join(dir, kid+".pem"))
+	})
+
+	t.Run("rotates once the newest key is older than the interval", func(t *testing.T) {
+		dir := t.TempDir()
+		keyring := newKeyring(t, dir, jwt.MethodRS256)
+
+		require.NoError(t, keyring.RotateIfDue(now.Add(-2*time.Hour), 24*time.Hour))
+		require.NoError(t, keyring.RotateIfDue(now, 24*time.Hour))
+		require.NoError(t, keyring.RotateIfDue(now, 0))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		require.NoError(t, keyring.RotateIfDue(now, time.Hour))
+
+		entries, err = os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+
+	t.Run("picks up the keys written by another instance", func(t *testing.T) {
+		dir := t.TempDir()
+		worker := newKeyring(t, dir, jwt.MethodEdDSA)
+		api := newKeyring(t, dir, jwt.MethodEdDSA)
+
+		require.NoError(t, worker.RotateIfDue(now.Add(-time.Hour), 0))
+
+		kid, _, err := api.SigningKey(now)
+		require.NoError(t, err)
+		assert.Equal(t, jwksKids(worker, now), []string{kid})
+	})
+}
+
+func TestKeyring_SigningKey(t *testing.T) {
+	now := time.Now()
+	dir := t.TempDir()
+	keyring := newKeyring(t, dir, jwt.MethodEdDSA)
+
+	require.NoError(t, keyring.RotateIfDue(now.Add(-2*time.Hour), 0))
+	previousKid, _, err := keyring.SigningKey(now)
+	require.NoError(t, err)
+
+	require.NoError(t, keyring.RotateIfDue(now, time.Hour))
+
+	// The new key only signs once the other instances had the time to load it
+	kid, _, err := keyring.SigningKey(now)
+	require.NoError(t, err)
+	assert.Equal(t, previousKid, kid)
+
+	kid, _, err = keyring.SigningKey(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.NotEqual(t, previousKid, kid)
+}
+
+func TestKeyring_JWKS(t *testing.T) {
+	now := time.Now()
+	dir := t.TempDir()
+	keyring := newKeyring(t, dir, jwt.MethodRS256)
+
+	require.NoError(t, keyring.RotateIfDue(now.Add(-48*time.Hour), 0))
+	require.NoError(t, keyring.RotateIfDue(now.Add(-24*time.Hour), time.Hour))
+	require.NoError(t, keyring.RotateIfDue(now.Add(-30*time.Minute), time.Hour))
+
+	kids := jwksKids(keyring, now)
+	require.Len(t, kids, 2, "the key replaced a day ago is no longer published")
+
+	for _, key := range keyring.JWKS(now).Keys {
+		assert.Equal(t, "RSA", key.Kty)
+		assert.Equal(t, jwt.MethodRS256, key.Alg)
+		assert.Equal(t, "sig", key.Use)
+		assert.NotEmpty(t, key.N)
+		assert.NotEmpty(t, key.E)
+	}
+
+	// Once the token lifetime passed, only the newest key is left
+	assert.Len(t, jwksKids(keyring, now.Add(2*tokenTTL)), 1)
+}
+
+func TestService_WithKeyring(t *testing.T) {
+	for _, method := range []string{jwt.MethodRS256, jwt.MethodEdDSA} {
+		t.Run(method, func(t *testing.T) {
+			now := time.Now()
+			dir := t.TempDir()
+
+			keyring := newKeyring(t, dir, method)
+			require.NoError(t, keyring.RotateIfDue(now.Add(-3*time.Hour), 0))
+
+			service := jwt.NewWithKeyring(keyring, "meye-core", 24*time.Hour)
+			token, err := service.GenerateSignedToken(newUser())
+			require.NoError(t, err)
+
+			claims, err := service.ValidateToken(token)
+			require.NoError(t, err)
+			assert.Equal(t, data.UserID, claims.UserID)
+			assert.Equal(t, data.Roles, claims.Roles)
+
+			t.Run("the previous key verifies for a token lifetime", func(t *testing.T) {
+				rotatedDir := t.TempDir()
+				copyDir(t, dir, rotatedDir)
+
+				rotated := newKeyring(t, rotatedDir, method)
+				require.NoError(t, rotated.RotateIfDue(now.Add(-30*time.Minute), time.Hour))
+
+				_, err := jwt.NewWithKeyring(rotated, "meye-core", 24*time.Hour).ValidateToken(token)
+				assert.NoError(t, err)
+			})
+
+			t.Run("the previous key is rejected after a token lifetime", func(t *testing.T) {
+				rotatedDir := t.TempDir()
+				copyDir(t, dir, rotatedDir)
+
+				rotated := newKeyring(t, rotatedDir, method)
+				require.NoError(t, rotated.RotateIfDue(now.Add(-2*time.Hour), time.Hour))
+
+				_, err := jwt.NewWithKeyring(rotated, "meye-core", 24*time.Hour).ValidateToken(token)
+				assert.ErrorIs(t, err, jwt.ErrUnknownKey)
+			})
+
+			t.Run("a token signed by another method is rejected", func(t *testing.T) {
+				_, err := jwt.New("secret", "meye-core", time.Hour).ValidateToken(token)
+				assert.Error(t, err)
+			})
+		})
+	}
+}
+
+func copyDir(t *testing.T, from, to string) {
+	entries, err := os.ReadDir(from)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(from, entry.Name()))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(to, entry.Name()), content, 0o600))
+	}
+}
@@ -0,0 +1,144 @@
+// Package recap renders session recaps from Markdown and HTML templates. The built-in templates
+// can be overridden by files with the same name in a directory.
+package recap
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	texttemplate "text/template"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatMarkdown Format = "md"
+	FormatHTML     Format = "html"
+)
+
+const (
+	markdownTemplateName = "recap.md.tmpl"
+	htmlTemplateName     = "recap.html.tmpl"
+)
+
+var ErrUnknownFormat = errors.New("unknown recap format")
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// Recap is the data the templates are executed with.
+type Recap struct {
+	CampaignName   string
+	Summary        string
+	Status         string
+	PlayedAt       time.Time
+	Attendees      []string // PJ names
+	XPAssignations []XPAssignation
+	StatsUpgrades  []StatsUpgrade
+}
+
+type XPAmounts struct {
+	Basic        uint
+	Special      uint
+	Supernatural uint
+}
+
+type XPAssignation struct {
+	PJ      string
+	Kind    string // attendance or bonus
+	Amounts XPAmounts
+	Reason  string
+}
+
+type StatChange struct {
+	Stat     string // dotted path, e.g. "physical.strength"
+	Previous uint
+	Current  uint
+}
+
+type StatsUpgrade struct {
+	PJ         string
+	SpentXP    XPAmounts
+	Changes    []StatChange
+	OccurredAt time.Time
+}
+
+type Renderer struct {
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// New parses the templates once. A template found in dir replaces the built-in one, an empty dir
+// keeps every built-in template.
+func New(dir string) (*Renderer, error) {
+	markdownSource, err := readTemplate(dir, markdownTemplateName)
+	if err != nil {
+		return nil, err
+	}
+
+	markdown, err := texttemplate.New(markdownTemplateName).Parse(markdownSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", markdownTemplateName, err)
+	}
+
+	htmlSource, err := readTemplate(dir, htmlTemplateName)
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := htmltemplate.New(htmlTemplateName).Parse(htmlSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", htmlTemplateName, err)
+	}
+
+	return &Renderer{
+		markdown: markdown,
+		html:     html,
+	}, nil
+}
+
+func readTemplate(dir, name string) (string, error) {
+	if dir != "" {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			return string(content), nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("failed to read %s: %w", name, err)
+		}
+	}
+
+	content, err := builtinTemplates.ReadFile("templates/" + name)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func (r *Renderer) Render(w io.Writer, format Format, recap Recap) error {
+	switch format {
+	case FormatMarkdown:
+		return r.markdown.Execute(w, recap)
+	case FormatHTML:
+		return r.html.Execute(w, recap)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+// ContentType is the media type of the recaps rendered in the format.
+func ContentType(format Format) string {
+	if format == FormatHTML {
+		return "text/html; charset=utf-8"
+	}
+
+	return "text/markdown; charset=utf-8"
+}
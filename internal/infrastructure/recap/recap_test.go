@@ -0,0 +1,115 @@
+package recap_test
+
+import (
+	"bytes"
+	"meye-core/internal/infrastructure/recap"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecap() recap.Recap {
+	return recap.Recap{
+		CampaignName: "The Lost Mines",
+		Summary:      "We met the <smuggler>",
+		Status:       "finalized",
+		PlayedAt:     time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC),
+		Attendees:    []string{"Aria", "Brom"},
+		XPAssignations: []recap.XPAssignation{
+			{PJ: "Aria", Kind: "attendance", Amounts: recap.XPAmounts{Basic: 10}},
+			{PJ: "Brom", Kind: "bonus", Amounts: recap.XPAmounts{Special: 5}, Reason: "bribed the guard"},
+		},
+		StatsUpgrades: []recap.StatsUpgrade{
+			{
+				PJ:      "Aria",
+				SpentXP: recap.XPAmounts{Basic: 30},
+				Changes: []recap.StatChange{{Stat: "physical.strength", Previous: 1, Current: 2}},
+			},
+		},
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	renderer, err := recap.New("")
+	require.NoError(t, err)
+
+	t.Run("markdown", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, renderer.Render(&buf, recap.FormatMarkdown, newRecap()))
+
+		out := buf.String()
+		assert.Contains(t, out, "# The Lost Mines · 2026-03-14")
+		assert.Contains(t, out, "We met the <smuggler>")
+		assert.Contains(t, out, "- Aria\n- Brom\n")
+		assert.Contains(t, out, "| Aria | 10 | 0 | 0 | Attendance |")
+		assert.Contains(t, out, "| Brom | 0 | 5 | 0 | bribed the guard |")
+		assert.Contains(t, out, "- **Aria** (30 basic, 0 special, 0 supernatural XP spent)")
+		assert.Contains(t, out, "  - physical.strength: 1 → 2")
+		assert.NotContains(t, out, "_finalized_")
+	})
+
+	t.Run("html escapes the session texts", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, renderer.Render(&buf, recap.FormatHTML, newRecap()))
+
+		out := buf.String()
+		assert.Contains(t, out, "<h1>The Lost Mines · 2026-03-14</h1>")
+		assert.Contains(t, out, "We met the &lt;smuggler&gt;")
+		assert.Contains(t, out, "<tr><td>Brom</td><td>0</td><td>5</td><td>0</td><td>bribed the guard</td></tr>")
+	})
+
+	t.Run("empty sections and draft status", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, renderer.Render(&buf, recap.FormatMarkdown, recap.Recap{
+			CampaignName: "The Lost Mines",
+			Status:       "draft",
+		}))
+
+		out := buf.String()
+		assert.Contains(t, out, "_draft_")
+		assert.Contains(t, out, "No attendees recorded.")
+		assert.Contains(t, out, "No XP handed out.")
+		assert.Contains(t, out, "No upgrades bought.")
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.Equal(t, recap.ErrUnknownFormat, renderer.Render(&buf, recap.Format("pdf"), newRecap()))
+	})
+}
+
+func TestNew_TemplateDir(t *testing.T) {
+	t.Run("a template in the directory replaces the built-in one", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "recap.md.tmpl"), []byte("Recap of {{.CampaignName}}"), 0o600))
+
+		renderer, err := recap.New(dir)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, renderer.Render(&buf, recap.FormatMarkdown, newRecap()))
+		assert.Equal(t, "Recap of The Lost Mines", buf.String())
+
+		// The HTML template is not in the directory, the built-in one is kept
+		buf.Reset()
+		require.NoError(t, renderer.Render(&buf, recap.FormatHTML, newRecap()))
+		assert.Contains(t, buf.String(), "<h1>The Lost Mines · 2026-03-14</h1>")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "recap.html.tmpl"), []byte("{{.CampaignName"), 0o600))
+
+		_, err := recap.New(dir)
+		assert.ErrorContains(t, err, "failed to parse recap.html.tmpl")
+	})
+}
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "text/markdown; charset=utf-8", recap.ContentType(recap.FormatMarkdown))
+	assert.Equal(t, "text/html; charset=utf-8", recap.ContentType(recap.FormatHTML))
+}
@@ -1,24 +1,175 @@
 package postgres
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"meye-core/internal/domain/campaign"
 	"meye-core/internal/domain/session"
 	"time"
 )
 
+type HouseRulesJSON struct {
+	MaxTalents       uint            `json:"max_talents"`
+	ExclusiveTalents [][]string      `json:"exclusive_talents"`
+	StatCaps         map[string]uint `json:"stat_caps"`
+	AttendanceXP     XPAmountsJSON   `json:"attendance_xp"`
+	SessionXPCap     XPAmountsJSON   `json:"session_xp_cap"`
+}
+
+func (h HouseRulesJSON) Value() (driver.Value, error) {
+	return json.Marshal(h)
+}
+
+func (h *HouseRulesJSON) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, h)
+}
+
+func getHouseRulesJSON(hr campaign.HouseRules) HouseRulesJSON {
+	exclusiveTalents := make([][]string, len(hr.ExclusiveTalents()))
+	for i, group := range hr.ExclusiveTalents() {
+		exclusiveTalents[i] = make([]string, len(group))
+		for j, talent := range group {
+			exclusiveTalents[i][j] = string(talent)
+		}
+	}
+
+	statCaps := make(map[string]uint, len(hr.StatCaps()))
+	for stat, limit := range hr.StatCaps() {
+		statCaps[string(stat)] = limit
+	}
+
+	return HouseRulesJSON{
+		MaxTalents:       hr.MaxTalents(),
+		ExclusiveTalents: exclusiveTalents,
+		StatCaps:         statCaps,
+		AttendanceXP: XPAmountsJSON{
+			Basic:        hr.AttendanceXP().Basic(),
+			Special:      hr.AttendanceXP().Special(),
+			Supernatural: hr.AttendanceXP().Supernatural(),
+		},
+		SessionXPCap: XPAmountsJSON{
+			Basic:        hr.SessionXPCap().Basic(),
+			Special:      hr.SessionXPCap().Special(),
+			Supernatural: hr.SessionXPCap().Supernatural(),
+		},
+	}
+}
+
+func (h HouseRulesJSON) ToDomain() campaign.HouseRules {
+	exclusiveTalents := make([][]campaign.Talent, len(h.ExclusiveTalents))
+	for i, group := range h.ExclusiveTalents {
+		exclusiveTalents[i] = make([]campaign.Talent, len(group))
+		for j, talent := range group {
+			exclusiveTalents[i][j] = campaign.Talent(talent)
+		}
+	}
+
+	statCaps := make(map[campaign.Stat]uint, len(h.StatCaps))
+	for stat, limit := range h.StatCaps {
+		statCaps[campaign.Stat(stat)] = limit
+	}
+
+	attendanceXP := campaign.CreateXPWithoutValidation(h.AttendanceXP.Basic, h.AttendanceXP.Special, h.AttendanceXP.Supernatural)
+
+	sessionXPCap := campaign.CreateXPWithoutValidation(h.SessionXPCap.Basic, h.SessionXPCap.Special, h.SessionXPCap.Supernatural)
+
+	return campaign.CreateHouseRulesWithoutValidation(h.MaxTalents, exclusiveTalents, statCaps, attendanceXP, sessionXPCap)
+}
+
+type RulesetJSON struct {
+	Name                          string `json:"name"`
+	BasicLevelStep                uint   `json:"basic_level_step"`
+	BasicFirstLevelCost           uint   `json:"basic_first_level_cost"`
+	BasicTalentedFirstLevelCost   uint   `json:"basic_talented_first_level_cost"`
+	LifeCost                      uint   `json:"life_cost"`
+	SpecialLevelStep              uint   `json:"special_level_step"`
+	SpecialFirstLevelCost         uint   `json:"special_first_level_cost"`
+	SpecialTalentedFirstLevelCost uint   `json:"special_talented_first_level_cost"`
+	EnergyTankCost                uint   `json:"energy_tank_cost"`
+	EnergyTankTalentedCost        uint   `json:"energy_tank_talented_cost"`
+	SupernaturalLevelStep         uint   `json:"supernatural_level_step"`
+	SupernaturalFirstLevelCost    uint   `json:"supernatural_first_level_cost"`
+}
+
+func (r RulesetJSON) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *RulesetJSON) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, r)
+}
+
+func getRulesetJSON(r campaign.Ruleset) RulesetJSON {
+	return RulesetJSON{
+		Name:                          r.Name(),
+		BasicLevelStep:                r.BasicLevelStep(),
+		BasicFirstLevelCost:           r.BasicFirstLevelCost(),
+		BasicTalentedFirstLevelCost:   r.BasicTalentedFirstLevelCost(),
+		LifeCost:                      r.LifeCost(),
+		SpecialLevelStep:              r.SpecialLevelStep(),
+		SpecialFirstLevelCost:         r.SpecialFirstLevelCost(),
+		SpecialTalentedFirstLevelCost: r.SpecialTalentedFirstLevelCost(),
+		EnergyTankCost:                r.EnergyTankCost(),
+		EnergyTankTalentedCost:        r.EnergyTankTalentedCost(),
+		SupernaturalLevelStep:         r.SupernaturalLevelStep(),
+		SupernaturalFirstLevelCost:    r.SupernaturalFirstLevelCost(),
+	}
+}
+
+func (r RulesetJSON) ToDomain() campaign.Ruleset {
+	return campaign.CreateRulesetWithoutValidation(campaign.RulesetParameters{
+		Name:                          r.Name,
+		BasicLevelStep:                r.BasicLevelStep,
+		BasicFirstLevelCost:           r.BasicFirstLevelCost,
+		BasicTalentedFirstLevelCost:   r.BasicTalentedFirstLevelCost,
+		LifeCost:                      r.LifeCost,
+		SpecialLevelStep:              r.SpecialLevelStep,
+		SpecialFirstLevelCost:         r.SpecialFirstLevelCost,
+		SpecialTalentedFirstLevelCost: r.SpecialTalentedFirstLevelCost,
+		EnergyTankCost:                r.EnergyTankCost,
+		EnergyTankTalentedCost:        r.EnergyTankTalentedCost,
+		SupernaturalLevelStep:         r.SupernaturalLevelStep,
+		SupernaturalFirstLevelCost:    r.SupernaturalFirstLevelCost,
+	})
+}
+
 type Campaign struct {
-	ID        string `gorm:"primaryKey"`
-	Name      string
-	MasterID  string
-	CreatedAt time.Time `gorm:"default:current_timestamp"`
-	UpdatedAt time.Time `gorm:"default:current_timestamp"`
+	ID                     string `gorm:"primaryKey"`
+	Name                   string
+	MasterID               string
+	StartingXPBasic        uint           `gorm:"column:starting_xp_basic"`
+	StartingXPSpecial      uint           `gorm:"column:starting_xp_special"`
+	StartingXPSupernatural uint           `gorm:"column:starting_xp_supernatural"`
+	HouseRules             HouseRulesJSON `gorm:"type:jsonb"`
+	Ruleset                RulesetJSON    `gorm:"type:jsonb"`
+	CreatedAt              time.Time      `gorm:"default:current_timestamp"`
+	UpdatedAt              time.Time      `gorm:"default:current_timestamp"`
 }
 
 func GetModelFromDomainCampaign(c *campaign.Campaign) *Campaign {
 	return &Campaign{
-		ID:       c.ID(),
-		Name:     c.Name(),
-		MasterID: c.MasterID(),
+		ID:                     c.ID(),
+		Name:                   c.Name(),
+		MasterID:               c.MasterID(),
+		StartingXPBasic:        c.StartingXP().Basic(),
+		StartingXPSpecial:      c.StartingXP().Special(),
+		StartingXPSupernatural: c.StartingXP().Supernatural(),
+		HouseRules:             getHouseRulesJSON(c.HouseRules()),
+		Ruleset:                getRulesetJSON(c.Ruleset()),
 	}
 }
 
@@ -28,9 +179,11 @@ func (c *Campaign) ToDomain(invitations []CampaignInvitation, pjs []PJ, sessions
 		domainInvitations = append(domainInvitations, inv.ToDomain())
 	}
 
+	ruleset := c.Ruleset.ToDomain()
+
 	domainPJs := make([]*campaign.PJ, 0, len(pjs))
 	for _, pj := range pjs {
-		domainPJs = append(domainPJs, pj.ToDomain())
+		domainPJs = append(domainPJs, pj.ToDomain(ruleset))
 	}
 
 	domainSessions := make([]*session.Session, 0, len(sessions))
@@ -42,6 +195,9 @@ func (c *Campaign) ToDomain(invitations []CampaignInvitation, pjs []PJ, sessions
 		c.ID,
 		c.MasterID,
 		c.Name,
+		campaign.CreateXPWithoutValidation(c.StartingXPBasic, c.StartingXPSpecial, c.StartingXPSupernatural),
+		c.HouseRules.ToDomain(),
+		ruleset,
 		domainInvitations,
 		domainPJs,
 		domainSessions,
@@ -20,6 +20,24 @@ func New(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
+func (r *Repository) FindHouseRules(ctx context.Context, id string) (*campaign.HouseRules, error) {
+	var houseRules []HouseRulesJSON
+
+	err := r.db.WithContext(ctx).
+		Model(&Campaign{}).
+		Where("id = ?", id).
+		Limit(1).
+		Pluck("house_rules", &houseRules).Error
+
+	if err != nil || len(houseRules) == 0 {
+		return nil, err
+	}
+
+	hr := houseRules[0].ToDomain()
+
+	return &hr, nil
+}
+
 func (r *Repository) FindByID(ctx context.Context, id string) (*campaign.Campaign, error) {
 	var campaignModel Campaign
 	result := r.db.Where("id = ?", id).First(&campaignModel)
@@ -51,6 +69,20 @@ func (r *Repository) FindByID(ctx context.Context, id string) (*campaign.Campaig
 		return nil, result.Error
 	}
 
+	sessionIDs := make([]string, 0, len(sessionModels))
+	for i := range sessionModels {
+		sessionIDs = append(sessionIDs, sessionModels[i].ID)
+	}
+
+	xpAssignations, err := shared.FindSessionXPAssignations(r.db, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessionModels {
+		sessionModels[i].XPAssignations = xpAssignations[sessionModels[i].ID]
+	}
+
 	return campaignModel.ToDomain(invitationModels, pjModels, sessionModels), nil
 }
 
@@ -62,9 +94,10 @@ func (r *Repository) Save(ctx context.Context, c *campaign.Campaign) error {
 		result := tx.Clauses(clause.OnConflict{
 			Columns: []clause.Column{{Name: "id"}},
 			DoUpdates: clause.Assignments(map[string]interface{}{
-				"name":       campaignModel.Name,
-				"master_id":  campaignModel.MasterID,
-				"updated_at": gorm.Expr("CURRENT_TIMESTAMP"),
+				"name":        campaignModel.Name,
+				"master_id":   campaignModel.MasterID,
+				"house_rules": campaignModel.HouseRules,
+				"updated_at":  gorm.Expr("CURRENT_TIMESTAMP"),
 			}),
 		}).Create(campaignModel)
 
@@ -203,17 +236,15 @@ func (r *Repository) Save(ctx context.Context, c *campaign.Campaign) error {
 			sessionModel := GetModelFromDomainSession(domainSession)
 			newSessionIDs[sessionModel.ID] = true
 
-			result := tx.Clauses(clause.OnConflict{
-				Columns: []clause.Column{{Name: "id"}},
-				DoUpdates: clause.Assignments(map[string]interface{}{
-					"summary":         sessionModel.Summary,
-					"xp_assignations": sessionModel.XPAssignations,
-				}),
-			}).Create(sessionModel)
+			result := tx.Clauses(shared.UpsertSession()).Create(sessionModel)
 
 			if result.Error != nil {
 				return result.Error
 			}
+
+			if err := shared.ReplaceSessionXPAssignations(tx, domainSession); err != nil {
+				return err
+			}
 		}
 
 		// Delete Sessions that are no longer in the domain array
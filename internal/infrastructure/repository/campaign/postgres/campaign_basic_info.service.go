@@ -39,3 +39,19 @@ func (qd *CampaignQueryService) GetCampaignsBasicInfo(ctx context.Context, maste
 
 	return result, nil
 }
+
+func (qd *CampaignQueryService) GetCampaignBasicInfo(ctx context.Context, campaignID string) (*domaincampaign.CampaignBasicInfo, error) {
+	var campaigns []Campaign
+
+	err := qd.db.WithContext(ctx).
+		Select("id", "name", "master_id").
+		Where("id = ?", campaignID).
+		Limit(1).
+		Find(&campaigns).Error
+
+	if err != nil || len(campaigns) == 0 {
+		return nil, err
+	}
+
+	return domaincampaign.CreateCampaignBasicInfo(campaigns[0].ID, campaigns[0].Name, campaigns[0].MasterID), nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	domaincampaign "meye-core/internal/domain/campaign"
+
+	"gorm.io/gorm"
+)
+
+var _ domaincampaign.MembershipQueryService = (*MembershipQueryService)(nil)
+
+type MembershipQueryService struct {
+	db *gorm.DB
+}
+
+func NewMembershipQueryService(db *gorm.DB) *MembershipQueryService {
+	return &MembershipQueryService{
+		db: db,
+	}
+}
+
+func (qs *MembershipQueryService) FindCampaignMasterID(ctx context.Context, campaignID string) (string, error) {
+	var masterIDs []string
+
+	err := qs.db.WithContext(ctx).
+		Model(&Campaign{}).
+		Where("id = ?", campaignID).
+		Limit(1).
+		Pluck("master_id", &masterIDs).Error
+
+	if err != nil || len(masterIDs) == 0 {
+		return "", err
+	}
+
+	return masterIDs[0], nil
+}
+
+func (qs *MembershipQueryService) FindPjOwnerID(ctx context.Context, pjID string) (string, error) {
+	var userIDs []string
+
+	err := qs.db.WithContext(ctx).
+		Model(&PJ{}).
+		Where("id = ?", pjID).
+		Limit(1).
+		Pluck("user_id", &userIDs).Error
+
+	if err != nil || len(userIDs) == 0 {
+		return "", err
+	}
+
+	return userIDs[0], nil
+}
+
+func (qs *MembershipQueryService) FindUserPjIDs(ctx context.Context, campaignID, userID string) ([]string, error) {
+	var pjIDs []string
+
+	err := qs.db.WithContext(ctx).
+		Model(&PJ{}).
+		Where("campaign_id = ? AND user_id = ?", campaignID, userID).
+		Pluck("id", &pjIDs).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pjIDs, nil
+}
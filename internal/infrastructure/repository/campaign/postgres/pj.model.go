@@ -12,7 +12,19 @@ type SupernaturalStatsJSON struct {
 }
 
 type SkillJSON struct {
-	Transformations []uint `json:"transformations"`
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description"`
+	UnlockCost      uint                 `json:"unlock_cost"`
+	Transformations []TransformationJSON `json:"transformations"`
+}
+
+type TransformationJSON struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Level       uint   `json:"level"`
+	UnlockCost  uint   `json:"unlock_cost"`
 }
 
 func (s SupernaturalStatsJSON) Value() (driver.Value, error) {
@@ -160,8 +172,24 @@ func GetModelFromDomainPJ(pj *campaign.PJ) *PJ {
 		skills := pj.SupernaturalStats().Skills()
 		skillsJSON := make([]SkillJSON, len(skills))
 		for i, skill := range skills {
+			transformations := skill.Transformations()
+			transformationsJSON := make([]TransformationJSON, len(transformations))
+			for j, t := range transformations {
+				transformationsJSON[j] = TransformationJSON{
+					ID:          t.ID(),
+					Name:        t.Name(),
+					Description: t.Description(),
+					Level:       t.Level(),
+					UnlockCost:  t.UnlockCost(),
+				}
+			}
+
 			skillsJSON[i] = SkillJSON{
-				Transformations: skill.Transformations(),
+				ID:              skill.ID(),
+				Name:            skill.Name(),
+				Description:     skill.Description(),
+				UnlockCost:      skill.UnlockCost(),
+				Transformations: transformationsJSON,
 			}
 		}
 		model.SupernaturalStats = &SupernaturalStatsJSON{
@@ -172,7 +200,7 @@ func GetModelFromDomainPJ(pj *campaign.PJ) *PJ {
 	return model
 }
 
-func (pj *PJ) ToDomain() *campaign.PJ {
+func (pj *PJ) ToDomain(ruleset campaign.Ruleset) *campaign.PJ {
 	// Reconstruct Physical
 	physical := campaign.CreatePhysicalWithoutValidation(
 		pj.Strength,
@@ -243,7 +271,18 @@ func (pj *PJ) ToDomain() *campaign.PJ {
 	if pj.SupernaturalStats != nil {
 		skills := make([]campaign.Skill, len(pj.SupernaturalStats.Skills))
 		for i, skillJSON := range pj.SupernaturalStats.Skills {
-			skills[i] = campaign.CreateSkillWithoutValidation(skillJSON.Transformations)
+			transformations := make([]campaign.Transformation, len(skillJSON.Transformations))
+			for j, t := range skillJSON.Transformations {
+				transformations[j] = campaign.CreateTransformationWithoutValidation(t.ID, t.Name, t.Description, t.Level, t.UnlockCost)
+			}
+
+			skills[i] = campaign.CreateSkillWithoutValidation(
+				skillJSON.ID,
+				skillJSON.Name,
+				skillJSON.Description,
+				skillJSON.UnlockCost,
+				transformations,
+			)
 		}
 		supernaturalStats = campaign.CreateSupernaturalStatsWithoutValidation(skills)
 	}
@@ -271,5 +310,6 @@ func (pj *PJ) ToDomain() *campaign.PJ {
 		specialStats,
 		supernaturalStats,
 		xp,
+		ruleset,
 	)
 }
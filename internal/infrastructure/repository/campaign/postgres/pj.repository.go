@@ -7,6 +7,7 @@ import (
 	"meye-core/internal/infrastructure/repository/shared"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ campaign.PjRepository = (*PjRepository)(nil)
@@ -20,22 +21,59 @@ func NewPjRepository(db *gorm.DB) *PjRepository {
 }
 
 func (r *PjRepository) Save(ctx context.Context, pj *campaign.PJ) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		model := GetModelFromDomainPJ(pj)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return savePJ(tx, pj)
+	})
+}
+
+func (r *PjRepository) FindByID(ctx context.Context, id string) (*campaign.PJ, error) {
+	return findPJ(r.db.WithContext(ctx), id, false)
+}
+
+func (r *PjRepository) Update(ctx context.Context, id string, update func(pj *campaign.PJ) error) (*campaign.PJ, error) {
+	var pj *campaign.PJ
 
-		if err := tx.WithContext(ctx).Save(model).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		pj, err = findPJ(tx, id, true)
+		if err != nil || pj == nil {
 			return err
 		}
 
-		events := getPjUncommittedEvents(pj)
+		if err = update(pj); err != nil {
+			return err
+		}
 
-		return tx.Create(&events).Error
+		return savePJ(tx, pj)
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pj, nil
 }
 
-func (r *PjRepository) FindByID(ctx context.Context, id string) (*campaign.PJ, error) {
+func savePJ(tx *gorm.DB, pj *campaign.PJ) error {
+	model := GetModelFromDomainPJ(pj)
+
+	if err := tx.Save(model).Error; err != nil {
+		return err
+	}
+
+	events := getPjUncommittedEvents(pj)
+
+	return tx.Create(&events).Error
+}
+
+func findPJ(db *gorm.DB, id string, forUpdate bool) (*campaign.PJ, error) {
+	query := db.Where("id = ?", id)
+	if forUpdate {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
 	var pjModel PJ
-	result := r.db.Where("id = ?", id).First(&pjModel)
+	result := query.First(&pjModel)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -43,7 +81,14 @@ func (r *PjRepository) FindByID(ctx context.Context, id string) (*campaign.PJ, e
 		return nil, result.Error
 	}
 
-	return pjModel.ToDomain(), nil
+	// The PJ XP costs depend on its campaign ruleset
+	var campaignModel Campaign
+	result = db.Select("ruleset").Where("id = ?", pjModel.CampaignID).First(&campaignModel)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return pjModel.ToDomain(campaignModel.Ruleset.ToDomain()), nil
 }
 
 func getPjUncommittedEvents(pj *campaign.PJ) []shared.DomainEvent {
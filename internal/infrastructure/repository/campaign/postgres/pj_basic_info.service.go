@@ -3,6 +3,9 @@ package postgres
 import (
 	"context"
 	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/domain/event"
+	"meye-core/internal/infrastructure/repository/shared"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -38,3 +41,49 @@ func (qs *PjQueryService) GetPjsBasicInfo(ctx context.Context, userID string) ([
 
 	return result, nil
 }
+
+func (qs *PjQueryService) GetCampaignPjsBasicInfo(ctx context.Context, campaignID string) ([]*domaincampaign.PjBasicInfo, error) {
+	var pjs []*PJ
+
+	err := qs.db.WithContext(ctx).
+		Select("id", "name").
+		Where("campaign_id = ?", campaignID).
+		Find(&pjs).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domaincampaign.PjBasicInfo, 0, len(pjs))
+	for i := range pjs {
+		result = append(result, domaincampaign.CreatePjBasicInfo(pjs[i].ID, pjs[i].Name))
+	}
+
+	return result, nil
+}
+
+func (qs *PjQueryService) ListStatsUpgrades(ctx context.Context, pjIDs []string, from, to time.Time) ([]*domaincampaign.StatsUpgrade, error) {
+	if len(pjIDs) == 0 {
+		return []*domaincampaign.StatsUpgrade{}, nil
+	}
+
+	var events []shared.DomainEvent
+
+	err := qs.db.WithContext(ctx).
+		Where("type = ?", string(event.EventTypeStatsUpdated)).
+		Where("aggregate_type = ? AND aggregate_id IN ?", string(event.AggregateTypePJ), pjIDs).
+		Where("occurred_at > ? AND occurred_at <= ?", from, to).
+		Order("occurred_at, id").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domaincampaign.StatsUpgrade, 0, len(events))
+	for i := range events {
+		result = append(result, statsUpgradeFromEvent(events[i]))
+	}
+
+	return result, nil
+}
@@ -4,45 +4,80 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"meye-core/internal/domain/session"
+	"meye-core/internal/infrastructure/repository/shared"
 	"time"
 )
 
-type XPAssignationJSON struct {
-	PjID    string        `json:"pj_id"`
-	Amounts XPAmountsJSON `json:"amounts"`
-	Reason  string        `json:"reason"`
-}
-
 type XPAmountsJSON struct {
 	Basic        uint `json:"basic"`
 	Special      uint `json:"special"`
 	Supernatural uint `json:"supernatural"`
 }
 
-type XPAssignationsJSON []XPAssignationJSON
+type AttendeesJSON []string
+
+func (a AttendeesJSON) Value() (driver.Value, error) {
+	if a == nil {
+		a = AttendeesJSON{}
+	}
+	return json.Marshal(a)
+}
+
+func (a *AttendeesJSON) Scan(value interface{}) error {
+	if value == nil {
+		*a = AttendeesJSON{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, a)
+}
+
+type RSVPJSON struct {
+	UserID    string    `json:"user_id"`
+	Response  string    `json:"response"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RSVPsJSON []RSVPJSON
 
-func (x XPAssignationsJSON) Value() (driver.Value, error) {
-	return json.Marshal(x)
+func (r RSVPsJSON) Value() (driver.Value, error) {
+	if r == nil {
+		r = RSVPsJSON{}
+	}
+	return json.Marshal(r)
 }
 
-func (x *XPAssignationsJSON) Scan(value interface{}) error {
+func (r *RSVPsJSON) Scan(value interface{}) error {
 	if value == nil {
-		*x = XPAssignationsJSON{}
+		*r = RSVPsJSON{}
 		return nil
 	}
 	bytes, ok := value.([]byte)
 	if !ok {
 		return nil
 	}
-	return json.Unmarshal(bytes, x)
+	return json.Unmarshal(bytes, r)
 }
 
 type Session struct {
-	ID             string             `gorm:"primaryKey"`
-	CampaignID     string             `gorm:"column:campaign_id"`
-	Summary        string             `gorm:"column:summary"`
-	XPAssignations XPAssignationsJSON `gorm:"type:jsonb;column:xp_assignations"`
-	CreatedAt      time.Time          `gorm:"column:created_at"`
+	ID                       string                        `gorm:"primaryKey"`
+	CampaignID               string                        `gorm:"column:campaign_id"`
+	Summary                  string                        `gorm:"column:summary"`
+	Status                   string                        `gorm:"column:status"`
+	Attendees                AttendeesJSON                 `gorm:"type:jsonb;column:attendees"`
+	AttendanceXPBasic        uint                          `gorm:"column:attendance_xp_basic"`
+	AttendanceXPSpecial      uint                          `gorm:"column:attendance_xp_special"`
+	AttendanceXPSupernatural uint                          `gorm:"column:attendance_xp_supernatural"`
+	XPAssignations           []shared.SessionXPAssignation `gorm:"-"` // stored in their own table
+	StartsAt                 *time.Time                    `gorm:"column:starts_at"`
+	DurationMinutes          *int                          `gorm:"column:duration_minutes"`
+	Location                 *string                       `gorm:"column:location"`
+	ScheduleSequence         int                           `gorm:"column:schedule_sequence"`
+	RSVPs                    RSVPsJSON                     `gorm:"type:jsonb;column:rsvps"`
+	CreatedAt                time.Time                     `gorm:"column:created_at"`
 }
 
 func (s *Session) TableName() string {
@@ -50,48 +85,88 @@ func (s *Session) TableName() string {
 }
 
 func GetModelFromDomainSession(s *session.Session) *Session {
-	xpAssignations := s.XPAssignations()
-	assignationsJSON := make(XPAssignationsJSON, len(xpAssignations))
-
-	for i, assignation := range xpAssignations {
-		assignationsJSON[i] = XPAssignationJSON{
-			PjID: assignation.PjID(),
-			Amounts: XPAmountsJSON{
-				Basic:        assignation.Amounts().Basic(),
-				Special:      assignation.Amounts().Special(),
-				Supernatural: assignation.Amounts().SuperNatural(),
-			},
-			Reason: assignation.Reason(),
+	rsvps := s.RSVPs()
+	rsvpsJSON := make(RSVPsJSON, len(rsvps))
+
+	for i, rsvp := range rsvps {
+		rsvpsJSON[i] = RSVPJSON{
+			UserID:    rsvp.UserID(),
+			Response:  string(rsvp.Response()),
+			UpdatedAt: rsvp.UpdatedAt(),
 		}
 	}
 
-	return &Session{
-		ID:             s.ID(),
-		CampaignID:     s.CampaignID(),
-		Summary:        s.Summary(),
-		XPAssignations: assignationsJSON,
-		CreatedAt:      s.CreatedAt(),
+	model := &Session{
+		ID:                       s.ID(),
+		CampaignID:               s.CampaignID(),
+		Summary:                  s.Summary(),
+		Status:                   string(s.Status()),
+		Attendees:                s.Attendees(),
+		AttendanceXPBasic:        s.AttendanceXP().Basic(),
+		AttendanceXPSpecial:      s.AttendanceXP().Special(),
+		AttendanceXPSupernatural: s.AttendanceXP().SuperNatural(),
+		XPAssignations:           shared.GetSessionXPAssignationModels(s),
+		RSVPs:                    rsvpsJSON,
+		CreatedAt:                s.CreatedAt(),
 	}
+
+	if schedule := s.Schedule(); schedule != nil {
+		startsAt := schedule.StartsAt()
+		durationMinutes := int(schedule.Duration().Minutes())
+		location := schedule.Location()
+
+		model.StartsAt = &startsAt
+		model.DurationMinutes = &durationMinutes
+		model.Location = &location
+		model.ScheduleSequence = int(schedule.Sequence())
+	}
+
+	return model
 }
 
 func (s *Session) ToDomain() *session.Session {
 	xpAssignations := make([]session.XPAssignation, len(s.XPAssignations))
 
-	for i, assignationJSON := range s.XPAssignations {
-		xpAssignations[i] = session.NewXPAssignation(
-			assignationJSON.PjID,
-			assignationJSON.Amounts.Basic,
-			assignationJSON.Amounts.Special,
-			assignationJSON.Amounts.Supernatural,
-			assignationJSON.Reason,
-		)
+	for i, assignation := range s.XPAssignations {
+		xpAssignations[i] = assignation.ToDomain()
+	}
+
+	rsvps := make([]session.RSVP, len(s.RSVPs))
+
+	for i, rsvpJSON := range s.RSVPs {
+		rsvps[i] = session.CreateRSVPWithoutValidation(rsvpJSON.UserID, session.RSVPResponse(rsvpJSON.Response), rsvpJSON.UpdatedAt)
 	}
 
 	return session.CreateSessionWithoutValidation(
 		s.ID,
 		s.CampaignID,
 		s.Summary,
+		session.Status(s.Status),
 		s.CreatedAt,
+		s.Attendees,
+		session.NewXPAmounts(s.AttendanceXPBasic, s.AttendanceXPSpecial, s.AttendanceXPSupernatural),
 		xpAssignations,
+		s.scheduleToDomain(),
+		rsvps,
 	)
 }
+
+func (s *Session) scheduleToDomain() *session.Schedule {
+	if s.StartsAt == nil {
+		return nil
+	}
+
+	var duration time.Duration
+	if s.DurationMinutes != nil {
+		duration = time.Duration(*s.DurationMinutes) * time.Minute
+	}
+
+	var location string
+	if s.Location != nil {
+		location = *s.Location
+	}
+
+	schedule := session.CreateScheduleWithoutValidation(*s.StartsAt, duration, location, uint(s.ScheduleSequence))
+
+	return &schedule
+}
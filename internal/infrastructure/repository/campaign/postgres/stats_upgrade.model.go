@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	domaincampaign "meye-core/internal/domain/campaign"
+	"meye-core/internal/infrastructure/repository/shared"
+	"sort"
+)
+
+// statsUpgradeFromEvent reads a stats_updated event, keeping only the stats whose level changed.
+func statsUpgradeFromEvent(e shared.DomainEvent) *domaincampaign.StatsUpgrade {
+	spentXP := domaincampaign.CreateXPWithoutValidation(
+		eventUint(e.Data["basic_spent_xp"]),
+		eventUint(e.Data["special_spent_xp"]),
+		eventUint(e.Data["supernatural_spent_xp"]),
+	)
+
+	var changes []domaincampaign.StatChange
+	changes = appendStatChanges(changes, flattenStats(e.Data["previous_basic_stats"]), flattenStats(e.Data["new_basic_stats"]))
+	changes = appendStatChanges(changes, flattenStats(e.Data["previous_special_stats"]), flattenStats(e.Data["new_special_stats"]))
+	changes = appendStatChanges(changes, flattenTransformations(e.Data["previous_supernatural_stats"]), flattenTransformations(e.Data["new_supernatural_stats"]))
+
+	return domaincampaign.CreateStatsUpgrade(e.AggregateID, spentXP, changes, e.OccurredAt)
+}
+
+func appendStatChanges(changes []domaincampaign.StatChange, previous, current map[string]uint) []domaincampaign.StatChange {
+	stats := make([]string, 0, len(current))
+	for stat := range current {
+		stats = append(stats, stat)
+	}
+	sort.Strings(stats)
+
+	for _, stat := range stats {
+		if previous[stat] != current[stat] {
+			changes = append(changes, domaincampaign.CreateStatChange(stat, previous[stat], current[stat]))
+		}
+	}
+
+	return changes
+}
+
+// flattenStats maps the numeric stats of a serialized stats group by their dotted path, talent
+// flags are left out.
+func flattenStats(data interface{}) map[string]uint {
+	stats := make(map[string]uint)
+
+	var walk func(prefix string, value interface{})
+	walk = func(prefix string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				if prefix != "" {
+					key = prefix + "." + key
+				}
+				walk(key, child)
+			}
+		case float64:
+			stats[prefix] = uint(v)
+		}
+	}
+	walk("", data)
+
+	return stats
+}
+
+// flattenTransformations maps the transformation levels of serialized supernatural stats by
+// "skill.transformation" names.
+func flattenTransformations(data interface{}) map[string]uint {
+	levels := make(map[string]uint)
+
+	supernatural, _ := data.(map[string]interface{})
+	skills, _ := supernatural["skills"].([]interface{})
+	for _, s := range skills {
+		skill, _ := s.(map[string]interface{})
+		transformations, _ := skill["transformations"].([]interface{})
+		for _, t := range transformations {
+			transformation, _ := t.(map[string]interface{})
+			name := eventString(skill["name"]) + "." + eventString(transformation["name"])
+			levels[name] = eventUint(transformation["level"])
+		}
+	}
+
+	return levels
+}
+
+func eventUint(value interface{}) uint {
+	number, _ := value.(float64)
+	return uint(number)
+}
+
+func eventString(value interface{}) string {
+	str, _ := value.(string)
+	return str
+}
@@ -0,0 +1,113 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"meye-core/internal/domain/campaign"
+	"os"
+	"path/filepath"
+	"sort"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var _ campaign.RulesetRepository = (*Repository)(nil)
+
+type rulesetFile struct {
+	Name                          string `yaml:"name"`
+	BasicLevelStep                uint   `yaml:"basic_level_step"`
+	BasicFirstLevelCost           uint   `yaml:"basic_first_level_cost"`
+	BasicTalentedFirstLevelCost   uint   `yaml:"basic_talented_first_level_cost"`
+	LifeCost                      uint   `yaml:"life_cost"`
+	SpecialLevelStep              uint   `yaml:"special_level_step"`
+	SpecialFirstLevelCost         uint   `yaml:"special_first_level_cost"`
+	SpecialTalentedFirstLevelCost uint   `yaml:"special_talented_first_level_cost"`
+	EnergyTankCost                uint   `yaml:"energy_tank_cost"`
+	EnergyTankTalentedCost        uint   `yaml:"energy_tank_talented_cost"`
+	SupernaturalLevelStep         uint   `yaml:"supernatural_level_step"`
+	SupernaturalFirstLevelCost    uint   `yaml:"supernatural_first_level_cost"`
+}
+
+func (f rulesetFile) toDomain() (campaign.Ruleset, error) {
+	return campaign.NewRuleset(campaign.RulesetParameters{
+		Name:                          f.Name,
+		BasicLevelStep:                f.BasicLevelStep,
+		BasicFirstLevelCost:           f.BasicFirstLevelCost,
+		BasicTalentedFirstLevelCost:   f.BasicTalentedFirstLevelCost,
+		LifeCost:                      f.LifeCost,
+		SpecialLevelStep:              f.SpecialLevelStep,
+		SpecialFirstLevelCost:         f.SpecialFirstLevelCost,
+		SpecialTalentedFirstLevelCost: f.SpecialTalentedFirstLevelCost,
+		EnergyTankCost:                f.EnergyTankCost,
+		EnergyTankTalentedCost:        f.EnergyTankTalentedCost,
+		SupernaturalLevelStep:         f.SupernaturalLevelStep,
+		SupernaturalFirstLevelCost:    f.SupernaturalFirstLevelCost,
+	})
+}
+
+// Repository serves the default ruleset plus the ones defined in the YAML files of a directory.
+// Files are read once on creation.
+type Repository struct {
+	rulesets map[string]campaign.Ruleset
+}
+
+func New(dir string) (*Repository, error) {
+	defaultRuleset := campaign.DefaultRuleset()
+	r := &Repository{
+		rulesets: map[string]campaign.Ruleset{defaultRuleset.Name(): defaultRuleset},
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		ruleset, err := readRuleset(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load ruleset %s: %w", path, err)
+		}
+
+		if _, ok := r.rulesets[ruleset.Name()]; ok {
+			return nil, fmt.Errorf("failed to load ruleset %s: duplicated name %q", path, ruleset.Name())
+		}
+
+		r.rulesets[ruleset.Name()] = ruleset
+	}
+
+	return r, nil
+}
+
+func readRuleset(path string) (campaign.Ruleset, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return campaign.Ruleset{}, err
+	}
+
+	var file rulesetFile
+	if err := yamlv3.Unmarshal(content, &file); err != nil {
+		return campaign.Ruleset{}, err
+	}
+
+	return file.toDomain()
+}
+
+func (r *Repository) FindByName(_ context.Context, name string) (*campaign.Ruleset, error) {
+	ruleset, ok := r.rulesets[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return &ruleset, nil
+}
+
+func (r *Repository) FindAll(_ context.Context) ([]campaign.Ruleset, error) {
+	rulesets := make([]campaign.Ruleset, 0, len(r.rulesets))
+	for _, ruleset := range r.rulesets {
+		rulesets = append(rulesets, ruleset)
+	}
+
+	sort.Slice(rulesets, func(i, j int) bool { return rulesets[i].Name() < rulesets[j].Name() })
+
+	return rulesets, nil
+}
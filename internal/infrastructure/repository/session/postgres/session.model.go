@@ -5,82 +5,149 @@ import (
 	"encoding/json"
 	"errors"
 	"meye-core/internal/domain/session"
+	"meye-core/internal/infrastructure/repository/shared"
 	"time"
 )
 
 type Session struct {
-	ID             string
-	CampaignID     string
-	Summary        string
-	XPAssignations XPAssignations
-	CreatedAt      time.Time
+	ID                       string
+	CampaignID               string
+	Summary                  string
+	Status                   string
+	Attendees                Attendees
+	AttendanceXPBasic        uint
+	AttendanceXPSpecial      uint
+	AttendanceXPSupernatural uint
+	XPAssignations           []shared.SessionXPAssignation `gorm:"-"` // stored in their own table
+	StartsAt                 *time.Time
+	DurationMinutes          *int
+	Location                 *string
+	ScheduleSequence         int
+	RSVPs                    RSVPs `gorm:"column:rsvps"`
+	CreatedAt                time.Time
 }
 
-// Custom type para JSONB
-type XPAssignations []XPAssignation
+type RSVP struct {
+	UserID    string    `json:"user_id"`
+	Response  string    `json:"response"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RSVPs []RSVP
 
-type XPAmounts struct {
-	Basic        uint `json:"basic"`
-	Special      uint `json:"special"`
-	SuperNatural uint `json:"supernatural"`
+func (r RSVPs) Value() (driver.Value, error) {
+	if r == nil {
+		r = RSVPs{}
+	}
+	return json.Marshal(r)
 }
 
-type XPAssignation struct {
-	PjID    string    `json:"pj_id"`
-	Amounts XPAmounts `json:"amounts"`
-	Reason  string    `json:"reason"`
+func (r *RSVPs) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan RSVPs")
+	}
+	return json.Unmarshal(bytes, r)
 }
 
-// Implementar driver.Valuer para escribir a JSONB
-func (x XPAssignations) Value() (driver.Value, error) {
-	return json.Marshal(x)
+type Attendees []string
+
+func (a Attendees) Value() (driver.Value, error) {
+	if a == nil {
+		a = Attendees{}
+	}
+	return json.Marshal(a)
 }
 
-// Implementar sql.Scanner para leer desde JSONB
-func (x *XPAssignations) Scan(value interface{}) error {
+func (a *Attendees) Scan(value interface{}) error {
 	bytes, ok := value.([]byte)
 	if !ok {
-		return errors.New("failed to scan XPAssignations")
+		return errors.New("failed to scan Attendees")
 	}
-	return json.Unmarshal(bytes, x)
+	return json.Unmarshal(bytes, a)
 }
 
 // Conversión a dominio
 func (s *Session) ToDomain() *session.Session {
 	assignations := make([]session.XPAssignation, len(s.XPAssignations))
 	for i, a := range s.XPAssignations {
-		assignations[i] = session.NewXPAssignation(a.PjID, a.Amounts.Basic, a.Amounts.Special, a.Amounts.SuperNatural, a.Reason)
+		assignations[i] = a.ToDomain()
+	}
+
+	rsvps := make([]session.RSVP, len(s.RSVPs))
+	for i, r := range s.RSVPs {
+		rsvps[i] = session.CreateRSVPWithoutValidation(r.UserID, session.RSVPResponse(r.Response), r.UpdatedAt)
 	}
 
 	return session.CreateSessionWithoutValidation(
 		s.ID,
 		s.CampaignID,
 		s.Summary,
+		session.Status(s.Status),
 		s.CreatedAt,
+		s.Attendees,
+		session.NewXPAmounts(s.AttendanceXPBasic, s.AttendanceXPSpecial, s.AttendanceXPSupernatural),
 		assignations,
+		s.scheduleToDomain(),
+		rsvps,
 	)
 }
 
+func (s *Session) scheduleToDomain() *session.Schedule {
+	if s.StartsAt == nil {
+		return nil
+	}
+
+	var duration time.Duration
+	if s.DurationMinutes != nil {
+		duration = time.Duration(*s.DurationMinutes) * time.Minute
+	}
+
+	var location string
+	if s.Location != nil {
+		location = *s.Location
+	}
+
+	schedule := session.CreateScheduleWithoutValidation(*s.StartsAt, duration, location, uint(s.ScheduleSequence))
+
+	return &schedule
+}
+
 // Conversión desde dominio
 func GetModelFromDomainSession(s *session.Session) *Session {
-	assignations := make(XPAssignations, len(s.XPAssignations()))
-	for i, a := range s.XPAssignations() {
-		assignations[i] = XPAssignation{
-			PjID: a.PjID(),
-			Amounts: XPAmounts{
-				Basic:        a.Basic(),
-				Special:      a.Special(),
-				SuperNatural: a.SuperNatural(),
-			},
-			Reason: a.Reason(),
+	rsvps := make(RSVPs, len(s.RSVPs()))
+	for i, r := range s.RSVPs() {
+		rsvps[i] = RSVP{
+			UserID:    r.UserID(),
+			Response:  string(r.Response()),
+			UpdatedAt: r.UpdatedAt(),
 		}
 	}
 
-	return &Session{
-		ID:             s.ID(),
-		CampaignID:     s.CampaignID(),
-		Summary:        s.Summary(),
-		XPAssignations: assignations,
-		CreatedAt:      s.CreatedAt(),
+	model := &Session{
+		ID:                       s.ID(),
+		CampaignID:               s.CampaignID(),
+		Summary:                  s.Summary(),
+		Status:                   string(s.Status()),
+		Attendees:                s.Attendees(),
+		AttendanceXPBasic:        s.AttendanceXP().Basic(),
+		AttendanceXPSpecial:      s.AttendanceXP().Special(),
+		AttendanceXPSupernatural: s.AttendanceXP().SuperNatural(),
+		XPAssignations:           shared.GetSessionXPAssignationModels(s),
+		RSVPs:                    rsvps,
+		CreatedAt:                s.CreatedAt(),
 	}
+
+	if schedule := s.Schedule(); schedule != nil {
+		startsAt := schedule.StartsAt()
+		durationMinutes := int(schedule.Duration().Minutes())
+		location := schedule.Location()
+
+		model.StartsAt = &startsAt
+		model.DurationMinutes = &durationMinutes
+		model.Location = &location
+		model.ScheduleSequence = int(schedule.Sequence())
+	}
+
+	return model
 }
@@ -3,8 +3,10 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"meye-core/internal/domain/session"
 	"meye-core/internal/infrastructure/repository/shared"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -23,16 +25,106 @@ func (r *Repository) Save(ctx context.Context, s *session.Session) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		sessionModel := GetModelFromDomainSession(s)
 
-		if err := tx.Create(sessionModel).Error; err != nil {
+		result := tx.Clauses(shared.UpsertSession()).Create(sessionModel)
+
+		if err := result.Error; err != nil {
+			return err
+		}
+
+		if err := shared.ReplaceSessionXPAssignations(tx, s); err != nil {
 			return err
 		}
 
 		events := getUncommittedEvents(s)
+		if len(events) == 0 {
+			return nil
+		}
 
 		return tx.Create(&events).Error
 	})
 }
 
+func (r *Repository) FindByID(ctx context.Context, id string) (*session.Session, error) {
+	var sessionModel Session
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&sessionModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	sessionModels := []Session{sessionModel}
+	if err := loadXPAssignations(r.db.WithContext(ctx), sessionModels); err != nil {
+		return nil, err
+	}
+
+	return sessionModels[0].ToDomain(), nil
+}
+
+func (r *Repository) FindByCampaignID(ctx context.Context, campaignID string) ([]*session.Session, error) {
+	var sessionModels []Session
+	result := r.db.WithContext(ctx).
+		Where("campaign_id = ?", campaignID).
+		Order("created_at DESC, id DESC").
+		Find(&sessionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if err := loadXPAssignations(r.db.WithContext(ctx), sessionModels); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*session.Session, 0, len(sessionModels))
+	for i := range sessionModels {
+		sessions = append(sessions, sessionModels[i].ToDomain())
+	}
+
+	return sessions, nil
+}
+
+func (r *Repository) FindPlannedStartingBefore(ctx context.Context, t time.Time) ([]*session.Session, error) {
+	var sessionModels []Session
+	result := r.db.WithContext(ctx).
+		Where("status = ? AND starts_at <= ?", string(session.StatusPlanned), t).
+		Order("starts_at ASC").
+		Find(&sessionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if err := loadXPAssignations(r.db.WithContext(ctx), sessionModels); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*session.Session, 0, len(sessionModels))
+	for i := range sessionModels {
+		sessions = append(sessions, sessionModels[i].ToDomain())
+	}
+
+	return sessions, nil
+}
+
+// loadXPAssignations fills the XP lines of the sessions, which live in their own table.
+func loadXPAssignations(db *gorm.DB, sessionModels []Session) error {
+	sessionIDs := make([]string, 0, len(sessionModels))
+	for i := range sessionModels {
+		sessionIDs = append(sessionIDs, sessionModels[i].ID)
+	}
+
+	bySession, err := shared.FindSessionXPAssignations(db, sessionIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range sessionModels {
+		sessionModels[i].XPAssignations = bySession[sessionModels[i].ID]
+	}
+
+	return nil
+}
+
 func getUncommittedEvents(s *session.Session) []shared.DomainEvent {
 	events := s.UncommittedEvents()
 	domainEvents := make([]shared.DomainEvent, 0, len(events))
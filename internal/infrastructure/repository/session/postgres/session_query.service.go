@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"meye-core/internal/domain/session"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var _ session.QueryService = (*QueryService)(nil)
+
+type QueryService struct {
+	db *gorm.DB
+}
+
+func NewQueryService(db *gorm.DB) *QueryService {
+	return &QueryService{db: db}
+}
+
+// ListCampaignSessions pages the sessions with a keyset on (created_at, id), so pages stay
+// stable when new sessions are recorded while paging.
+func (qs *QueryService) ListCampaignSessions(ctx context.Context, query session.ListQuery) (session.Page, error) {
+	db := qs.db.WithContext(ctx).Where("campaign_id = ?", query.CampaignID)
+
+	if !query.IncludeDrafts {
+		db = db.Where("status <> ?", string(session.StatusDraft))
+	}
+
+	if query.From != nil {
+		db = db.Where("created_at >= ?", *query.From)
+	}
+
+	if query.To != nil {
+		db = db.Where("created_at < ?", *query.To)
+	}
+
+	if query.Cursor != "" {
+		createdAt, id, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return session.Page{}, err
+		}
+
+		db = db.Where("(created_at, id) < (?, ?)", createdAt, id)
+	}
+
+	// One extra row tells whether there is a next page
+	var sessionModels []Session
+	err := db.Order("created_at DESC, id DESC").
+		Limit(query.Limit + 1).
+		Find(&sessionModels).Error
+	if err != nil {
+		return session.Page{}, err
+	}
+
+	var nextCursor string
+	if len(sessionModels) > query.Limit {
+		sessionModels = sessionModels[:query.Limit]
+		last := sessionModels[len(sessionModels)-1]
+		nextCursor = encodeCursor(last.CreatedAt, last.ID)
+	}
+
+	if err := loadXPAssignations(qs.db.WithContext(ctx), sessionModels); err != nil {
+		return session.Page{}, err
+	}
+
+	sessions := make([]*session.Session, 0, len(sessionModels))
+	for i := range sessionModels {
+		sessions = append(sessions, sessionModels[i].ToDomain())
+	}
+
+	return session.Page{
+		Sessions:   sessions,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+type scheduledSessionRow struct {
+	Session
+	CampaignName string
+}
+
+func (qs *QueryService) ListUserScheduledSessions(ctx context.Context, userID string) ([]session.ScheduledSession, error) {
+	var rows []scheduledSessionRow
+	err := qs.db.WithContext(ctx).
+		Table("sessions").
+		Select("sessions.*, campaigns.name AS campaign_name").
+		Joins("JOIN campaigns ON campaigns.id = sessions.campaign_id").
+		Where("sessions.starts_at IS NOT NULL").
+		Where(
+			"campaigns.master_id = ? OR EXISTS (SELECT 1 FROM pjs WHERE pjs.campaign_id = sessions.campaign_id AND pjs.user_id = ?)",
+			userID, userID,
+		).
+		Order("sessions.starts_at, sessions.id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sessionModels := make([]Session, len(rows))
+	for i := range rows {
+		sessionModels[i] = rows[i].Session
+	}
+
+	if err := loadXPAssignations(qs.db.WithContext(ctx), sessionModels); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]session.ScheduledSession, 0, len(rows))
+	for i := range rows {
+		sessions = append(sessions, session.ScheduledSession{
+			Session:      sessionModels[i].ToDomain(),
+			CampaignName: rows[i].CampaignName,
+		})
+	}
+
+	return sessions, nil
+}
+
+func encodeCursor(createdAt time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.Format(time.RFC3339Nano) + "|" + id))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", session.ErrInvalidCursor
+	}
+
+	createdAtStr, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return time.Time{}, "", session.ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return time.Time{}, "", session.ErrInvalidCursor
+	}
+
+	return createdAt, id, nil
+}
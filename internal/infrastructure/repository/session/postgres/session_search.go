@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"meye-core/internal/domain/session"
+	"strings"
+	"time"
+)
+
+// ts_headline doesn't escape the text around the matches, so they are delimited with control
+// characters and turned into <mark> once the snippet is escaped.
+const (
+	highlightStart  = "\x02"
+	highlightStop   = "\x03"
+	headlineOptions = "StartSel=\x02, StopSel=\x03, MaxWords=25, MinWords=8, MaxFragments=2"
+)
+
+type searchRow struct {
+	ID             string
+	Status         string
+	CreatedAt      time.Time
+	Rank           float64
+	SummarySnippet string
+	ReasonMatches  []byte
+}
+
+type reasonMatchRow struct {
+	PjID    string `json:"pj_id"`
+	Snippet string `json:"snippet"`
+}
+
+// Search matches the summaries and the XP reasons through their GIN indexed tsvectors, with the
+// same 'simple' text search configuration. Reasons are matched line by line, so players only find
+// the reasons given to their PJs.
+func (qs *QueryService) Search(ctx context.Context, query session.SearchQuery) ([]session.SearchResult, error) {
+	args := map[string]interface{}{
+		"text":       query.Text,
+		"options":    headlineOptions,
+		"campaignID": query.CampaignID,
+		"limit":      query.Limit,
+	}
+
+	reasonsFilter := ""
+	if query.PjIDs != nil {
+		reasonsFilter = "AND a.pj_id IN @pjIDs"
+		args["pjIDs"] = query.PjIDs
+	}
+
+	draftsFilter := ""
+	if !query.IncludeDrafts {
+		draftsFilter = "AND s.status <> @draft"
+		args["draft"] = string(session.StatusDraft)
+	}
+
+	sql := `
+SELECT
+	s.id,
+	s.status,
+	s.created_at,
+	ts_rank(s.summary_tsv, q.query) + COALESCE(r.rank, 0) AS rank,
+	CASE WHEN s.summary_tsv @@ q.query THEN ts_headline('simple', s.summary, q.query, @options) ELSE '' END AS summary_snippet,
+	COALESCE(r.matches, '[]') AS reason_matches
+FROM sessions s
+CROSS JOIN websearch_to_tsquery('simple', @text) AS q(query)
+LEFT JOIN LATERAL (
+	SELECT
+		SUM(ts_rank(a.reason_tsv, q.query)) AS rank,
+		jsonb_agg(jsonb_build_object(
+			'pj_id', a.pj_id,
+			'snippet', ts_headline('simple', a.reason, q.query, @options)
+		) ORDER BY a.position) AS matches
+	FROM session_xp_assignations a
+	WHERE a.session_id = s.id
+		AND a.reason_tsv @@ q.query
+		` + reasonsFilter + `
+) r ON TRUE
+WHERE s.campaign_id = @campaignID
+	` + draftsFilter + `
+	AND (s.summary_tsv @@ q.query OR r.matches IS NOT NULL)
+ORDER BY rank DESC, s.created_at DESC, s.id DESC
+LIMIT @limit`
+
+	var rows []searchRow
+	if err := qs.db.WithContext(ctx).Raw(sql, args).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]session.SearchResult, 0, len(rows))
+	for _, row := range rows {
+		var reasonMatches []reasonMatchRow
+		if err := json.Unmarshal(row.ReasonMatches, &reasonMatches); err != nil {
+			return nil, err
+		}
+
+		matches := make([]session.ReasonMatch, 0, len(reasonMatches))
+		for _, m := range reasonMatches {
+			matches = append(matches, session.ReasonMatch{
+				PjID:    m.PjID,
+				Snippet: highlight(m.Snippet),
+			})
+		}
+
+		results = append(results, session.SearchResult{
+			SessionID:      row.ID,
+			Status:         session.Status(row.Status),
+			CreatedAt:      row.CreatedAt,
+			Rank:           row.Rank,
+			SummarySnippet: highlight(row.SummarySnippet),
+			ReasonMatches:  matches,
+		})
+	}
+
+	return results, nil
+}
+
+var highlighter = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+func highlight(snippet string) string {
+	return highlighter.Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,27 @@
+package shared
+
+import "gorm.io/gorm/clause"
+
+// sessionMutableColumns are the columns of a session that change after it is created, both the
+// session and the campaign repositories save sessions.
+var sessionMutableColumns = []string{
+	"summary",
+	"status",
+	"attendees",
+	"attendance_xp_basic",
+	"attendance_xp_special",
+	"attendance_xp_supernatural",
+	"starts_at",
+	"duration_minutes",
+	"location",
+	"schedule_sequence",
+	"rsvps",
+}
+
+// UpsertSession inserts the session row, or updates its mutable columns when it already exists.
+func UpsertSession() clause.OnConflict {
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns(sessionMutableColumns),
+	}
+}
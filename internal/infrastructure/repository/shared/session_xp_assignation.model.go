@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"meye-core/internal/domain/session"
+
+	"gorm.io/gorm"
+)
+
+// SessionXPAssignation is an XP line of a session, sessions keep their lines in position order.
+type SessionXPAssignation struct {
+	SessionID    string `gorm:"primaryKey"`
+	Position     int    `gorm:"primaryKey"`
+	PjID         string
+	Kind         string
+	Basic        uint
+	Special      uint
+	Supernatural uint
+	Reason       string
+}
+
+func (a SessionXPAssignation) ToDomain() session.XPAssignation {
+	return session.CreateXPAssignationWithoutValidation(a.PjID, session.XPKind(a.Kind), a.Basic, a.Special, a.Supernatural, a.Reason)
+}
+
+func GetSessionXPAssignationModels(s *session.Session) []SessionXPAssignation {
+	xpAssignations := s.XPAssignations()
+	models := make([]SessionXPAssignation, len(xpAssignations))
+	for i, xpA := range xpAssignations {
+		models[i] = SessionXPAssignation{
+			SessionID:    s.ID(),
+			Position:     i,
+			PjID:         xpA.PjID(),
+			Kind:         string(xpA.Kind()),
+			Basic:        xpA.Basic(),
+			Special:      xpA.Special(),
+			Supernatural: xpA.SuperNatural(),
+			Reason:       xpA.Reason(),
+		}
+	}
+
+	return models
+}
+
+// ReplaceSessionXPAssignations rewrites the XP lines of the session.
+func ReplaceSessionXPAssignations(tx *gorm.DB, s *session.Session) error {
+	if err := tx.Where("session_id = ?", s.ID()).Delete(&SessionXPAssignation{}).Error; err != nil {
+		return err
+	}
+
+	models := GetSessionXPAssignationModels(s)
+	if len(models) == 0 {
+		return nil
+	}
+
+	return tx.Create(&models).Error
+}
+
+// FindSessionXPAssignations returns the XP lines of the sessions, by session ID.
+func FindSessionXPAssignations(db *gorm.DB, sessionIDs []string) (map[string][]SessionXPAssignation, error) {
+	bySession := make(map[string][]SessionXPAssignation, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return bySession, nil
+	}
+
+	var models []SessionXPAssignation
+	err := db.Where("session_id IN ?", sessionIDs).
+		Order("session_id, position").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, model := range models {
+		bySession[model.SessionID] = append(bySession[model.SessionID], model)
+	}
+
+	return bySession, nil
+}
@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"meye-core/internal/domain/user"
+	"sync"
+	"time"
+)
+
+var _ user.LoginAttemptStore = (*LoginAttemptStore)(nil)
+
+// LoginAttemptStore counts the failed logins in the memory of the instance,
+// so each instance of a deployment throttles on its own.
+type LoginAttemptStore struct {
+	mu       sync.Mutex
+	attempts map[string]user.LoginAttempts
+	window   time.Duration // longest window seen, entries idle for longer are swept
+	sweptAt  time.Time
+}
+
+func NewLoginAttemptStore() *LoginAttemptStore {
+	return &LoginAttemptStore{
+		attempts: make(map[string]user.LoginAttempts),
+	}
+}
+
+func (s *LoginAttemptStore) Find(_ context.Context, key string) (user.LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.attempts[key], nil
+}
+
+func (s *LoginAttemptStore) RecordFailure(_ context.Context, key string, now time.Time, window time.Duration) (user.LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.window = max(s.window, window)
+	s.sweep(now)
+
+	attempts := s.attempts[key]
+	if now.Sub(attempts.LastFailureAt) >= window {
+		attempts.Failures = 0
+	}
+
+	attempts.Failures++
+	attempts.LastFailureAt = now
+	s.attempts[key] = attempts
+
+	return attempts, nil
+}
+
+func (s *LoginAttemptStore) Lock(_ context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempts := s.attempts[key]
+	attempts.LockedUntil = until
+	s.attempts[key] = attempts
+
+	return nil
+}
+
+func (s *LoginAttemptStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+
+	return nil
+}
+
+// sweep drops the entries that no longer throttle anything, at most once per window,
+// so failures spread over many usernames don't grow the map forever.
+func (s *LoginAttemptStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < s.window {
+		return
+	}
+
+	for key, attempts := range s.attempts {
+		if now.Sub(attempts.LastFailureAt) >= s.window && !attempts.LockedUntil.After(now) {
+			delete(s.attempts, key)
+		}
+	}
+
+	s.sweptAt = now
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"meye-core/internal/domain/user"
+	"time"
+)
+
+type APIKeyScopesJSON []user.APIKeyScope
+
+func (s APIKeyScopesJSON) Value() (driver.Value, error) {
+	if s == nil {
+		s = APIKeyScopesJSON{}
+	}
+	return json.Marshal(s)
+}
+
+func (s *APIKeyScopesJSON) Scan(value interface{}) error {
+	if value == nil {
+		*s = APIKeyScopesJSON{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, s)
+}
+
+type APIKey struct {
+	ID         string `gorm:"primaryKey"`
+	Name       string
+	UserID     string
+	TokenHash  string
+	Prefix     string
+	Scopes     APIKeyScopesJSON `gorm:"type:jsonb"`
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func GetModelFromDomainAPIKey(k *user.APIKey) *APIKey {
+	return &APIKey{
+		ID:         k.ID(),
+		Name:       k.Name(),
+		UserID:     k.UserID(),
+		TokenHash:  k.TokenHash(),
+		Prefix:     k.Prefix(),
+		Scopes:     k.Scopes(),
+		CreatedAt:  k.CreatedAt(),
+		ExpiresAt:  k.ExpiresAt(),
+		LastUsedAt: k.LastUsedAt(),
+		RevokedAt:  k.RevokedAt(),
+	}
+}
+
+func (k *APIKey) ToDomain() *user.APIKey {
+	return user.CreateAPIKeyWithoutValidation(
+		k.ID,
+		k.Name,
+		k.UserID,
+		k.TokenHash,
+		k.Prefix,
+		k.Scopes,
+		k.CreatedAt,
+		k.ExpiresAt,
+		k.LastUsedAt,
+		k.RevokedAt,
+	)
+}
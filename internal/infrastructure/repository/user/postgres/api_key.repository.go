@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"meye-core/internal/domain/user"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ user.APIKeyRepository = (*APIKeyRepository)(nil)
+
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// Save performs an upsert operation into DB by ID, only the usage and revocation of a key change.
+func (r *APIKeyRepository) Save(ctx context.Context, key *user.APIKey) error {
+	model := GetModelFromDomainAPIKey(key)
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_used_at": model.LastUsedAt,
+			"revoked_at":   model.RevokedAt,
+		}),
+	}).Create(model).Error
+}
+
+func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*user.APIKey, error) {
+	return r.findOne(r.db.WithContext(ctx).Where("id = ?", id))
+}
+
+func (r *APIKeyRepository) FindByHash(ctx context.Context, hash string) (*user.APIKey, error) {
+	return r.findOne(r.db.WithContext(ctx).Where("token_hash = ?", hash))
+}
+
+func (r *APIKeyRepository) findOne(query *gorm.DB) (*user.APIKey, error) {
+	var model APIKey
+	result := query.First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]*user.APIKey, error) {
+	var models []APIKey
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*user.APIKey, 0, len(models))
+	for i := range models {
+		keys = append(keys, models[i].ToDomain())
+	}
+
+	return keys, nil
+}
+
+// TouchLastUsed only updates the last use, so it can't undo a concurrent revocation.
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("last_used_at", at).Error
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"meye-core/internal/domain/user"
+
+	"gorm.io/gorm"
+)
+
+var _ user.AuthQueryService = (*AuthQueryService)(nil)
+
+type AuthQueryService struct {
+	db *gorm.DB
+}
+
+func NewAuthQueryService(db *gorm.DB) *AuthQueryService {
+	return &AuthQueryService{db: db}
+}
+
+func (qs *AuthQueryService) FindAuthState(ctx context.Context, userID string) (*user.AuthState, error) {
+	var userModel User
+	result := qs.db.WithContext(ctx).
+		Select("roles", "token_version", "disabled").
+		Where("id = ?", userID).
+		First(&userModel)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return &user.AuthState{
+		Roles:        userModel.Roles,
+		TokenVersion: userModel.TokenVersion,
+		Disabled:     userModel.Disabled,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"meye-core/internal/domain/user"
+	"time"
+)
+
+type LoginAttempt struct {
+	Key           string `gorm:"column:attempt_key;primaryKey"`
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+func (a *LoginAttempt) ToDomain() user.LoginAttempts {
+	attempts := user.LoginAttempts{
+		Failures:      a.Failures,
+		LastFailureAt: a.LastFailureAt,
+	}
+
+	if a.LockedUntil != nil {
+		attempts.LockedUntil = *a.LockedUntil
+	}
+
+	return attempts
+}
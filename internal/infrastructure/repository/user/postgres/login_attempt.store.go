@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"meye-core/internal/domain/user"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ user.LoginAttemptStore = (*LoginAttemptStore)(nil)
+
+// LoginAttemptStore counts the failed logins in the database, shared by every instance.
+type LoginAttemptStore struct {
+	db *gorm.DB
+
+	mu      sync.Mutex
+	sweptAt time.Time
+}
+
+func NewLoginAttemptStore(db *gorm.DB) *LoginAttemptStore {
+	return &LoginAttemptStore{db: db}
+}
+
+func (s *LoginAttemptStore) Find(ctx context.Context, key string) (user.LoginAttempts, error) {
+	var model LoginAttempt
+	result := s.db.WithContext(ctx).Where("attempt_key = ?", key).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return user.LoginAttempts{}, nil
+		}
+
+		return user.LoginAttempts{}, result.Error
+	}
+
+	return model.ToDomain(), nil
+}
+
+// RecordFailure increments the count in a single upsert, so concurrent failures are all counted.
+func (s *LoginAttemptStore) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (user.LoginAttempts, error) {
+	if err := s.sweep(ctx, now, window); err != nil {
+		return user.LoginAttempts{}, err
+	}
+
+	model := LoginAttempt{
+		Key:           key,
+		Failures:      1,
+		LastFailureAt: now,
+	}
+
+	result := s.db.WithContext(ctx).Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{{Name: "attempt_key"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"failures": gorm.Expr(
+					"CASE WHEN login_attempts.last_failure_at <= ? THEN 1 ELSE login_attempts.failures + 1 END",
+					now.Add(-window),
+				),
+				"last_failure_at": now,
+			}),
+		},
+		clause.Returning{},
+	).Create(&model)
+
+	if result.Error != nil {
+		return user.LoginAttempts{}, result.Error
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (s *LoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	return s.db.WithContext(ctx).
+		Model(&LoginAttempt{}).
+		Where("attempt_key = ?", key).
+		Update("locked_until", until).Error
+}
+
+func (s *LoginAttemptStore) Reset(ctx context.Context, key string) error {
+	return s.db.WithContext(ctx).
+		Where("attempt_key = ?", key).
+		Delete(&LoginAttempt{}).Error
+}
+
+// sweep deletes the rows that no longer throttle anything, at most once per window and instance,
+// so failures spread over many usernames don't grow the table forever.
+func (s *LoginAttemptStore) sweep(ctx context.Context, now time.Time, window time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.sweptAt) < window {
+		return nil
+	}
+
+	err := s.db.WithContext(ctx).
+		Where("last_failure_at <= ? AND (locked_until IS NULL OR locked_until <= ?)", now.Add(-window), now).
+		Delete(&LoginAttempt{}).Error
+	if err != nil {
+		return err
+	}
+
+	s.sweptAt = now
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"meye-core/internal/domain/user"
+	"time"
+)
+
+type LoginChallenge struct {
+	ID        string `gorm:"primaryKey"`
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	Failures  int
+}
+
+func GetModelFromDomainLoginChallenge(c *user.LoginChallenge) *LoginChallenge {
+	return &LoginChallenge{
+		ID:        c.ID(),
+		UserID:    c.UserID(),
+		TokenHash: c.TokenHash(),
+		ExpiresAt: c.ExpiresAt(),
+		Failures:  c.Failures(),
+	}
+}
+
+func (c *LoginChallenge) ToDomain() *user.LoginChallenge {
+	return user.CreateLoginChallengeWithoutValidation(
+		c.ID,
+		c.UserID,
+		c.TokenHash,
+		c.ExpiresAt,
+		c.Failures,
+	)
+}
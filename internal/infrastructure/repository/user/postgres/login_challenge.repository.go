@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"meye-core/internal/domain/user"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ user.LoginChallengeRepository = (*LoginChallengeRepository)(nil)
+
+// challengeSweepInterval is how often an instance deletes the expired challenges
+const challengeSweepInterval = time.Minute
+
+type LoginChallengeRepository struct {
+	db *gorm.DB
+
+	mu      sync.Mutex
+	sweptAt time.Time
+}
+
+func NewLoginChallengeRepository(db *gorm.DB) *LoginChallengeRepository {
+	return &LoginChallengeRepository{db: db}
+}
+
+// Save performs an upsert operation into DB by ID.
+func (r *LoginChallengeRepository) Save(ctx context.Context, challenge *user.LoginChallenge) error {
+	if err := r.sweep(ctx, time.Now()); err != nil {
+		return err
+	}
+
+	model := GetModelFromDomainLoginChallenge(challenge)
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"failures": model.Failures,
+		}),
+	}).Create(model).Error
+}
+
+func (r *LoginChallengeRepository) FindByHash(ctx context.Context, hash string) (*user.LoginChallenge, error) {
+	var model LoginChallenge
+	result := r.db.WithContext(ctx).Where("token_hash = ?", hash).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *LoginChallengeRepository) Delete(ctx context.Context, challenge *user.LoginChallenge) error {
+	return r.db.WithContext(ctx).Delete(&LoginChallenge{}, "id = ?", challenge.ID()).Error
+}
+
+// sweep deletes the challenges left unanswered past their expiration, at most once per interval and instance.
+func (r *LoginChallengeRepository) sweep(ctx context.Context, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.sweptAt) < challengeSweepInterval {
+		return nil
+	}
+
+	if err := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&LoginChallenge{}).Error; err != nil {
+		return err
+	}
+
+	r.sweptAt = now
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"meye-core/internal/domain/user"
+	"time"
+)
+
+type RefreshToken struct {
+	ID        string `gorm:"primaryKey"`
+	UserID    string
+	FamilyID  string
+	TokenHash string
+	UserAgent string
+	IPAddress string `gorm:"column:ip_address"`
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+}
+
+func GetModelFromDomainRefreshToken(t *user.RefreshToken) *RefreshToken {
+	return &RefreshToken{
+		ID:        t.ID(),
+		UserID:    t.UserID(),
+		FamilyID:  t.FamilyID(),
+		TokenHash: t.TokenHash(),
+		UserAgent: t.UserAgent(),
+		IPAddress: t.IPAddress(),
+		CreatedAt: t.CreatedAt(),
+		ExpiresAt: t.ExpiresAt(),
+		RotatedAt: t.RotatedAt(),
+		RevokedAt: t.RevokedAt(),
+	}
+}
+
+func (t *RefreshToken) ToDomain() *user.RefreshToken {
+	return user.CreateRefreshTokenWithoutValidation(
+		t.ID,
+		t.UserID,
+		t.FamilyID,
+		t.TokenHash,
+		t.UserAgent,
+		t.IPAddress,
+		t.CreatedAt,
+		t.ExpiresAt,
+		t.RotatedAt,
+		t.RevokedAt,
+	)
+}
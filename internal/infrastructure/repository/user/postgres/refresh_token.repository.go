@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"meye-core/internal/domain/user"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ user.RefreshTokenRepository = (*RefreshTokenRepository)(nil)
+
+type RefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// Save performs an upsert operation into DB by ID.
+func (r *RefreshTokenRepository) Save(ctx context.Context, token *user.RefreshToken) error {
+	return saveRefreshToken(r.db.WithContext(ctx), GetModelFromDomainRefreshToken(token))
+}
+
+// SaveRotation only marks the token as rotated while it is still active,
+// so two concurrent refreshes of the same token can't both succeed.
+func (r *RefreshTokenRepository) SaveRotation(ctx context.Context, rotated, next *user.RefreshToken) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&RefreshToken{}).
+			Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", rotated.ID()).
+			Update("rotated_at", rotated.RotatedAt())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return user.ErrRefreshTokenReused
+		}
+
+		return saveRefreshToken(tx, GetModelFromDomainRefreshToken(next))
+	})
+}
+
+func saveRefreshToken(tx *gorm.DB, model *RefreshToken) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"rotated_at": model.RotatedAt,
+			"revoked_at": model.RevokedAt,
+		}),
+	}).Create(model).Error
+}
+
+func (r *RefreshTokenRepository) FindByHash(ctx context.Context, hash string) (*user.RefreshToken, error) {
+	var model RefreshToken
+	result := r.db.WithContext(ctx).Where("token_hash = ?", hash).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", at).Error
+}
+
+func (r *RefreshTokenRepository) RevokeUserTokens(ctx context.Context, userID string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at).Error
+}
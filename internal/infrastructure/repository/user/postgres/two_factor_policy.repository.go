@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"meye-core/internal/domain/user"
+
+	"gorm.io/gorm"
+)
+
+var _ user.TwoFactorPolicyRepository = (*TwoFactorPolicyRepository)(nil)
+
+type TwoFactorRequiredRole struct {
+	Role string `gorm:"primaryKey"`
+}
+
+type TwoFactorPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewTwoFactorPolicyRepository(db *gorm.DB) *TwoFactorPolicyRepository {
+	return &TwoFactorPolicyRepository{db: db}
+}
+
+func (r *TwoFactorPolicyRepository) Get(ctx context.Context) (user.TwoFactorPolicy, error) {
+	var models []TwoFactorRequiredRole
+	if err := r.db.WithContext(ctx).Order("role").Find(&models).Error; err != nil {
+		return user.TwoFactorPolicy{}, err
+	}
+
+	roles := make([]user.UserRole, 0, len(models))
+	for _, model := range models {
+		roles = append(roles, user.UserRole(model.Role))
+	}
+
+	return user.CreateTwoFactorPolicyWithoutValidation(roles), nil
+}
+
+// Save replaces the required roles with the ones of the policy.
+func (r *TwoFactorPolicyRepository) Save(ctx context.Context, policy user.TwoFactorPolicy) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&TwoFactorRequiredRole{}).Error; err != nil {
+			return err
+		}
+
+		roles := policy.RequiredRoles()
+		if len(roles) == 0 {
+			return nil
+		}
+
+		models := make([]TwoFactorRequiredRole, 0, len(roles))
+		for _, role := range roles {
+			models = append(models, TwoFactorRequiredRole{Role: string(role)})
+		}
+
+		return tx.Create(&models).Error
+	})
+}
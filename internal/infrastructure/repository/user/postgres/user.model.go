@@ -1,33 +1,136 @@
 package postgres
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"meye-core/internal/domain/user"
 	"time"
 )
 
+type RolesJSON []user.UserRole
+
+func (r RolesJSON) Value() (driver.Value, error) {
+	if r == nil {
+		r = RolesJSON{}
+	}
+	return json.Marshal(r)
+}
+
+func (r *RolesJSON) Scan(value interface{}) error {
+	if value == nil {
+		*r = RolesJSON{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, r)
+}
+
+type HashesJSON []string
+
+func (h HashesJSON) Value() (driver.Value, error) {
+	if h == nil {
+		h = HashesJSON{}
+	}
+	return json.Marshal(h)
+}
+
+func (h *HashesJSON) Scan(value interface{}) error {
+	if value == nil {
+		*h = HashesJSON{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, h)
+}
+
 type User struct {
 	Id             string `gorm:"primaryKey"`
 	Username       string
 	HashedPassword string
-	Role           user.UserRole
-	CreatedAt      time.Time `gorm:"default:current_timestamp"`
-	UpdatedAt      time.Time `gorm:"default:current_timestamp"`
+	Roles          RolesJSON `gorm:"type:jsonb"`
+	// Nil when the user has no calendar feed, the column is unique
+	CalendarTokenHash *string
+	TokenVersion      int
+	// Both nil unless an admin issued a password reset, the hash column is unique
+	PasswordResetTokenHash *string
+	PasswordResetExpiresAt *time.Time
+	Disabled               bool
+	// Nil when the user has no second factor, enabled stays false until the enrollment is confirmed
+	TwoFactorSecret             *string
+	TwoFactorEnabled            bool
+	TwoFactorRecoveryCodeHashes HashesJSON `gorm:"type:jsonb"`
+	TwoFactorLastUsedStep       int64
+	CreatedAt                   time.Time `gorm:"default:current_timestamp"`
+	UpdatedAt                   time.Time `gorm:"default:current_timestamp"`
 }
 
 func GetModelFromDomainUser(u *user.User) *User {
-	return &User{
+	model := &User{
 		Id:             u.ID(),
 		Username:       u.Username(),
 		HashedPassword: u.HashedPassword(),
-		Role:           u.Role(),
+		Roles:          u.Roles(),
+		TokenVersion:   u.TokenVersion(),
+		Disabled:       u.IsDisabled(),
+	}
+
+	if hash := u.CalendarTokenHash(); hash != "" {
+		model.CalendarTokenHash = &hash
+	}
+
+	if reset := u.PasswordReset(); reset != nil {
+		hash, expiresAt := reset.TokenHash(), reset.ExpiresAt()
+		model.PasswordResetTokenHash = &hash
+		model.PasswordResetExpiresAt = &expiresAt
+	}
+
+	if twoFactor := u.TwoFactor(); twoFactor != nil {
+		secret := twoFactor.Secret()
+		model.TwoFactorSecret = &secret
+		model.TwoFactorEnabled = twoFactor.IsEnabled()
+		model.TwoFactorRecoveryCodeHashes = twoFactor.RecoveryCodeHashes()
+		model.TwoFactorLastUsedStep = twoFactor.LastUsedStep()
 	}
+
+	return model
 }
 
 func (u *User) ToDomainUser() *user.User {
+	var calendarTokenHash string
+	if u.CalendarTokenHash != nil {
+		calendarTokenHash = *u.CalendarTokenHash
+	}
+
+	var passwordReset *user.PasswordReset
+	if u.PasswordResetTokenHash != nil && u.PasswordResetExpiresAt != nil {
+		passwordReset = user.CreatePasswordResetWithoutValidation(*u.PasswordResetTokenHash, *u.PasswordResetExpiresAt)
+	}
+
+	var twoFactor *user.TwoFactor
+	if u.TwoFactorSecret != nil {
+		twoFactor = user.CreateTwoFactorWithoutValidation(
+			*u.TwoFactorSecret,
+			u.TwoFactorEnabled,
+			u.TwoFactorRecoveryCodeHashes,
+			u.TwoFactorLastUsedStep,
+		)
+	}
+
 	return user.CreateUserWithoutValidation(
 		u.Id,
 		u.Username,
 		u.HashedPassword,
-		u.Role,
+		u.Roles,
+		calendarTokenHash,
+		u.TokenVersion,
+		passwordReset,
+		u.Disabled,
+		twoFactor,
 	)
 }
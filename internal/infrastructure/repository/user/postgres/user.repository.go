@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"meye-core/internal/domain/user"
+	"meye-core/internal/infrastructure/encryption"
 	"meye-core/internal/infrastructure/repository/shared"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -13,38 +15,110 @@ import (
 
 var _ user.Repository = (*Repository)(nil)
 
+// Repository stores the users, with the secrets of their second factor encrypted.
 type Repository struct {
-	db *gorm.DB
+	db         *gorm.DB
+	encryption *encryption.Service
 }
 
-func New(db *gorm.DB) *Repository {
-	return &Repository{db: db}
+func New(db *gorm.DB, encryptionServ *encryption.Service) *Repository {
+	return &Repository{
+		db:         db,
+		encryption: encryptionServ,
+	}
 }
 
 // Save performs an upsert operation into DB by ID.
 func (r *Repository) Save(ctx context.Context, us *user.User) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		userModel := GetModelFromDomainUser(us)
+		return r.save(tx, us)
+	})
+}
 
-		result := tx.Clauses(clause.OnConflict{
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]interface{}{
-				"username":        userModel.Username,
-				"hashed_password": userModel.HashedPassword,
-				"updated_at":      time.Now(),
-			}),
-		}).Create(userModel)
+// SaveResetPassword clears the reset token with a conditional update before saving the user, so two
+// concurrent resets can't both consume it: only the first one matches the row.
+func (r *Repository) SaveResetPassword(ctx context.Context, us *user.User, tokenHash string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).
+			Where("id = ? AND password_reset_token_hash = ? AND password_reset_expires_at > ?", us.ID(), tokenHash, time.Now()).
+			Updates(map[string]interface{}{
+				"password_reset_token_hash": nil,
+				"password_reset_expires_at": nil,
+			})
 
 		if result.Error != nil {
 			return result.Error
 		}
 
-		domainEvents := getUncommittedEvents(us)
+		if result.RowsAffected == 0 {
+			return user.ErrInvalidPasswordResetToken
+		}
 
-		return tx.Create(&domainEvents).Error
+		return r.save(tx, us)
 	})
 }
 
+// SaveTwoFactorUse compares the second factor of the row with previous in a conditional update before
+// saving the user: of two logins accepting a code concurrently, the second matches no row anymore.
+func (r *Repository) SaveTwoFactorUse(ctx context.Context, us *user.User, previous *user.TwoFactor) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).
+			Where(
+				"id = ? AND two_factor_enabled = ? AND two_factor_last_used_step = ? AND "+
+					"COALESCE(jsonb_array_length(two_factor_recovery_code_hashes), 0) = ?",
+				us.ID(), previous.IsEnabled(), previous.LastUsedStep(), len(previous.RecoveryCodeHashes()),
+			).
+			Update("updated_at", time.Now())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return user.ErrInvalidTwoFactorCode
+		}
+
+		return r.save(tx, us)
+	})
+}
+
+func (r *Repository) save(tx *gorm.DB, us *user.User) error {
+	userModel, err := r.toModel(us)
+	if err != nil {
+		return err
+	}
+
+	result := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"username":                        userModel.Username,
+			"roles":                           userModel.Roles,
+			"disabled":                        userModel.Disabled,
+			"hashed_password":                 userModel.HashedPassword,
+			"calendar_token_hash":             userModel.CalendarTokenHash,
+			"token_version":                   userModel.TokenVersion,
+			"password_reset_token_hash":       userModel.PasswordResetTokenHash,
+			"password_reset_expires_at":       userModel.PasswordResetExpiresAt,
+			"two_factor_secret":               userModel.TwoFactorSecret,
+			"two_factor_enabled":              userModel.TwoFactorEnabled,
+			"two_factor_recovery_code_hashes": userModel.TwoFactorRecoveryCodeHashes,
+			"two_factor_last_used_step":       userModel.TwoFactorLastUsedStep,
+			"updated_at":                      time.Now(),
+		}),
+	}).Create(userModel)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	domainEvents := getUncommittedEvents(us)
+	if len(domainEvents) == 0 {
+		return nil
+	}
+
+	return tx.Create(&domainEvents).Error
+}
+
 func (r *Repository) FindByUsername(ctx context.Context, username string) (*user.User, error) {
 	var userModel User
 	result := r.db.WithContext(ctx).Where("username = ?", username).First(&userModel)
@@ -56,8 +130,7 @@ func (r *Repository) FindByUsername(ctx context.Context, username string) (*user
 		return nil, result.Error
 	}
 
-	domainUser := userModel.ToDomainUser()
-	return domainUser, nil
+	return r.toDomain(&userModel)
 }
 
 func (r *Repository) FindByID(ctx context.Context, id string) (*user.User, error) {
@@ -71,8 +144,35 @@ func (r *Repository) FindByID(ctx context.Context, id string) (*user.User, error
 		return nil, result.Error
 	}
 
-	domainUser := userModel.ToDomainUser()
-	return domainUser, nil
+	return r.toDomain(&userModel)
+}
+
+func (r *Repository) FindByCalendarTokenHash(ctx context.Context, hash string) (*user.User, error) {
+	var userModel User
+	result := r.db.WithContext(ctx).Where("calendar_token_hash = ?", hash).First(&userModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return r.toDomain(&userModel)
+}
+
+func (r *Repository) FindByPasswordResetTokenHash(ctx context.Context, hash string) (*user.User, error) {
+	var userModel User
+	result := r.db.WithContext(ctx).Where("password_reset_token_hash = ?", hash).First(&userModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return r.toDomain(&userModel)
 }
 
 func (r *Repository) FindByRole(ctx context.Context, role user.UserRole, page, size int) ([]*user.User, error) {
@@ -80,7 +180,7 @@ func (r *Repository) FindByRole(ctx context.Context, role user.UserRole, page, s
 	offset := (page - 1) * size
 
 	result := r.db.WithContext(ctx).
-		Where("role = ?", role).
+		Where("roles @> ?", RolesJSON{role}).
 		Offset(offset).
 		Limit(size).
 		Order("created_at DESC").
@@ -92,12 +192,102 @@ func (r *Repository) FindByRole(ctx context.Context, role user.UserRole, page, s
 
 	domainUsers := make([]*user.User, 0, len(userModels))
 	for _, userModel := range userModels {
-		domainUsers = append(domainUsers, userModel.ToDomainUser())
+		domainUser, err := r.toDomain(&userModel)
+		if err != nil {
+			return nil, err
+		}
+
+		domainUsers = append(domainUsers, domainUser)
+	}
+
+	return domainUsers, nil
+}
+
+func (r *Repository) Find(ctx context.Context, filter user.UserFilter) ([]*user.User, error) {
+	var userModels []User
+
+	query := r.db.WithContext(ctx)
+	if filter.Role != "" {
+		query = query.Where("roles @> ?", RolesJSON{filter.Role})
+	}
+	if filter.UsernamePrefix != "" {
+		query = query.Where("username LIKE ?", escapeLike(filter.UsernamePrefix)+"%")
+	}
+
+	result := query.
+		Offset((filter.Page - 1) * filter.Size).
+		Limit(filter.Size).
+		Order("username ASC").
+		Find(&userModels)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	domainUsers := make([]*user.User, 0, len(userModels))
+	for _, userModel := range userModels {
+		domainUser, err := r.toDomain(&userModel)
+		if err != nil {
+			return nil, err
+		}
+
+		domainUsers = append(domainUsers, domainUser)
 	}
 
 	return domainUsers, nil
 }
 
+// Delete removes the user, the foreign keys referencing it cascade.
+func (r *Repository) Delete(ctx context.Context, us *user.User) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&User{Id: us.ID()}).Error; err != nil {
+			return err
+		}
+
+		domainEvents := getUncommittedEvents(us)
+		if len(domainEvents) == 0 {
+			return nil
+		}
+
+		return tx.Create(&domainEvents).Error
+	})
+}
+
+// toModel maps the user to its row, with the secret of the second factor encrypted.
+func (r *Repository) toModel(us *user.User) (*User, error) {
+	model := GetModelFromDomainUser(us)
+
+	if model.TwoFactorSecret != nil {
+		secret, err := r.encryption.Encrypt(*model.TwoFactorSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		model.TwoFactorSecret = &secret
+	}
+
+	return model, nil
+}
+
+// toDomain maps the row to the user, with the secret of the second factor decrypted.
+func (r *Repository) toDomain(model *User) (*user.User, error) {
+	if model.TwoFactorSecret != nil {
+		secret, err := r.encryption.Decrypt(*model.TwoFactorSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		model.TwoFactorSecret = &secret
+	}
+
+	return model.ToDomainUser(), nil
+}
+
+// escapeLike makes the LIKE wildcards of s match literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func getUncommittedEvents(user *user.User) []shared.DomainEvent {
 	events := user.UncommittedEvents()
 	domainEvents := make([]shared.DomainEvent, 0, len(events))
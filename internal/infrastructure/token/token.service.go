@@ -0,0 +1,38 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"meye-core/internal/domain/user"
+)
+
+const size = 32
+
+var _ user.TokenService = (*Service)(nil)
+
+type Service struct{}
+
+// New creates a new Token Service.
+func New() *Service {
+	return &Service{}
+}
+
+// Generate returns 32 random bytes encoded as URL-safe base64.
+func (s *Service) Generate() (string, error) {
+	bytes := make([]byte, size)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// Hash returns the hex encoded SHA-256 of the token. The secret is random enough that a
+// slow hash is not needed, and a deterministic one lets it be looked up by index.
+func (s *Service) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -13,12 +13,14 @@ import (
 // EventHandler routes events to their appropriate use case handlers
 type EventHandler struct {
 	consumeXpUseCase campaign.ConsumeXpUseCase
+	amendXpUseCase   campaign.AmendXpUseCase
 }
 
 // NewEventHandler creates a new event handler
-func NewEventHandler(consumeXpUseCase campaign.ConsumeXpUseCase) *EventHandler {
+func NewEventHandler(consumeXpUseCase campaign.ConsumeXpUseCase, amendXpUseCase campaign.AmendXpUseCase) *EventHandler {
 	return &EventHandler{
 		consumeXpUseCase: consumeXpUseCase,
+		amendXpUseCase:   amendXpUseCase,
 	}
 }
 
@@ -27,6 +29,8 @@ func (h *EventHandler) Handle(ctx context.Context, message rabbitmq.EventMessage
 	switch event.EventType(message.Type) {
 	case event.EventTypeXPAssigned:
 		return h.handleXPAssigned(ctx, message)
+	case event.EventTypeXPAssignationAmended:
+		return h.handleXPAssignationAmended(ctx, message)
 	default:
 		// Ignore unknown event types
 		logrus.WithFields(logrus.Fields{
@@ -80,6 +84,49 @@ func (h *EventHandler) handleXPAssigned(ctx context.Context, message rabbitmq.Ev
 	return nil
 }
 
+// handleXPAssignationAmended applies the XP delta of an amended session to the PJ
+func (h *EventHandler) handleXPAssignationAmended(ctx context.Context, message rabbitmq.EventMessage) error {
+	logrus.WithFields(logrus.Fields{
+		"event_id":     message.ID,
+		"aggregate_id": message.AggregateID, // This is the PJ ID
+	}).Info("Processing XPAssignationAmended event")
+
+	deltaData, ok := message.Data["xp_delta"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("missing or invalid xp_delta data in event %s", message.ID)
+	}
+
+	basic, err := convertToInt(deltaData["basic"])
+	if err != nil {
+		return fmt.Errorf("invalid basic xp delta: %w", err)
+	}
+
+	special, err := convertToInt(deltaData["special"])
+	if err != nil {
+		return fmt.Errorf("invalid special xp delta: %w", err)
+	}
+
+	supernatural, err := convertToInt(deltaData["supernatural"])
+	if err != nil {
+		return fmt.Errorf("invalid supernatural xp delta: %w", err)
+	}
+
+	input := campaign.AmendXpInput{
+		PjID: message.AggregateID, // PJ ID from aggregate_id
+		Delta: campaign.XpDelta{
+			Basic:        basic,
+			Special:      special,
+			Supernatural: supernatural,
+		},
+	}
+
+	if err := h.amendXpUseCase.Execute(ctx, input); err != nil {
+		return fmt.Errorf("failed to amend XP for PJ %s: %w", message.AggregateID, err)
+	}
+
+	return nil
+}
+
 // convertToUint converts an interface{} value to uint
 func convertToUint(value interface{}) (uint, error) {
 	switch v := value.(type) {
@@ -97,3 +144,21 @@ func convertToUint(value interface{}) (uint, error) {
 		return 0, fmt.Errorf("cannot convert %T to uint", value)
 	}
 }
+
+// convertToInt converts an interface{} value to int
+func convertToInt(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case uint:
+		return int(v), nil
+	case uint64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to int", value)
+	}
+}
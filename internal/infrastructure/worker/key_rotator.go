@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"meye-core/internal/infrastructure/jwt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// keyRotationCheckInterval is how often the age of the newest signing key is checked
+const keyRotationCheckInterval = time.Minute
+
+// KeyRotator periodically writes a new JWT signing key once the newest one is older than the rotation interval
+type KeyRotator struct {
+	keyring  *jwt.Keyring
+	interval time.Duration
+}
+
+// NewKeyRotator creates a new key rotator
+func NewKeyRotator(keyring *jwt.Keyring, interval time.Duration) *KeyRotator {
+	return &KeyRotator{
+		keyring:  keyring,
+		interval: interval,
+	}
+}
+
+// Start runs the rotator until the context is cancelled
+func (r *KeyRotator) Start(ctx context.Context) {
+	ticker := time.NewTicker(keyRotationCheckInterval)
+	defer ticker.Stop()
+
+	logrus.WithField("interval", r.interval).Info("Key rotator started")
+
+	for {
+		r.run()
+
+		select {
+		case <-ctx.Done():
+			logrus.Info("Key rotator stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *KeyRotator) run() {
+	if err := r.keyring.RotateIfDue(time.Now(), r.interval); err != nil {
+		logrus.Errorf("Failed to rotate JWT signing key: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"meye-core/internal/application/session"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SessionScheduler periodically turns the planned sessions that have started into drafts
+type SessionScheduler struct {
+	startDueSessionsUseCase session.StartDueSessionsUseCase
+	interval                time.Duration
+}
+
+// NewSessionScheduler creates a new session scheduler
+func NewSessionScheduler(startDueSessionsUseCase session.StartDueSessionsUseCase, interval time.Duration) *SessionScheduler {
+	return &SessionScheduler{
+		startDueSessionsUseCase: startDueSessionsUseCase,
+		interval:                interval,
+	}
+}
+
+// Start runs the scheduler until the context is cancelled
+func (s *SessionScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logrus.WithField("interval", s.interval).Info("Session scheduler started")
+
+	for {
+		s.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			logrus.Info("Session scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SessionScheduler) run(ctx context.Context) {
+	input := session.StartDueSessionsInput{Now: time.Now()}
+
+	if err := s.startDueSessionsUseCase.Execute(ctx, input); err != nil {
+		logrus.Errorf("Failed to start due sessions: %v", err)
+	}
+}
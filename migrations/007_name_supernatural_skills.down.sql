@@ -0,0 +1,17 @@
+-- Drop skill and transformation ids, names and descriptions, keeping only the levels
+UPDATE pjs
+SET supernatural_stats = jsonb_build_object(
+    'skills',
+    COALESCE((
+        SELECT jsonb_agg(
+            jsonb_build_object(
+                'transformations', COALESCE((
+                    SELECT jsonb_agg((t.transformation ->> 'level')::bigint ORDER BY t.ord)
+                    FROM jsonb_array_elements(s.skill -> 'transformations') WITH ORDINALITY AS t(transformation, ord)
+                ), '[]'::jsonb)
+            ) ORDER BY s.ord
+        )
+        FROM jsonb_array_elements(supernatural_stats -> 'skills') WITH ORDINALITY AS s(skill, ord)
+    ), '[]'::jsonb)
+)
+WHERE supernatural_stats IS NOT NULL;
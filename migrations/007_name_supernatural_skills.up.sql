@@ -0,0 +1,28 @@
+-- Give every existing supernatural skill and transformation a stable id, a name and a description.
+-- Transformations were stored as plain level arrays: {"skills":[{"transformations":[210,110]}]}
+UPDATE pjs
+SET supernatural_stats = jsonb_build_object(
+    'skills',
+    COALESCE((
+        SELECT jsonb_agg(
+            jsonb_build_object(
+                'id', gen_random_uuid()::text,
+                'name', '',
+                'description', '',
+                'transformations', COALESCE((
+                    SELECT jsonb_agg(
+                        jsonb_build_object(
+                            'id', gen_random_uuid()::text,
+                            'name', '',
+                            'description', '',
+                            'level', t.level::bigint
+                        ) ORDER BY t.ord
+                    )
+                    FROM jsonb_array_elements_text(s.skill -> 'transformations') WITH ORDINALITY AS t(level, ord)
+                ), '[]'::jsonb)
+            ) ORDER BY s.ord
+        )
+        FROM jsonb_array_elements(supernatural_stats -> 'skills') WITH ORDINALITY AS s(skill, ord)
+    ), '[]'::jsonb)
+)
+WHERE supernatural_stats IS NOT NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE campaigns
+DROP COLUMN IF EXISTS starting_xp_basic,
+DROP COLUMN IF EXISTS starting_xp_special,
+DROP COLUMN IF EXISTS starting_xp_supernatural;
@@ -0,0 +1,4 @@
+ALTER TABLE campaigns
+ADD COLUMN starting_xp_basic INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN starting_xp_special INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN starting_xp_supernatural INTEGER NOT NULL DEFAULT 0;
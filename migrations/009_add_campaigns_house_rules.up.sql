@@ -0,0 +1,2 @@
+ALTER TABLE campaigns
+ADD COLUMN house_rules JSONB NOT NULL DEFAULT '{}'::jsonb;
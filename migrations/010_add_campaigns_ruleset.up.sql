@@ -0,0 +1,15 @@
+ALTER TABLE campaigns
+ADD COLUMN ruleset JSONB NOT NULL DEFAULT '{
+  "name": "default",
+  "basic_level_step": 10,
+  "basic_first_level_cost": 3,
+  "basic_talented_first_level_cost": 1,
+  "life_cost": 5,
+  "special_level_step": 100,
+  "special_first_level_cost": 2,
+  "special_talented_first_level_cost": 1,
+  "energy_tank_cost": 10,
+  "energy_tank_talented_cost": 5,
+  "supernatural_level_step": 100,
+  "supernatural_first_level_cost": 1
+}'::jsonb;
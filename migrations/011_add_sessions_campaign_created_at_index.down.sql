@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_sessions_campaign_created_at_id;
@@ -0,0 +1 @@
+CREATE INDEX idx_sessions_campaign_created_at_id ON sessions(campaign_id, created_at DESC, id DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS attendees,
+DROP COLUMN IF EXISTS status;
@@ -0,0 +1,3 @@
+ALTER TABLE sessions
+ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'finalized',
+ADD COLUMN attendees JSONB NOT NULL DEFAULT '[]';
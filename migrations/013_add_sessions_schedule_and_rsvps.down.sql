@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_sessions_planned_starts_at;
+
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS rsvps,
+DROP COLUMN IF EXISTS location,
+DROP COLUMN IF EXISTS duration_minutes,
+DROP COLUMN IF EXISTS starts_at;
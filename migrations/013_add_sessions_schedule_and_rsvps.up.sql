@@ -0,0 +1,7 @@
+ALTER TABLE sessions
+ADD COLUMN starts_at TIMESTAMP WITH TIME ZONE,
+ADD COLUMN duration_minutes INTEGER,
+ADD COLUMN location TEXT,
+ADD COLUMN rsvps JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX idx_sessions_planned_starts_at ON sessions(starts_at) WHERE status = 'planned';
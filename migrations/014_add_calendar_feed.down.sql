@@ -0,0 +1,7 @@
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS schedule_sequence;
+
+DROP INDEX IF EXISTS idx_users_calendar_token_hash;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS calendar_token_hash;
@@ -0,0 +1,7 @@
+ALTER TABLE users
+ADD COLUMN calendar_token_hash VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_users_calendar_token_hash ON users(calendar_token_hash) WHERE calendar_token_hash IS NOT NULL;
+
+ALTER TABLE sessions
+ADD COLUMN schedule_sequence INTEGER NOT NULL DEFAULT 0;
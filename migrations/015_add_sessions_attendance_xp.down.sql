@@ -0,0 +1,4 @@
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS attendance_xp_supernatural,
+DROP COLUMN IF EXISTS attendance_xp_special,
+DROP COLUMN IF EXISTS attendance_xp_basic;
@@ -0,0 +1,4 @@
+ALTER TABLE sessions
+ADD COLUMN attendance_xp_basic INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN attendance_xp_special INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN attendance_xp_supernatural INTEGER NOT NULL DEFAULT 0;
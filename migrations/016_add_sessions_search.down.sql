@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_sessions_reasons_tsv;
+DROP INDEX IF EXISTS idx_sessions_summary_tsv;
+
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS reasons_tsv,
+DROP COLUMN IF EXISTS summary_tsv;
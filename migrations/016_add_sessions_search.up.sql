@@ -0,0 +1,7 @@
+-- 'simple' keeps the search language agnostic: no stemming nor stop words
+ALTER TABLE sessions
+ADD COLUMN summary_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', summary)) STORED,
+ADD COLUMN reasons_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', jsonb_path_query_array(xp_assignations, '$[*].reason'))) STORED;
+
+CREATE INDEX idx_sessions_summary_tsv ON sessions USING GIN (summary_tsv);
+CREATE INDEX idx_sessions_reasons_tsv ON sessions USING GIN (reasons_tsv);
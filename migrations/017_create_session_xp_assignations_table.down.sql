@@ -0,0 +1,27 @@
+ALTER TABLE sessions
+ADD COLUMN xp_assignations JSONB NOT NULL DEFAULT '[]';
+
+UPDATE sessions s
+SET xp_assignations = a.lines
+FROM (
+    SELECT
+        session_id,
+        jsonb_agg(jsonb_build_object(
+            'pj_id', pj_id,
+            'kind', kind,
+            'amounts', jsonb_build_object('basic', basic, 'special', special, 'supernatural', supernatural),
+            'reason', reason
+        ) ORDER BY position) AS lines
+    FROM session_xp_assignations
+    GROUP BY session_id
+) a
+WHERE a.session_id = s.id;
+
+ALTER TABLE sessions
+ALTER COLUMN xp_assignations DROP DEFAULT,
+ADD COLUMN reasons_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', jsonb_path_query_array(xp_assignations, '$[*].reason'))) STORED;
+
+CREATE INDEX idx_sessions_xp_assignations_gin ON sessions USING GIN (xp_assignations);
+CREATE INDEX idx_sessions_reasons_tsv ON sessions USING GIN (reasons_tsv);
+
+DROP TABLE IF EXISTS session_xp_assignations;
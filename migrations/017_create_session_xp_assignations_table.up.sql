@@ -0,0 +1,41 @@
+CREATE TABLE session_xp_assignations (
+    session_id VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    pj_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    basic INTEGER NOT NULL DEFAULT 0,
+    special INTEGER NOT NULL DEFAULT 0,
+    supernatural INTEGER NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    reason_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', reason)) STORED,
+
+    PRIMARY KEY (session_id, position),
+    CONSTRAINT fk_session_xp_assignations_session
+        FOREIGN KEY (session_id)
+        REFERENCES sessions(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_session_xp_assignations_pj_id ON session_xp_assignations(pj_id, session_id);
+CREATE INDEX idx_session_xp_assignations_reason_tsv ON session_xp_assignations USING GIN (reason_tsv);
+
+-- Lines stored before attendance XP existed have no kind, they were all bonuses
+INSERT INTO session_xp_assignations (session_id, position, pj_id, kind, basic, special, supernatural, reason)
+SELECT
+    s.id,
+    line.position - 1,
+    line.value->>'pj_id',
+    COALESCE(NULLIF(line.value->>'kind', ''), 'bonus'),
+    COALESCE((line.value->'amounts'->>'basic')::INTEGER, 0),
+    COALESCE((line.value->'amounts'->>'special')::INTEGER, 0),
+    COALESCE((line.value->'amounts'->>'supernatural')::INTEGER, 0),
+    COALESCE(line.value->>'reason', '')
+FROM sessions s
+CROSS JOIN LATERAL jsonb_array_elements(s.xp_assignations) WITH ORDINALITY AS line(value, position);
+
+DROP INDEX IF EXISTS idx_sessions_reasons_tsv;
+DROP INDEX IF EXISTS idx_sessions_xp_assignations_gin;
+
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS reasons_tsv,
+DROP COLUMN IF EXISTS xp_assignations;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_username_pattern;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS disabled;
@@ -0,0 +1,5 @@
+ALTER TABLE users
+ADD COLUMN disabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Serves the username prefix search of the admin listing
+CREATE INDEX idx_users_username_pattern ON users(username varchar_pattern_ops);
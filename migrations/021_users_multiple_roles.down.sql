@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_users_roles;
+
+ALTER TABLE users
+ADD COLUMN role VARCHAR(50);
+
+-- Users holding several roles keep the most privileged one, the first of the array
+UPDATE users SET role = roles->>0;
+
+ALTER TABLE users
+ALTER COLUMN role SET NOT NULL,
+DROP COLUMN roles;
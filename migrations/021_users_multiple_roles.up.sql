@@ -0,0 +1,10 @@
+ALTER TABLE users
+ADD COLUMN roles JSONB NOT NULL DEFAULT '[]';
+
+UPDATE users SET roles = jsonb_build_array(role);
+
+ALTER TABLE users
+DROP COLUMN role;
+
+-- Serves the role filters, which look for users holding a role among others
+CREATE INDEX idx_users_roles ON users USING GIN (roles);
@@ -0,0 +1,6 @@
+CREATE TABLE login_attempts (
+    attempt_key VARCHAR(255) PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP
+);
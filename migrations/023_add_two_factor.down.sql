@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS two_factor_required_roles;
+
+DROP TABLE IF EXISTS login_challenges;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS two_factor_secret,
+DROP COLUMN IF EXISTS two_factor_enabled,
+DROP COLUMN IF EXISTS two_factor_recovery_code_hashes,
+DROP COLUMN IF EXISTS two_factor_last_used_step;
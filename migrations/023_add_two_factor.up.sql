@@ -0,0 +1,25 @@
+ALTER TABLE users
+ADD COLUMN two_factor_secret VARCHAR(64),
+ADD COLUMN two_factor_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN two_factor_recovery_code_hashes JSONB NOT NULL DEFAULT '[]',
+ADD COLUMN two_factor_last_used_step BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS login_challenges (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT fk_user
+      FOREIGN KEY(user_id)
+      REFERENCES users(id)
+      ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_login_challenges_token_hash ON login_challenges(token_hash);
+
+-- Roles whose users can't login without a second factor
+CREATE TABLE IF NOT EXISTS two_factor_required_roles (
+    role VARCHAR(50) PRIMARY KEY
+);
@@ -0,0 +1,3 @@
+-- Fails while encrypted secrets are stored, they don't fit the former size
+ALTER TABLE users
+ALTER COLUMN two_factor_secret TYPE VARCHAR(64);
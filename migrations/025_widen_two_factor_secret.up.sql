@@ -0,0 +1,3 @@
+-- The secret is stored encrypted, 87 characters for the 32 characters of a base32 secret
+ALTER TABLE users
+ALTER COLUMN two_factor_secret TYPE VARCHAR(128);
@@ -27,6 +27,9 @@ func Campaign(t *testing.T) *campaign.Campaign {
 		CampaignID,
 		CampaignMasterID,
 		CampaignName,
+		campaign.CreateXPWithoutValidation(0, 0, 0),
+		campaign.HouseRules{},
+		campaign.DefaultRuleset(),
 		[]*campaign.Invitation{},
 		[]*campaign.PJ{},
 		[]*session.Session{},
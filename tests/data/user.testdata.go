@@ -16,6 +16,8 @@ const (
 	Role           = user.UserRolePlayer
 )
 
+var Roles = []user.UserRole{Role}
+
 func User(t *testing.T) *user.User {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -26,7 +28,7 @@ func User(t *testing.T) *user.User {
 	idServ.EXPECT().GenerateID().Return(UserID).Times(2)
 	hashServ.EXPECT().Hash(Password).Return(HashedPassword, nil)
 
-	u, _ := user.NewUser(Username, Password, Role, idServ, hashServ)
+	u, _ := user.NewUser(Username, Password, Roles, idServ, hashServ)
 
 	return u
 }
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/user (interfaces: AuthQueryService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/auth_query_service_mock.go -package=mocks meye-core/internal/domain/user AuthQueryService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	user "meye-core/internal/domain/user"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthQueryService is a mock of AuthQueryService interface.
+type MockAuthQueryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthQueryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthQueryServiceMockRecorder is the mock recorder for MockAuthQueryService.
+type MockAuthQueryServiceMockRecorder struct {
+	mock *MockAuthQueryService
+}
+
+// NewMockAuthQueryService creates a new mock instance.
+func NewMockAuthQueryService(ctrl *gomock.Controller) *MockAuthQueryService {
+	mock := &MockAuthQueryService{ctrl: ctrl}
+	mock.recorder = &MockAuthQueryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthQueryService) EXPECT() *MockAuthQueryServiceMockRecorder {
+	return m.recorder
+}
+
+// FindAuthState mocks base method.
+func (m *MockAuthQueryService) FindAuthState(ctx context.Context, userID string) (*user.AuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuthState", ctx, userID)
+	ret0, _ := ret[0].(*user.AuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuthState indicates an expected call of FindAuthState.
+func (mr *MockAuthQueryServiceMockRecorder) FindAuthState(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuthState", reflect.TypeOf((*MockAuthQueryService)(nil).FindAuthState), ctx, userID)
+}
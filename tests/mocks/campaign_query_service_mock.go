@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/campaign (interfaces: CampaignQueryService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/campaign_query_service_mock.go -package=mocks meye-core/internal/domain/campaign CampaignQueryService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	campaign "meye-core/internal/domain/campaign"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCampaignQueryService is a mock of CampaignQueryService interface.
+type MockCampaignQueryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCampaignQueryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCampaignQueryServiceMockRecorder is the mock recorder for MockCampaignQueryService.
+type MockCampaignQueryServiceMockRecorder struct {
+	mock *MockCampaignQueryService
+}
+
+// NewMockCampaignQueryService creates a new mock instance.
+func NewMockCampaignQueryService(ctrl *gomock.Controller) *MockCampaignQueryService {
+	mock := &MockCampaignQueryService{ctrl: ctrl}
+	mock.recorder = &MockCampaignQueryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCampaignQueryService) EXPECT() *MockCampaignQueryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCampaignBasicInfo mocks base method.
+func (m *MockCampaignQueryService) GetCampaignBasicInfo(ctx context.Context, campaignID string) (*campaign.CampaignBasicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignBasicInfo", ctx, campaignID)
+	ret0, _ := ret[0].(*campaign.CampaignBasicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignBasicInfo indicates an expected call of GetCampaignBasicInfo.
+func (mr *MockCampaignQueryServiceMockRecorder) GetCampaignBasicInfo(ctx, campaignID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignBasicInfo", reflect.TypeOf((*MockCampaignQueryService)(nil).GetCampaignBasicInfo), ctx, campaignID)
+}
+
+// GetCampaignsBasicInfo mocks base method.
+func (m *MockCampaignQueryService) GetCampaignsBasicInfo(ctx context.Context, masterID string) ([]*campaign.CampaignBasicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignsBasicInfo", ctx, masterID)
+	ret0, _ := ret[0].([]*campaign.CampaignBasicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignsBasicInfo indicates an expected call of GetCampaignsBasicInfo.
+func (mr *MockCampaignQueryServiceMockRecorder) GetCampaignsBasicInfo(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignsBasicInfo", reflect.TypeOf((*MockCampaignQueryService)(nil).GetCampaignsBasicInfo), ctx, masterID)
+}
@@ -56,6 +56,21 @@ func (mr *MockCampaignRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCampaignRepository)(nil).FindByID), ctx, id)
 }
 
+// FindHouseRules mocks base method.
+func (m *MockCampaignRepository) FindHouseRules(ctx context.Context, id string) (*campaign.HouseRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHouseRules", ctx, id)
+	ret0, _ := ret[0].(*campaign.HouseRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHouseRules indicates an expected call of FindHouseRules.
+func (mr *MockCampaignRepositoryMockRecorder) FindHouseRules(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHouseRules", reflect.TypeOf((*MockCampaignRepository)(nil).FindHouseRules), ctx, id)
+}
+
 // Save mocks base method.
 func (m *MockCampaignRepository) Save(ctx context.Context, arg1 *campaign.Campaign) error {
 	m.ctrl.T.Helper()
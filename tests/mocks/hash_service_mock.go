@@ -67,3 +67,17 @@ func (mr *MockHashServiceMockRecorder) Hash(password any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockHashService)(nil).Hash), password)
 }
+
+// NeedsRehash mocks base method.
+func (m *MockHashService) NeedsRehash(hashedPassword string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", hashedPassword)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockHashServiceMockRecorder) NeedsRehash(hashedPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockHashService)(nil).NeedsRehash), hashedPassword)
+}
@@ -56,10 +56,10 @@ func (mr *MockJWTServiceMockRecorder) GenerateSignedToken(arg0 any) *gomock.Call
 }
 
 // ValidateToken mocks base method.
-func (m *MockJWTService) ValidateToken(tokenString string) (string, error) {
+func (m *MockJWTService) ValidateToken(tokenString string) (user.AccessClaims, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateToken", tokenString)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(user.AccessClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/user (interfaces: LoginAttemptStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/login_attempt_store_mock.go -package=mocks meye-core/internal/domain/user LoginAttemptStore
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	user "meye-core/internal/domain/user"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginAttemptStore is a mock of LoginAttemptStore interface.
+type MockLoginAttemptStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginAttemptStoreMockRecorder is the mock recorder for MockLoginAttemptStore.
+type MockLoginAttemptStoreMockRecorder struct {
+	mock *MockLoginAttemptStore
+}
+
+// NewMockLoginAttemptStore creates a new mock instance.
+func NewMockLoginAttemptStore(ctrl *gomock.Controller) *MockLoginAttemptStore {
+	mock := &MockLoginAttemptStore{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptStore) EXPECT() *MockLoginAttemptStoreMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockLoginAttemptStore) Find(ctx context.Context, key string) (user.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, key)
+	ret0, _ := ret[0].(user.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockLoginAttemptStoreMockRecorder) Find(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockLoginAttemptStore)(nil).Find), ctx, key)
+}
+
+// Lock mocks base method.
+func (m *MockLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, key, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockLoginAttemptStoreMockRecorder) Lock(ctx, key, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockLoginAttemptStore)(nil).Lock), ctx, key, until)
+}
+
+// RecordFailure mocks base method.
+func (m *MockLoginAttemptStore) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (user.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, key, now, window)
+	ret0, _ := ret[0].(user.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockLoginAttemptStoreMockRecorder) RecordFailure(ctx, key, now, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockLoginAttemptStore)(nil).RecordFailure), ctx, key, now, window)
+}
+
+// Reset mocks base method.
+func (m *MockLoginAttemptStore) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockLoginAttemptStoreMockRecorder) Reset(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockLoginAttemptStore)(nil).Reset), ctx, key)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/user (interfaces: LoginChallengeRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/login_challenge_repository_mock.go -package=mocks meye-core/internal/domain/user LoginChallengeRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	user "meye-core/internal/domain/user"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginChallengeRepository is a mock of LoginChallengeRepository interface.
+type MockLoginChallengeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginChallengeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginChallengeRepositoryMockRecorder is the mock recorder for MockLoginChallengeRepository.
+type MockLoginChallengeRepositoryMockRecorder struct {
+	mock *MockLoginChallengeRepository
+}
+
+// NewMockLoginChallengeRepository creates a new mock instance.
+func NewMockLoginChallengeRepository(ctrl *gomock.Controller) *MockLoginChallengeRepository {
+	mock := &MockLoginChallengeRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginChallengeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginChallengeRepository) EXPECT() *MockLoginChallengeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockLoginChallengeRepository) Delete(ctx context.Context, challenge *user.LoginChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoginChallengeRepositoryMockRecorder) Delete(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoginChallengeRepository)(nil).Delete), ctx, challenge)
+}
+
+// FindByHash mocks base method.
+func (m *MockLoginChallengeRepository) FindByHash(ctx context.Context, hash string) (*user.LoginChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, hash)
+	ret0, _ := ret[0].(*user.LoginChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockLoginChallengeRepositoryMockRecorder) FindByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockLoginChallengeRepository)(nil).FindByHash), ctx, hash)
+}
+
+// Save mocks base method.
+func (m *MockLoginChallengeRepository) Save(ctx context.Context, challenge *user.LoginChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLoginChallengeRepositoryMockRecorder) Save(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLoginChallengeRepository)(nil).Save), ctx, challenge)
+}
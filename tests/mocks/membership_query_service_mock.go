@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/campaign (interfaces: MembershipQueryService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/membership_query_service_mock.go -package=mocks meye-core/internal/domain/campaign MembershipQueryService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMembershipQueryService is a mock of MembershipQueryService interface.
+type MockMembershipQueryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipQueryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMembershipQueryServiceMockRecorder is the mock recorder for MockMembershipQueryService.
+type MockMembershipQueryServiceMockRecorder struct {
+	mock *MockMembershipQueryService
+}
+
+// NewMockMembershipQueryService creates a new mock instance.
+func NewMockMembershipQueryService(ctrl *gomock.Controller) *MockMembershipQueryService {
+	mock := &MockMembershipQueryService{ctrl: ctrl}
+	mock.recorder = &MockMembershipQueryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipQueryService) EXPECT() *MockMembershipQueryServiceMockRecorder {
+	return m.recorder
+}
+
+// FindCampaignMasterID mocks base method.
+func (m *MockMembershipQueryService) FindCampaignMasterID(ctx context.Context, campaignID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCampaignMasterID", ctx, campaignID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCampaignMasterID indicates an expected call of FindCampaignMasterID.
+func (mr *MockMembershipQueryServiceMockRecorder) FindCampaignMasterID(ctx, campaignID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCampaignMasterID", reflect.TypeOf((*MockMembershipQueryService)(nil).FindCampaignMasterID), ctx, campaignID)
+}
+
+// FindPjOwnerID mocks base method.
+func (m *MockMembershipQueryService) FindPjOwnerID(ctx context.Context, pjID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPjOwnerID", ctx, pjID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPjOwnerID indicates an expected call of FindPjOwnerID.
+func (mr *MockMembershipQueryServiceMockRecorder) FindPjOwnerID(ctx, pjID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPjOwnerID", reflect.TypeOf((*MockMembershipQueryService)(nil).FindPjOwnerID), ctx, pjID)
+}
+
+// FindUserPjIDs mocks base method.
+func (m *MockMembershipQueryService) FindUserPjIDs(ctx context.Context, campaignID, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserPjIDs", ctx, campaignID, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserPjIDs indicates an expected call of FindUserPjIDs.
+func (mr *MockMembershipQueryServiceMockRecorder) FindUserPjIDs(ctx, campaignID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserPjIDs", reflect.TypeOf((*MockMembershipQueryService)(nil).FindUserPjIDs), ctx, campaignID, userID)
+}
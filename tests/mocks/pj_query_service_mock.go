@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/campaign (interfaces: PjQueryService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/pj_query_service_mock.go -package=mocks meye-core/internal/domain/campaign PjQueryService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	campaign "meye-core/internal/domain/campaign"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPjQueryService is a mock of PjQueryService interface.
+type MockPjQueryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPjQueryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPjQueryServiceMockRecorder is the mock recorder for MockPjQueryService.
+type MockPjQueryServiceMockRecorder struct {
+	mock *MockPjQueryService
+}
+
+// NewMockPjQueryService creates a new mock instance.
+func NewMockPjQueryService(ctrl *gomock.Controller) *MockPjQueryService {
+	mock := &MockPjQueryService{ctrl: ctrl}
+	mock.recorder = &MockPjQueryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPjQueryService) EXPECT() *MockPjQueryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCampaignPjsBasicInfo mocks base method.
+func (m *MockPjQueryService) GetCampaignPjsBasicInfo(ctx context.Context, campaignID string) ([]*campaign.PjBasicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignPjsBasicInfo", ctx, campaignID)
+	ret0, _ := ret[0].([]*campaign.PjBasicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignPjsBasicInfo indicates an expected call of GetCampaignPjsBasicInfo.
+func (mr *MockPjQueryServiceMockRecorder) GetCampaignPjsBasicInfo(ctx, campaignID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignPjsBasicInfo", reflect.TypeOf((*MockPjQueryService)(nil).GetCampaignPjsBasicInfo), ctx, campaignID)
+}
+
+// GetPjsBasicInfo mocks base method.
+func (m *MockPjQueryService) GetPjsBasicInfo(ctx context.Context, userID string) ([]*campaign.PjBasicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPjsBasicInfo", ctx, userID)
+	ret0, _ := ret[0].([]*campaign.PjBasicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPjsBasicInfo indicates an expected call of GetPjsBasicInfo.
+func (mr *MockPjQueryServiceMockRecorder) GetPjsBasicInfo(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPjsBasicInfo", reflect.TypeOf((*MockPjQueryService)(nil).GetPjsBasicInfo), ctx, userID)
+}
+
+// ListStatsUpgrades mocks base method.
+func (m *MockPjQueryService) ListStatsUpgrades(ctx context.Context, pjIDs []string, from, to time.Time) ([]*campaign.StatsUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatsUpgrades", ctx, pjIDs, from, to)
+	ret0, _ := ret[0].([]*campaign.StatsUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatsUpgrades indicates an expected call of ListStatsUpgrades.
+func (mr *MockPjQueryServiceMockRecorder) ListStatsUpgrades(ctx, pjIDs, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatsUpgrades", reflect.TypeOf((*MockPjQueryService)(nil).ListStatsUpgrades), ctx, pjIDs, from, to)
+}
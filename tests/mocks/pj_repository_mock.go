@@ -69,3 +69,18 @@ func (mr *MockPjRepositoryMockRecorder) Save(ctx, pj any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPjRepository)(nil).Save), ctx, pj)
 }
+
+// Update mocks base method.
+func (m *MockPjRepository) Update(ctx context.Context, id string, update func(*campaign.PJ) error) (*campaign.PJ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, update)
+	ret0, _ := ret[0].(*campaign.PJ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPjRepositoryMockRecorder) Update(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPjRepository)(nil).Update), ctx, id, update)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/campaign (interfaces: RulesetRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/ruleset_repository_mock.go -package=mocks meye-core/internal/domain/campaign RulesetRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	campaign "meye-core/internal/domain/campaign"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRulesetRepository is a mock of RulesetRepository interface.
+type MockRulesetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRulesetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRulesetRepositoryMockRecorder is the mock recorder for MockRulesetRepository.
+type MockRulesetRepositoryMockRecorder struct {
+	mock *MockRulesetRepository
+}
+
+// NewMockRulesetRepository creates a new mock instance.
+func NewMockRulesetRepository(ctrl *gomock.Controller) *MockRulesetRepository {
+	mock := &MockRulesetRepository{ctrl: ctrl}
+	mock.recorder = &MockRulesetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRulesetRepository) EXPECT() *MockRulesetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockRulesetRepository) FindAll(ctx context.Context) ([]campaign.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]campaign.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRulesetRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRulesetRepository)(nil).FindAll), ctx)
+}
+
+// FindByName mocks base method.
+func (m *MockRulesetRepository) FindByName(ctx context.Context, name string) (*campaign.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, name)
+	ret0, _ := ret[0].(*campaign.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockRulesetRepositoryMockRecorder) FindByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockRulesetRepository)(nil).FindByName), ctx, name)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/session (interfaces: QueryService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/session_query_service_mock.go -package=mocks meye-core/internal/domain/session QueryService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	session "meye-core/internal/domain/session"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQueryService is a mock of QueryService interface.
+type MockQueryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockQueryServiceMockRecorder is the mock recorder for MockQueryService.
+type MockQueryServiceMockRecorder struct {
+	mock *MockQueryService
+}
+
+// NewMockQueryService creates a new mock instance.
+func NewMockQueryService(ctrl *gomock.Controller) *MockQueryService {
+	mock := &MockQueryService{ctrl: ctrl}
+	mock.recorder = &MockQueryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryService) EXPECT() *MockQueryServiceMockRecorder {
+	return m.recorder
+}
+
+// ListCampaignSessions mocks base method.
+func (m *MockQueryService) ListCampaignSessions(ctx context.Context, query session.ListQuery) (session.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCampaignSessions", ctx, query)
+	ret0, _ := ret[0].(session.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCampaignSessions indicates an expected call of ListCampaignSessions.
+func (mr *MockQueryServiceMockRecorder) ListCampaignSessions(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCampaignSessions", reflect.TypeOf((*MockQueryService)(nil).ListCampaignSessions), ctx, query)
+}
+
+// ListUserScheduledSessions mocks base method.
+func (m *MockQueryService) ListUserScheduledSessions(ctx context.Context, userID string) ([]session.ScheduledSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserScheduledSessions", ctx, userID)
+	ret0, _ := ret[0].([]session.ScheduledSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserScheduledSessions indicates an expected call of ListUserScheduledSessions.
+func (mr *MockQueryServiceMockRecorder) ListUserScheduledSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserScheduledSessions", reflect.TypeOf((*MockQueryService)(nil).ListUserScheduledSessions), ctx, userID)
+}
+
+// Search mocks base method.
+func (m *MockQueryService) Search(ctx context.Context, query session.SearchQuery) ([]session.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret0, _ := ret[0].([]session.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockQueryServiceMockRecorder) Search(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockQueryService)(nil).Search), ctx, query)
+}
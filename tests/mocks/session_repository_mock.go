@@ -13,6 +13,7 @@ import (
 	context "context"
 	session "meye-core/internal/domain/session"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,51 @@ func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
 	return m.recorder
 }
 
+// FindByCampaignID mocks base method.
+func (m *MockSessionRepository) FindByCampaignID(ctx context.Context, campaignID string) ([]*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCampaignID", ctx, campaignID)
+	ret0, _ := ret[0].([]*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCampaignID indicates an expected call of FindByCampaignID.
+func (mr *MockSessionRepositoryMockRecorder) FindByCampaignID(ctx, campaignID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCampaignID", reflect.TypeOf((*MockSessionRepository)(nil).FindByCampaignID), ctx, campaignID)
+}
+
+// FindByID mocks base method.
+func (m *MockSessionRepository) FindByID(ctx context.Context, id string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSessionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSessionRepository)(nil).FindByID), ctx, id)
+}
+
+// FindPlannedStartingBefore mocks base method.
+func (m *MockSessionRepository) FindPlannedStartingBefore(ctx context.Context, t time.Time) ([]*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlannedStartingBefore", ctx, t)
+	ret0, _ := ret[0].([]*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlannedStartingBefore indicates an expected call of FindPlannedStartingBefore.
+func (mr *MockSessionRepositoryMockRecorder) FindPlannedStartingBefore(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlannedStartingBefore", reflect.TypeOf((*MockSessionRepository)(nil).FindPlannedStartingBefore), ctx, t)
+}
+
 // Save mocks base method.
 func (m *MockSessionRepository) Save(ctx context.Context, s *session.Session) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/user (interfaces: TokenService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/token_service_mock.go -package=mocks meye-core/internal/domain/user TokenService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenService is a mock of TokenService interface.
+type MockTokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenServiceMockRecorder is the mock recorder for MockTokenService.
+type MockTokenServiceMockRecorder struct {
+	mock *MockTokenService
+}
+
+// NewMockTokenService creates a new mock instance.
+func NewMockTokenService(ctrl *gomock.Controller) *MockTokenService {
+	mock := &MockTokenService{ctrl: ctrl}
+	mock.recorder = &MockTokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenService) EXPECT() *MockTokenServiceMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockTokenService) Generate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockTokenServiceMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockTokenService)(nil).Generate))
+}
+
+// Hash mocks base method.
+func (m *MockTokenService) Hash(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockTokenServiceMockRecorder) Hash(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockTokenService)(nil).Hash), token)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/user (interfaces: TOTPService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/totp_service_mock.go -package=mocks meye-core/internal/domain/user TOTPService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTOTPService is a mock of TOTPService interface.
+type MockTOTPService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTOTPServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTOTPServiceMockRecorder is the mock recorder for MockTOTPService.
+type MockTOTPServiceMockRecorder struct {
+	mock *MockTOTPService
+}
+
+// NewMockTOTPService creates a new mock instance.
+func NewMockTOTPService(ctrl *gomock.Controller) *MockTOTPService {
+	mock := &MockTOTPService{ctrl: ctrl}
+	mock.recorder = &MockTOTPServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTOTPService) EXPECT() *MockTOTPServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateRecoveryCode mocks base method.
+func (m *MockTOTPService) GenerateRecoveryCode() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRecoveryCode")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateRecoveryCode indicates an expected call of GenerateRecoveryCode.
+func (mr *MockTOTPServiceMockRecorder) GenerateRecoveryCode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRecoveryCode", reflect.TypeOf((*MockTOTPService)(nil).GenerateRecoveryCode))
+}
+
+// GenerateSecret mocks base method.
+func (m *MockTOTPService) GenerateSecret() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret.
+func (mr *MockTOTPServiceMockRecorder) GenerateSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockTOTPService)(nil).GenerateSecret))
+}
+
+// ProvisioningURI mocks base method.
+func (m *MockTOTPService) ProvisioningURI(secret, accountName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisioningURI", secret, accountName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProvisioningURI indicates an expected call of ProvisioningURI.
+func (mr *MockTOTPServiceMockRecorder) ProvisioningURI(secret, accountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisioningURI", reflect.TypeOf((*MockTOTPService)(nil).ProvisioningURI), secret, accountName)
+}
+
+// Verify mocks base method.
+func (m *MockTOTPService) Verify(secret, code string, now time.Time) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", secret, code, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTOTPServiceMockRecorder) Verify(secret, code, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockTOTPService)(nil).Verify), secret, code, now)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: meye-core/internal/domain/user (interfaces: TwoFactorPolicyRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../tests/mocks/two_factor_policy_repository_mock.go -package=mocks meye-core/internal/domain/user TwoFactorPolicyRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	user "meye-core/internal/domain/user"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTwoFactorPolicyRepository is a mock of TwoFactorPolicyRepository interface.
+type MockTwoFactorPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorPolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTwoFactorPolicyRepositoryMockRecorder is the mock recorder for MockTwoFactorPolicyRepository.
+type MockTwoFactorPolicyRepositoryMockRecorder struct {
+	mock *MockTwoFactorPolicyRepository
+}
+
+// NewMockTwoFactorPolicyRepository creates a new mock instance.
+func NewMockTwoFactorPolicyRepository(ctrl *gomock.Controller) *MockTwoFactorPolicyRepository {
+	mock := &MockTwoFactorPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorPolicyRepository) EXPECT() *MockTwoFactorPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockTwoFactorPolicyRepository) Get(ctx context.Context) (user.TwoFactorPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(user.TwoFactorPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTwoFactorPolicyRepositoryMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTwoFactorPolicyRepository)(nil).Get), ctx)
+}
+
+// Save mocks base method.
+func (m *MockTwoFactorPolicyRepository) Save(ctx context.Context, policy user.TwoFactorPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTwoFactorPolicyRepositoryMockRecorder) Save(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTwoFactorPolicyRepository)(nil).Save), ctx, policy)
+}
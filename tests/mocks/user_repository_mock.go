@@ -41,6 +41,50 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockUserRepository) Delete(ctx context.Context, arg1 *user.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRepositoryMockRecorder) Delete(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), ctx, arg1)
+}
+
+// Find mocks base method.
+func (m *MockUserRepository) Find(ctx context.Context, filter user.UserFilter) ([]*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, filter)
+	ret0, _ := ret[0].([]*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockUserRepositoryMockRecorder) Find(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockUserRepository)(nil).Find), ctx, filter)
+}
+
+// FindByCalendarTokenHash mocks base method.
+func (m *MockUserRepository) FindByCalendarTokenHash(ctx context.Context, hash string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCalendarTokenHash", ctx, hash)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCalendarTokenHash indicates an expected call of FindByCalendarTokenHash.
+func (mr *MockUserRepositoryMockRecorder) FindByCalendarTokenHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCalendarTokenHash", reflect.TypeOf((*MockUserRepository)(nil).FindByCalendarTokenHash), ctx, hash)
+}
+
 // FindByID mocks base method.
 func (m *MockUserRepository) FindByID(ctx context.Context, id string) (*user.User, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +100,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByPasswordResetTokenHash mocks base method.
+func (m *MockUserRepository) FindByPasswordResetTokenHash(ctx context.Context, hash string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPasswordResetTokenHash", ctx, hash)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPasswordResetTokenHash indicates an expected call of FindByPasswordResetTokenHash.
+func (mr *MockUserRepositoryMockRecorder) FindByPasswordResetTokenHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPasswordResetTokenHash", reflect.TypeOf((*MockUserRepository)(nil).FindByPasswordResetTokenHash), ctx, hash)
+}
+
 // FindByRole mocks base method.
 func (m *MockUserRepository) FindByRole(ctx context.Context, role user.UserRole, page, size int) ([]*user.User, error) {
 	m.ctrl.T.Helper()
@@ -99,3 +158,31 @@ func (mr *MockUserRepositoryMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserRepository)(nil).Save), ctx, arg1)
 }
+
+// SaveResetPassword mocks base method.
+func (m *MockUserRepository) SaveResetPassword(ctx context.Context, arg1 *user.User, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResetPassword", ctx, arg1, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResetPassword indicates an expected call of SaveResetPassword.
+func (mr *MockUserRepositoryMockRecorder) SaveResetPassword(ctx, arg1, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResetPassword", reflect.TypeOf((*MockUserRepository)(nil).SaveResetPassword), ctx, arg1, tokenHash)
+}
+
+// SaveTwoFactorUse mocks base method.
+func (m *MockUserRepository) SaveTwoFactorUse(ctx context.Context, arg1 *user.User, previous *user.TwoFactor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTwoFactorUse", ctx, arg1, previous)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTwoFactorUse indicates an expected call of SaveTwoFactorUse.
+func (mr *MockUserRepositoryMockRecorder) SaveTwoFactorUse(ctx, arg1, previous any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTwoFactorUse", reflect.TypeOf((*MockUserRepository)(nil).SaveTwoFactorUse), ctx, arg1, previous)
+}